@@ -34,10 +34,10 @@ type Account struct {
 }
 
 // GetAccount retrieves account details from TMDb.
-func (ar *AccountResource) GetAccount(sessionID string) (*Account, *http.Response, error) {
+func (ar *AccountResource) GetAccount(sessionID string, options ...RequestOptionFn) (*Account, *http.Response, error) {
 	path := "/account"
 	var account Account
-	resp, err := ar.client.get(path, &account, WithSessionID(sessionID))
+	resp, err := ar.client.get(path, &account, WithSessionID(sessionID), withOptions(options...))
 	return &account, resp, errors.Wrap(err, "failed to get account")
 }
 
@@ -55,7 +55,7 @@ type CreatedList struct {
 
 // CreatedLists represents the created lists in TMDb.
 type CreatedLists struct {
-	pagination
+	Pagination
 	Lists []CreatedList `json:"results"`
 }
 
@@ -64,10 +64,10 @@ type AccountListsOptions languagePageOptions
 
 // GetCreatedLists retrieves all of the lists created by an account.
 // Will include private lists if the requester is the owner.
-func (ar *AccountResource) GetCreatedLists(accountID int, sessionID string, opt *AccountListsOptions) (*CreatedLists, *http.Response, error) {
+func (ar *AccountResource) GetCreatedLists(accountID int, sessionID string, opt *AccountListsOptions, options ...RequestOptionFn) (*CreatedLists, *http.Response, error) {
 	path := fmt.Sprintf("/account/%d/lists", accountID)
 	var lists CreatedLists
-	resp, err := ar.client.get(path, &lists, WithQueryParams(opt), WithSessionID(sessionID))
+	resp, err := ar.client.get(path, &lists, WithQueryParams(opt), WithSessionID(sessionID), withOptions(options...))
 	return &lists, resp, errors.Wrap(err, "failed to get account lists")
 }
 
@@ -85,17 +85,17 @@ type AccountOptions struct {
 
 	// Sort the results.
 	// Allowed Values: created_at.asc, created_at.desc
-	SortBy string `url:"sort_by,omitempty" json:"sort_by,omitempty"`
+	SortBy AccountSort `url:"sort_by,omitempty" json:"sort_by,omitempty"`
 
 	// Specify which page to query.
 	Page *int `url:"page,omitempty" json:"page,omitempty"`
 }
 
 // GetFavoriteMovies retrieves the list of favorite movies.
-func (ar *AccountResource) GetFavoriteMovies(accountID int, sessionID string, opt *AccountOptions) (*FavoriteMovies, *http.Response, error) {
+func (ar *AccountResource) GetFavoriteMovies(accountID int, sessionID string, opt *AccountOptions, options ...RequestOptionFn) (*FavoriteMovies, *http.Response, error) {
 	path := fmt.Sprintf("/account/%d/favorite/movies", accountID)
 	var movies FavoriteMovies
-	resp, err := ar.client.get(path, &movies, WithQueryParams(opt), WithSessionID(sessionID))
+	resp, err := ar.client.get(path, &movies, WithQueryParams(opt), WithSessionID(sessionID), withOptions(options...))
 	return &movies, resp, errors.Wrap(err, "failed to get favorite movies")
 }
 
@@ -103,10 +103,10 @@ func (ar *AccountResource) GetFavoriteMovies(accountID int, sessionID string, op
 type FavoriteTVShows paginatedTVShows
 
 // GetFavoriteTVShows retrieves the list of favorite tv shows.
-func (ar *AccountResource) GetFavoriteTVShows(accountID int, sessionID string, opt *AccountOptions) (*FavoriteTVShows, *http.Response, error) {
+func (ar *AccountResource) GetFavoriteTVShows(accountID int, sessionID string, opt *AccountOptions, options ...RequestOptionFn) (*FavoriteTVShows, *http.Response, error) {
 	path := fmt.Sprintf("/account/%d/favorite/tv", accountID)
 	var tvShows FavoriteTVShows
-	resp, err := ar.client.get(path, &tvShows, WithQueryParams(opt), WithSessionID(sessionID))
+	resp, err := ar.client.get(path, &tvShows, WithQueryParams(opt), WithSessionID(sessionID), withOptions(options...))
 	return &tvShows, resp, errors.Wrap(err, "failed to get favorite tv shows")
 }
 
@@ -122,7 +122,7 @@ type RatedMovie struct {
 	Popularity       float64 `json:"popularity"`
 	PosterPath       *string `json:"poster_path"`
 	Rating           float64 `json:"rating"`
-	ReleaseDate      string  `json:"release_date"`
+	ReleaseDate      Date    `json:"release_date"`
 	Title            string  `json:"title"`
 	Video            bool    `json:"video"`
 	VoteAverage      float64 `json:"vote_average"`
@@ -131,15 +131,15 @@ type RatedMovie struct {
 
 // RatedMovies represents rated movies in TMDb.
 type RatedMovies struct {
-	pagination
+	Pagination
 	Movies []RatedMovie `json:"results"`
 }
 
 // GetRatedMovies retrieves the list of rated movies.
-func (ar *AccountResource) GetRatedMovies(accountID int, sessionID string, opt *AccountOptions) (*RatedMovies, *http.Response, error) {
+func (ar *AccountResource) GetRatedMovies(accountID int, sessionID string, opt *AccountOptions, options ...RequestOptionFn) (*RatedMovies, *http.Response, error) {
 	path := fmt.Sprintf("/account/%d/rated/movies", accountID)
 	var movies RatedMovies
-	resp, err := ar.client.get(path, &movies, WithQueryParams(opt), WithSessionID(sessionID))
+	resp, err := ar.client.get(path, &movies, WithQueryParams(opt), WithSessionID(sessionID), withOptions(options...))
 	return &movies, resp, errors.Wrap(err, "failed to get rated movies")
 }
 
@@ -147,7 +147,7 @@ func (ar *AccountResource) GetRatedMovies(accountID int, sessionID string, opt *
 type RatedTVShow struct {
 	Adult            bool     `json:"adult"`
 	BackdropPath     *string  `json:"backdrop_path"`
-	FirstAirDate     string   `json:"first_air_date"`
+	FirstAirDate     Date     `json:"first_air_date"`
 	GenreIDs         []int    `json:"genre_ids"`
 	ID               int      `json:"id"`
 	Name             string   `json:"name"`
@@ -164,21 +164,21 @@ type RatedTVShow struct {
 
 // RatedTVShows represents rated tv shows in TMDb.
 type RatedTVShows struct {
-	pagination
+	Pagination
 	TVShows []RatedTVShow `json:"results"`
 }
 
 // GetRatedTVShows retrieves the list of rated tv shows.
-func (ar *AccountResource) GetRatedTVShows(accountID int, sessionID string, opt *AccountOptions) (*RatedTVShows, *http.Response, error) {
+func (ar *AccountResource) GetRatedTVShows(accountID int, sessionID string, opt *AccountOptions, options ...RequestOptionFn) (*RatedTVShows, *http.Response, error) {
 	path := fmt.Sprintf("/account/%d/rated/tv", accountID)
 	var tvShows RatedTVShows
-	resp, err := ar.client.get(path, &tvShows, WithQueryParams(opt), WithSessionID(sessionID))
+	resp, err := ar.client.get(path, &tvShows, WithQueryParams(opt), WithSessionID(sessionID), withOptions(options...))
 	return &tvShows, resp, errors.Wrap(err, "failed to get rated tv shows")
 }
 
 // RatedTVEpisode represents a rated tv episode in TMDb.
 type RatedTVEpisode struct {
-	AirDate        string  `json:"air_date"`
+	AirDate        Date    `json:"air_date"`
 	EpisodeNumber  int     `json:"episode_number"`
 	ID             int     `json:"id"`
 	Name           string  `json:"name"`
@@ -195,15 +195,15 @@ type RatedTVEpisode struct {
 
 // RatedTVEpisodes represents rated tv episodes in TMDb.
 type RatedTVEpisodes struct {
-	pagination
+	Pagination
 	TVShows []RatedTVEpisode `json:"results"`
 }
 
 // GetRatedTVEpisodes retrieves the list of rated tv episodes.
-func (ar *AccountResource) GetRatedTVEpisodes(accountID int, sessionID string, opt *AccountOptions) (*RatedTVEpisodes, *http.Response, error) {
+func (ar *AccountResource) GetRatedTVEpisodes(accountID int, sessionID string, opt *AccountOptions, options ...RequestOptionFn) (*RatedTVEpisodes, *http.Response, error) {
 	path := fmt.Sprintf("/account/%d/rated/tv/episodes", accountID)
 	var episodes RatedTVEpisodes
-	resp, err := ar.client.get(path, &episodes, WithQueryParams(opt), WithSessionID(sessionID))
+	resp, err := ar.client.get(path, &episodes, WithQueryParams(opt), WithSessionID(sessionID), withOptions(options...))
 	return &episodes, resp, errors.Wrap(err, "failed to get rated tv episodes")
 }
 
@@ -211,10 +211,10 @@ func (ar *AccountResource) GetRatedTVEpisodes(accountID int, sessionID string, o
 type WatchlistMovies paginatedMovies
 
 // GetWatchlistMovies retrieves the list of rated movies.
-func (ar *AccountResource) GetWatchlistMovies(accountID int, sessionID string, opt *AccountOptions) (*WatchlistMovies, *http.Response, error) {
+func (ar *AccountResource) GetWatchlistMovies(accountID int, sessionID string, opt *AccountOptions, options ...RequestOptionFn) (*WatchlistMovies, *http.Response, error) {
 	path := fmt.Sprintf("/account/%d/watchlist/movies", accountID)
 	var movies WatchlistMovies
-	resp, err := ar.client.get(path, &movies, WithQueryParams(opt), WithSessionID(sessionID))
+	resp, err := ar.client.get(path, &movies, WithQueryParams(opt), WithSessionID(sessionID), withOptions(options...))
 	return &movies, resp, errors.Wrap(err, "failed to get movies in watchlist")
 }
 
@@ -222,10 +222,10 @@ func (ar *AccountResource) GetWatchlistMovies(accountID int, sessionID string, o
 type WatchlistTVShows paginatedTVShows
 
 // GetWatchlistTVShows retrieves the list of rated tv shows.
-func (ar *AccountResource) GetWatchlistTVShows(accountID int, sessionID string, opt *AccountOptions) (*WatchlistTVShows, *http.Response, error) {
+func (ar *AccountResource) GetWatchlistTVShows(accountID int, sessionID string, opt *AccountOptions, options ...RequestOptionFn) (*WatchlistTVShows, *http.Response, error) {
 	path := fmt.Sprintf("/account/%d/watchlist/tv", accountID)
 	var tvShows WatchlistTVShows
-	resp, err := ar.client.get(path, &tvShows, WithQueryParams(opt), WithSessionID(sessionID))
+	resp, err := ar.client.get(path, &tvShows, WithQueryParams(opt), WithSessionID(sessionID), withOptions(options...))
 	return &tvShows, resp, errors.Wrap(err, "failed to get tv shows in watchlist")
 }
 
@@ -236,14 +236,24 @@ type Favorite struct {
 	Favorite  bool   `json:"favorite"`
 }
 
+// Validate checks the favorite fields.
+func (f Favorite) Validate() error {
+	var errs fieldErrors
+	errs.checkMediaType("media_type", f.MediaType)
+	if f.MediaID <= 0 {
+		errs.add("media_id", f.MediaID, "must be positive")
+	}
+	return errs.err()
+}
+
 // FavoriteResponse represents the favorite response.
 type FavoriteResponse statusResponse
 
 // Favorite adds/removes some media to/from favorites.
-func (ar *AccountResource) Favorite(accountID int, sessionID string, favorite Favorite) (*FavoriteResponse, *http.Response, error) {
+func (ar *AccountResource) Favorite(accountID int, sessionID string, favorite Favorite, options ...RequestOptionFn) (*FavoriteResponse, *http.Response, error) {
 	path := fmt.Sprintf("/account/%d/favorite", accountID)
 	var favoriteResp FavoriteResponse
-	resp, err := ar.client.post(path, &favoriteResp, WithBody(favorite), WithSessionID(sessionID))
+	resp, err := ar.client.post(path, &favoriteResp, WithBody(favorite), WithSessionID(sessionID), withOptions(options...))
 	return &favoriteResp, resp, errors.Wrap(err, "failed to mark as favorite")
 }
 
@@ -254,13 +264,23 @@ type Watchlist struct {
 	Watchlist bool   `json:"watchlist"`
 }
 
+// Validate checks the watchlist fields.
+func (w Watchlist) Validate() error {
+	var errs fieldErrors
+	errs.checkMediaType("media_type", w.MediaType)
+	if w.MediaID <= 0 {
+		errs.add("media_id", w.MediaID, "must be positive")
+	}
+	return errs.err()
+}
+
 // WatchlistResponse represents the watchlist response.
 type WatchlistResponse statusResponse
 
 // Watchlist adds/removes some media to/from watchlist.
-func (ar *AccountResource) Watchlist(accountID int, sessionID string, watchlist Watchlist) (*WatchlistResponse, *http.Response, error) {
+func (ar *AccountResource) Watchlist(accountID int, sessionID string, watchlist Watchlist, options ...RequestOptionFn) (*WatchlistResponse, *http.Response, error) {
 	path := fmt.Sprintf("/account/%d/watchlist", accountID)
 	var watchlistResp WatchlistResponse
-	resp, err := ar.client.post(path, &watchlistResp, WithBody(watchlist), WithSessionID(sessionID))
+	resp, err := ar.client.post(path, &watchlistResp, WithBody(watchlist), WithSessionID(sessionID), withOptions(options...))
 	return &watchlistResp, resp, errors.Wrap(err, "failed to mark as favorite")
 }
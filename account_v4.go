@@ -0,0 +1,193 @@
+package tmdb
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// AccountV4Resource handles v4 account-related requests of TMDb API.
+// The account id is the account_id returned along with the user access token,
+// see AuthenticationV4Resource.CreateAccessToken.
+type AccountV4Resource struct {
+	client *Client
+}
+
+// AccountListV4 represents a list created by an account in TMDb.
+type AccountListV4 struct {
+	Adult         int       `json:"adult"`
+	AverageRating float64   `json:"average_rating"`
+	BackdropPath  *string   `json:"backdrop_path"`
+	CreatedAt     Timestamp `json:"created_at"`
+	Description   string    `json:"description"`
+	Featured      int       `json:"featured"`
+	ID            int       `json:"id"`
+	ISO31661      string    `json:"iso_3166_1"`
+	ISO6391       string    `json:"iso_639_1"`
+	Name          string    `json:"name"`
+	NumberOfItems int       `json:"number_of_items"`
+	PosterPath    *string   `json:"poster_path"`
+	Public        int       `json:"public"`
+	Revenue       int64     `json:"revenue"`
+	Runtime       int       `json:"runtime"`
+	SortBy        int       `json:"sort_by"`
+	UpdatedAt     Timestamp `json:"updated_at"`
+}
+
+// AccountListsV4 represents the lists created by an account in TMDb.
+type AccountListsV4 struct {
+	Pagination
+	Lists []AccountListV4 `json:"results"`
+}
+
+// AccountListsV4Options represents the available options for the request.
+type AccountListsV4Options struct {
+	// Specify which page to query.
+	Page *int `url:"page,omitempty" json:"page,omitempty"`
+}
+
+// GetLists retrieves all of the lists created by an account.
+// Will include private lists if the requester is the owner.
+func (ar *AccountV4Resource) GetLists(accountID, accessToken string, opt *AccountListsV4Options, options ...RequestOptionFn) (*AccountListsV4, *http.Response, error) {
+	path := ar.client.urlV4(fmt.Sprintf("/account/%s/lists", accountID))
+	var lists AccountListsV4
+	resp, err := ar.client.get(path, &lists, WithQueryParams(opt), WithAccessToken(accessToken), withOptions(options...))
+	return &lists, resp, errors.Wrap(err, "failed to get account lists")
+}
+
+// AccountV4Options represents the available options for the request.
+type AccountV4Options struct {
+	// Specify which page to query.
+	Page *int `url:"page,omitempty" json:"page,omitempty"`
+
+	// Sort the results.
+	// Allowed Values: created_at.asc, created_at.desc
+	SortBy AccountSort `url:"sort_by,omitempty" json:"sort_by,omitempty"`
+}
+
+// GetFavoriteMovies retrieves the list of favorite movies.
+func (ar *AccountV4Resource) GetFavoriteMovies(accountID, accessToken string, opt *AccountV4Options, options ...RequestOptionFn) (*FavoriteMovies, *http.Response, error) {
+	path := ar.client.urlV4(fmt.Sprintf("/account/%s/movie/favorites", accountID))
+	var movies FavoriteMovies
+	resp, err := ar.client.get(path, &movies, WithQueryParams(opt), WithAccessToken(accessToken), withOptions(options...))
+	return &movies, resp, errors.Wrap(err, "failed to get favorite movies")
+}
+
+// GetFavoriteTVShows retrieves the list of favorite tv shows.
+func (ar *AccountV4Resource) GetFavoriteTVShows(accountID, accessToken string, opt *AccountV4Options, options ...RequestOptionFn) (*FavoriteTVShows, *http.Response, error) {
+	path := ar.client.urlV4(fmt.Sprintf("/account/%s/tv/favorites", accountID))
+	var tvShows FavoriteTVShows
+	resp, err := ar.client.get(path, &tvShows, WithQueryParams(opt), WithAccessToken(accessToken), withOptions(options...))
+	return &tvShows, resp, errors.Wrap(err, "failed to get favorite tv shows")
+}
+
+// AccountRecommendedMovies represents movies recommended to an account in TMDb.
+type AccountRecommendedMovies paginatedMovies
+
+// GetMovieRecommendations retrieves a list of movies recommended based on the account activity.
+func (ar *AccountV4Resource) GetMovieRecommendations(accountID, accessToken string, opt *AccountV4Options, options ...RequestOptionFn) (*AccountRecommendedMovies, *http.Response, error) {
+	path := ar.client.urlV4(fmt.Sprintf("/account/%s/movie/recommendations", accountID))
+	var movies AccountRecommendedMovies
+	resp, err := ar.client.get(path, &movies, WithQueryParams(opt), WithAccessToken(accessToken), withOptions(options...))
+	return &movies, resp, errors.Wrap(err, "failed to get recommended movies")
+}
+
+// AccountRecommendedTVShows represents tv shows recommended to an account in TMDb.
+type AccountRecommendedTVShows paginatedTVShows
+
+// GetTVShowRecommendations retrieves a list of tv shows recommended based on the account activity.
+func (ar *AccountV4Resource) GetTVShowRecommendations(accountID, accessToken string, opt *AccountV4Options, options ...RequestOptionFn) (*AccountRecommendedTVShows, *http.Response, error) {
+	path := ar.client.urlV4(fmt.Sprintf("/account/%s/tv/recommendations", accountID))
+	var tvShows AccountRecommendedTVShows
+	resp, err := ar.client.get(path, &tvShows, WithQueryParams(opt), WithAccessToken(accessToken), withOptions(options...))
+	return &tvShows, resp, errors.Wrap(err, "failed to get recommended tv shows")
+}
+
+// GetWatchlistMovies retrieves the list of movies added to the watchlist.
+func (ar *AccountV4Resource) GetWatchlistMovies(accountID, accessToken string, opt *AccountV4Options, options ...RequestOptionFn) (*WatchlistMovies, *http.Response, error) {
+	path := ar.client.urlV4(fmt.Sprintf("/account/%s/movie/watchlist", accountID))
+	var movies WatchlistMovies
+	resp, err := ar.client.get(path, &movies, WithQueryParams(opt), WithAccessToken(accessToken), withOptions(options...))
+	return &movies, resp, errors.Wrap(err, "failed to get movies in watchlist")
+}
+
+// GetWatchlistTVShows retrieves the list of tv shows added to the watchlist.
+func (ar *AccountV4Resource) GetWatchlistTVShows(accountID, accessToken string, opt *AccountV4Options, options ...RequestOptionFn) (*WatchlistTVShows, *http.Response, error) {
+	path := ar.client.urlV4(fmt.Sprintf("/account/%s/tv/watchlist", accountID))
+	var tvShows WatchlistTVShows
+	resp, err := ar.client.get(path, &tvShows, WithQueryParams(opt), WithAccessToken(accessToken), withOptions(options...))
+	return &tvShows, resp, errors.Wrap(err, "failed to get tv shows in watchlist")
+}
+
+// AccountRating represents a rating given by an account in TMDb.
+type AccountRating struct {
+	CreatedAt Timestamp `json:"created_at"`
+	Value     float64   `json:"value"`
+}
+
+// RatedMovieV4 represents a movie rated by an account in TMDb.
+type RatedMovieV4 struct {
+	AccountRating    AccountRating `json:"account_rating"`
+	Adult            bool          `json:"adult"`
+	BackdropPath     *string       `json:"backdrop_path"`
+	GenreIDs         []int         `json:"genre_ids"`
+	ID               int           `json:"id"`
+	OriginalLanguage string        `json:"original_language"`
+	OriginalTitle    string        `json:"original_title"`
+	Overview         string        `json:"overview"`
+	Popularity       float64       `json:"popularity"`
+	PosterPath       *string       `json:"poster_path"`
+	ReleaseDate      Date          `json:"release_date"`
+	Title            string        `json:"title"`
+	Video            bool          `json:"video"`
+	VoteAverage      float64       `json:"vote_average"`
+	VoteCount        int           `json:"vote_count"`
+}
+
+// RatedMoviesV4 represents movies rated by an account in TMDb.
+type RatedMoviesV4 struct {
+	Pagination
+	Movies []RatedMovieV4 `json:"results"`
+}
+
+// GetRatedMovies retrieves the list of rated movies.
+func (ar *AccountV4Resource) GetRatedMovies(accountID, accessToken string, opt *AccountV4Options, options ...RequestOptionFn) (*RatedMoviesV4, *http.Response, error) {
+	path := ar.client.urlV4(fmt.Sprintf("/account/%s/movie/rated", accountID))
+	var movies RatedMoviesV4
+	resp, err := ar.client.get(path, &movies, WithQueryParams(opt), WithAccessToken(accessToken), withOptions(options...))
+	return &movies, resp, errors.Wrap(err, "failed to get rated movies")
+}
+
+// RatedTVShowV4 represents a tv show rated by an account in TMDb.
+type RatedTVShowV4 struct {
+	AccountRating    AccountRating `json:"account_rating"`
+	Adult            bool          `json:"adult"`
+	BackdropPath     *string       `json:"backdrop_path"`
+	FirstAirDate     Date          `json:"first_air_date"`
+	GenreIDs         []int         `json:"genre_ids"`
+	ID               int           `json:"id"`
+	Name             string        `json:"name"`
+	OriginalLanguage string        `json:"original_language"`
+	OriginalName     string        `json:"original_name"`
+	OriginCountry    []string      `json:"origin_country"`
+	Overview         string        `json:"overview"`
+	Popularity       float64       `json:"popularity"`
+	PosterPath       *string       `json:"poster_path"`
+	VoteAverage      float64       `json:"vote_average"`
+	VoteCount        int           `json:"vote_count"`
+}
+
+// RatedTVShowsV4 represents tv shows rated by an account in TMDb.
+type RatedTVShowsV4 struct {
+	Pagination
+	TVShows []RatedTVShowV4 `json:"results"`
+}
+
+// GetRatedTVShows retrieves the list of rated tv shows.
+func (ar *AccountV4Resource) GetRatedTVShows(accountID, accessToken string, opt *AccountV4Options, options ...RequestOptionFn) (*RatedTVShowsV4, *http.Response, error) {
+	path := ar.client.urlV4(fmt.Sprintf("/account/%s/tv/rated", accountID))
+	var tvShows RatedTVShowsV4
+	resp, err := ar.client.get(path, &tvShows, WithQueryParams(opt), WithAccessToken(accessToken), withOptions(options...))
+	return &tvShows, resp, errors.Wrap(err, "failed to get rated tv shows")
+}
@@ -0,0 +1,208 @@
+package tmdb
+
+import (
+	"fmt"
+	"math/bits"
+	"net/url"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/pkg/errors"
+)
+
+// MaxAppendToResponse is the maximum number of sub-resources TMDb appends to a response.
+const MaxAppendToResponse = 20
+
+// appendNames holds the names in TMDb API of the flags of a set, indexed by bit.
+type appendNames []string
+
+// names retrieves the names of the flags set, in bit order, followed by unknown flags in hexadecimal.
+func (n appendNames) names(flags uint32) []string {
+	var names []string
+	for i, name := range n {
+		if flags&(1<<i) != 0 {
+			names = append(names, name)
+		}
+	}
+	if unknown := n.unknown(flags); unknown != 0 {
+		names = append(names, fmt.Sprintf("%#x", unknown))
+	}
+	return names
+}
+
+// unknown retrieves the flags set that have no name.
+func (n appendNames) unknown(flags uint32) uint32 {
+	return flags &^ (1<<len(n) - 1)
+}
+
+// validate checks that only known flags are set.
+func (n appendNames) validate(flags uint32) error {
+	if unknown := n.unknown(flags); unknown != 0 {
+		return errors.Errorf("unsupported flags %#x, allowed values: %s", unknown, strings.Join(n, ", "))
+	}
+	return nil
+}
+
+// MovieAppend represents a set of sub-resources appended to a movie details response.
+// Combine them with |, e.g. MovieAppendCredits | MovieAppendImages.
+type MovieAppend uint32
+
+// Available movie sub-resources.
+const (
+	MovieAppendAlternativeTitles MovieAppend = 1 << iota
+	MovieAppendChanges
+	MovieAppendCredits
+	MovieAppendExternalIDs
+	MovieAppendImages
+	MovieAppendKeywords
+	MovieAppendLists
+	MovieAppendRecommendations
+	MovieAppendReleaseDates
+	MovieAppendReviews
+	MovieAppendSimilar
+	MovieAppendTranslations
+	MovieAppendVideos
+)
+
+var movieAppendNames = appendNames{
+	"alternative_titles", "changes", "credits", "external_ids", "images", "keywords", "lists",
+	"recommendations", "release_dates", "reviews", "similar", "translations", "videos",
+}
+
+// String returns the sub-resources separated by commas, e.g. credits,images.
+func (a MovieAppend) String() string {
+	return strings.Join(movieAppendNames.names(uint32(a)), ",")
+}
+
+// EncodeValues encodes the sub-resources as a query param.
+func (a MovieAppend) EncodeValues(key string, v *url.Values) error {
+	v.Set(key, a.String())
+	return nil
+}
+
+// Validate checks whether the sub-resources are supported by TMDb.
+func (a MovieAppend) Validate() error {
+	return movieAppendNames.validate(uint32(a))
+}
+
+// TVAppend represents a set of sub-resources appended to a tv show details response.
+// Combine them with |, e.g. TVAppendCredits | TVAppendImages.
+// Seasons are appended with TVShowDetailsOptions.AppendSeasons.
+type TVAppend uint32
+
+// Available tv show sub-resources.
+const (
+	TVAppendAggregateCredits TVAppend = 1 << iota
+	TVAppendAlternativeTitles
+	TVAppendChanges
+	TVAppendContentRatings
+	TVAppendCredits
+	TVAppendEpisodeGroups
+	TVAppendExternalIDs
+	TVAppendImages
+	TVAppendKeywords
+	TVAppendRecommendations
+	TVAppendReviews
+	TVAppendScreenedTheatrically
+	TVAppendSimilar
+	TVAppendTranslations
+	TVAppendVideos
+)
+
+var tvAppendNames = appendNames{
+	"aggregate_credits", "alternative_titles", "changes", "content_ratings", "credits", "episode_groups",
+	"external_ids", "images", "keywords", "recommendations", "reviews", "screened_theatrically", "similar",
+	"translations", "videos",
+}
+
+// String returns the sub-resources separated by commas, e.g. credits,images.
+func (a TVAppend) String() string {
+	return strings.Join(tvAppendNames.names(uint32(a)), ",")
+}
+
+// EncodeValues encodes the sub-resources as a query param.
+func (a TVAppend) EncodeValues(key string, v *url.Values) error {
+	v.Set(key, a.String())
+	return nil
+}
+
+// Validate checks whether the sub-resources are supported by TMDb.
+func (a TVAppend) Validate() error {
+	return tvAppendNames.validate(uint32(a))
+}
+
+// PersonAppend represents a set of sub-resources appended to a person details response.
+// Combine them with |, e.g. PersonAppendCombinedCredits | PersonAppendImages.
+type PersonAppend uint32
+
+// Available person sub-resources.
+const (
+	PersonAppendChanges PersonAppend = 1 << iota
+	PersonAppendCombinedCredits
+	PersonAppendExternalIDs
+	PersonAppendImages
+	PersonAppendMovieCredits
+	PersonAppendTaggedImages
+	PersonAppendTranslations
+	PersonAppendTVCredits
+)
+
+var personAppendNames = appendNames{
+	"changes", "combined_credits", "external_ids", "images", "movie_credits", "tagged_images",
+	"translations", "tv_credits",
+}
+
+// String returns the sub-resources separated by commas, e.g. combined_credits,images.
+func (a PersonAppend) String() string {
+	return strings.Join(personAppendNames.names(uint32(a)), ",")
+}
+
+// EncodeValues encodes the sub-resources as a query param.
+func (a PersonAppend) EncodeValues(key string, v *url.Values) error {
+	v.Set(key, a.String())
+	return nil
+}
+
+// Validate checks whether the sub-resources are supported by TMDb.
+func (a PersonAppend) Validate() error {
+	return personAppendNames.validate(uint32(a))
+}
+
+// seasonAppendPrefix prefixes the season number of appended seasons, e.g. season/1.
+const seasonAppendPrefix = "season/"
+
+// appendToResponse retrieves the sub-resources and seasons to append, separated by commas.
+func (opt *TVShowDetailsOptions) appendToResponse() string {
+	names := tvAppendNames.names(uint32(opt.AppendToResponse))
+	for _, season := range opt.AppendSeasons {
+		names = append(names, fmt.Sprintf("%s%d", seasonAppendPrefix, season))
+	}
+	return strings.Join(names, ",")
+}
+
+// checkAppendSeasons checks the appended seasons, which count towards the limit of appended sub-resources.
+func (fe *fieldErrors) checkAppendSeasons(appendToResponse TVAppend, seasons []int) {
+	seen := make(map[int]bool, len(seasons))
+	for _, season := range seasons {
+		if season < 0 {
+			fe.add("append_to_response", season, "season number must not be negative")
+		} else if seen[season] {
+			fe.add("append_to_response", season, "season is appended more than once")
+		}
+		seen[season] = true
+	}
+	if count := bits.OnesCount32(uint32(appendToResponse)) + len(seasons); count > MaxAppendToResponse {
+		fe.add("append_to_response", count, "must not append more than %d items", MaxAppendToResponse)
+	}
+}
+
+// withAppendedSeasons adds the seasons to append to the sub-resources in the query params.
+func withAppendedSeasons(opt *TVShowDetailsOptions) RequestOptionFn {
+	return func(r *resty.Request) error {
+		if opt == nil || len(opt.AppendSeasons) == 0 {
+			return nil
+		}
+		r.SetQueryParam("append_to_response", opt.appendToResponse())
+		return nil
+	}
+}
@@ -0,0 +1,136 @@
+package tmdb_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/mdvalv/go-tmdb"
+	"github.com/mdvalv/go-tmdb/tmdbtest"
+)
+
+func TestAppendString(t *testing.T) {
+	tests := []struct {
+		name    string
+		flags   fmt.Stringer
+		want    string
+		wantErr bool
+	}{
+		{name: "no movie flags", flags: tmdb.MovieAppend(0), want: ""},
+		{name: "single movie flag", flags: tmdb.MovieAppendCredits, want: "credits"},
+		{name: "movie flags in bit order", flags: tmdb.MovieAppendImages | tmdb.MovieAppendAlternativeTitles, want: "alternative_titles,images"},
+		{name: "tv flags", flags: tmdb.TVAppendAggregateCredits | tmdb.TVAppendVideos, want: "aggregate_credits,videos"},
+		{name: "person flags", flags: tmdb.PersonAppendCombinedCredits | tmdb.PersonAppendTVCredits, want: "combined_credits,tv_credits"},
+		{name: "unknown flags", flags: tmdb.PersonAppendChanges | tmdb.PersonAppend(1<<20), want: "changes,0x100000", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.flags.String(); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+			err := tt.flags.(interface{ Validate() error }).Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %t", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAppendToResponse(t *testing.T) {
+	seasons := make([]int, tmdb.MaxAppendToResponse)
+	seasonNames := make([]string, tmdb.MaxAppendToResponse)
+	for i := range seasons {
+		seasons[i], seasonNames[i] = i, fmt.Sprintf("season/%d", i)
+	}
+	tests := []struct {
+		name       string
+		opt        *tmdb.TVShowDetailsOptions
+		want       string
+		wantFields []string
+	}{
+		{name: "flags", opt: &tmdb.TVShowDetailsOptions{AppendToResponse: tmdb.TVAppendCredits | tmdb.TVAppendImages}, want: "credits,images"},
+		{name: "seasons", opt: &tmdb.TVShowDetailsOptions{AppendSeasons: []int{1, 2}}, want: "season/1,season/2"},
+		{
+			name: "flags and seasons",
+			opt:  &tmdb.TVShowDetailsOptions{AppendToResponse: tmdb.TVAppendCredits, AppendSeasons: []int{1}},
+			want: "credits,season/1",
+		},
+		{name: "unknown flags", opt: &tmdb.TVShowDetailsOptions{AppendToResponse: tmdb.TVAppend(1 << 30)}, wantFields: []string{"append_to_response"}},
+		{name: "limit", opt: &tmdb.TVShowDetailsOptions{AppendSeasons: seasons}, want: strings.Join(seasonNames, ",")},
+		{name: "duplicated season", opt: &tmdb.TVShowDetailsOptions{AppendSeasons: []int{1, 1}}, wantFields: []string{"append_to_response"}},
+		{name: "negative season", opt: &tmdb.TVShowDetailsOptions{AppendSeasons: []int{-1}}, wantFields: []string{"append_to_response"}},
+		{
+			name:       "more than the limit",
+			opt:        &tmdb.TVShowDetailsOptions{AppendToResponse: tmdb.TVAppendCredits, AppendSeasons: seasons},
+			wantFields: []string{"append_to_response"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := tmdbtest.NewServer()
+			defer server.Close()
+			client, err := server.Client()
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, _, err = client.TV.GetTVShow(tmdbtest.TVShowID, tt.opt)
+			if got := fmt.Sprint(invalidFields(err)); got != fmt.Sprint(tt.wantFields) {
+				t.Fatalf("got invalid fields %s, want %v (error %v)", got, tt.wantFields, err)
+			}
+			if len(tt.wantFields) > 0 {
+				return
+			}
+			requests := server.Requests()
+			if len(requests) != 1 {
+				t.Fatalf("got %d requests, want 1", len(requests))
+			}
+			if got := requests[0].Query.Get("append_to_response"); got != tt.want {
+				t.Errorf("got append_to_response %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTVShowDetailsSeasonDetails(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		wantSeasons []int
+	}{
+		{name: "without appended seasons", data: `{"id": 1399, "seasons": [{"season_number": 1}, {"season_number": 2}]}`},
+		{
+			name:        "appended seasons",
+			data:        `{"id": 1399, "seasons": [{"season_number": 1}, {"season_number": 2}], "season/1": {"season_number": 1}, "season/2": {"season_number": 2}}`,
+			wantSeasons: []int{1, 2},
+		},
+		{name: "unknown season key", data: `{"id": 1399, "season/latest": {"season_number": 3}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var tv tmdb.TVShowDetails
+			if err := json.Unmarshal([]byte(tt.data), &tv); err != nil {
+				t.Fatal(err)
+			}
+			if tv.ID != tmdbtest.TVShowID {
+				t.Errorf("got id %d, want %d", tv.ID, tmdbtest.TVShowID)
+			}
+			if len(tv.SeasonDetails) != len(tt.wantSeasons) {
+				t.Fatalf("got %d season details, want %d", len(tv.SeasonDetails), len(tt.wantSeasons))
+			}
+			for _, seasonNumber := range tt.wantSeasons {
+				if season := tv.SeasonDetails[seasonNumber]; season == nil || season.SeasonNumber != seasonNumber {
+					t.Errorf("got season details %+v for season %d", season, seasonNumber)
+				}
+			}
+		})
+	}
+
+	data, err := json.Marshal(tmdb.TVShowDetailsOptions{AppendSeasons: []int{1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "season") {
+		t.Errorf("got appended seasons in %s", data)
+	}
+}
@@ -13,31 +13,31 @@ type AuthenticationResource struct {
 
 // AuthToken represents the authentication object in TMDb.
 type AuthToken struct {
-	ExpiresAt    string `json:"expires_at"`
-	RequestToken string `json:"request_token"`
-	Success      bool   `json:"success"`
+	ExpiresAt    Timestamp `json:"expires_at"`
+	RequestToken string    `json:"request_token"`
+	Success      bool      `json:"success"`
 }
 
 // CreateRequestToken creates a temporary request token that can be used to validate a TMDB user login.
-func (ar *AuthenticationResource) CreateRequestToken() (*AuthToken, *http.Response, error) {
+func (ar *AuthenticationResource) CreateRequestToken(options ...RequestOptionFn) (*AuthToken, *http.Response, error) {
 	path := "/authentication/token/new"
 	var response AuthToken
-	resp, err := ar.client.get(path, &response)
+	resp, err := ar.client.get(path, &response, withOptions(options...))
 	return &response, resp, errors.Wrap(err, "failed to get request token")
 }
 
 // GuestSession represents a guest session object in TMDb.
 type GuestSession struct {
-	ExpiresAt      string `json:"expires_at"`
-	GuestSessionID string `json:"guest_session_id"`
-	Success        bool   `json:"success"`
+	ExpiresAt      Timestamp `json:"expires_at"`
+	GuestSessionID string    `json:"guest_session_id"`
+	Success        bool      `json:"success"`
 }
 
 // CreateGuestSession creates a new guest session.
-func (ar *AuthenticationResource) CreateGuestSession() (*GuestSession, *http.Response, error) {
+func (ar *AuthenticationResource) CreateGuestSession(options ...RequestOptionFn) (*GuestSession, *http.Response, error) {
 	path := "/authentication/guest_session/new"
 	var session GuestSession
-	resp, err := ar.client.get(path, &session)
+	resp, err := ar.client.get(path, &session, withOptions(options...))
 	return &session, resp, errors.Wrap(err, "failed to get guest session")
 }
 
@@ -47,40 +47,80 @@ type Session struct {
 	Success   bool   `json:"success"`
 }
 
+// newSession represents the body of session creation requests.
+type newSession struct {
+	RequestToken string `json:"request_token"`
+}
+
+// Validate checks that the request token is set.
+func (ns newSession) Validate() error {
+	var errs fieldErrors
+	errs.checkRequired("request_token", ns.RequestToken)
+	return errs.err()
+}
+
 // CreateSession creates a fully valid session ID once a user has validated the request token.
-func (ar *AuthenticationResource) CreateSession(requestToken string) (*Session, *http.Response, error) {
+func (ar *AuthenticationResource) CreateSession(requestToken string, options ...RequestOptionFn) (*Session, *http.Response, error) {
 	path := "/authentication/session/new"
-	opt := map[string]string{
-		"request_token": requestToken,
+	opt := newSession{
+		RequestToken: requestToken,
 	}
 	var session Session
-	resp, err := ar.client.post(path, &session, WithBody(opt))
+	resp, err := ar.client.post(path, &session, WithBody(opt), withOptions(options...))
 	return &session, resp, errors.Wrap(err, "failed to get session")
 }
 
+// login represents the body of login requests.
+type login struct {
+	Username     string `json:"username"`
+	Password     string `json:"password"`
+	RequestToken string `json:"request_token"`
+}
+
+// Validate checks that the credentials are set.
+func (l login) Validate() error {
+	var errs fieldErrors
+	errs.checkRequired("username", l.Username)
+	errs.checkRequired("password", l.Password)
+	errs.checkRequired("request_token", l.RequestToken)
+	return errs.err()
+}
+
 // ValidateRequestToken allows an application to validate a request token by entering a username and password.
-func (ar *AuthenticationResource) ValidateRequestToken(username, password, requestToken string) (*AuthToken, *http.Response, error) {
+func (ar *AuthenticationResource) ValidateRequestToken(username, password, requestToken string, options ...RequestOptionFn) (*AuthToken, *http.Response, error) {
 	path := "/authentication/token/validate_with_login"
-	opt := map[string]string{
-		"request_token": requestToken,
-		"username":      username,
-		"password":      password,
+	opt := login{
+		Username:     username,
+		Password:     password,
+		RequestToken: requestToken,
 	}
 	var session AuthToken
-	resp, err := ar.client.post(path, &session, WithBody(opt))
+	resp, err := ar.client.post(path, &session, WithBody(opt), withOptions(options...))
 	return &session, resp, errors.Wrap(err, "failed to get session")
 }
 
+// v4Session represents the body of session conversion requests.
+type v4Session struct {
+	AccessToken string `json:"access_token"`
+}
+
+// Validate checks that the access token is set.
+func (vs v4Session) Validate() error {
+	var errs fieldErrors
+	errs.checkRequired("access_token", vs.AccessToken)
+	return errs.err()
+}
+
 // CreateSessionWithV4Token creates a v3 session ID from a valid v4 access token.
 // The v4 token needs to be authenticated by the user.
 // The standard "read token" will not validate to create a session ID.
-func (ar *AuthenticationResource) CreateSessionWithV4Token(accessToken string) (*Session, *http.Response, error) {
+func (ar *AuthenticationResource) CreateSessionWithV4Token(accessToken string, options ...RequestOptionFn) (*Session, *http.Response, error) {
 	path := "/authentication/session/convert/4"
-	opt := map[string]string{
-		"access_token": accessToken,
+	opt := v4Session{
+		AccessToken: accessToken,
 	}
 	var session Session
-	resp, err := ar.client.post(path, &session, WithBody(opt))
+	resp, err := ar.client.post(path, &session, WithBody(opt), withOptions(options...))
 	return &session, resp, errors.Wrap(err, "failed to get session")
 }
 
@@ -89,13 +129,25 @@ type DeleteSessionResponse struct {
 	Success bool `json:"success"`
 }
 
+// sessionDeletion represents the body of session deletion requests.
+type sessionDeletion struct {
+	SessionID string `json:"session_id"`
+}
+
+// Validate checks that the session ID is set.
+func (sd sessionDeletion) Validate() error {
+	var errs fieldErrors
+	errs.checkRequired("session_id", sd.SessionID)
+	return errs.err()
+}
+
 // DeleteSession deletes (or "logout") from a session.
-func (ar *AuthenticationResource) DeleteSession(sessionID string) (*DeleteSessionResponse, *http.Response, error) {
+func (ar *AuthenticationResource) DeleteSession(sessionID string, options ...RequestOptionFn) (*DeleteSessionResponse, *http.Response, error) {
 	path := "/authentication/session"
-	opt := map[string]string{
-		"session_id": sessionID,
+	opt := sessionDeletion{
+		SessionID: sessionID,
 	}
 	var deleteResponse DeleteSessionResponse
-	resp, err := ar.client.delete(path, &deleteResponse, WithBody(opt))
+	resp, err := ar.client.delete(path, &deleteResponse, WithBody(opt), withOptions(options...))
 	return &deleteResponse, resp, errors.Wrap(err, "failed to delete session")
 }
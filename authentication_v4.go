@@ -0,0 +1,71 @@
+package tmdb
+
+import (
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// AuthenticationV4Resource handles v4 authentication-related requests of TMDb API.
+// These requests require the client to be created with a v4 API Read Access Token.
+type AuthenticationV4Resource struct {
+	client *Client
+}
+
+// RequestTokenV4 represents a v4 request token in TMDb.
+type RequestTokenV4 struct {
+	RequestToken  string `json:"request_token"`
+	StatusCode    int    `json:"status_code"`
+	StatusMessage string `json:"status_message"`
+	Success       bool   `json:"success"`
+}
+
+// CreateRequestToken creates a new request token that can be approved by a user.
+// The user must be sent to https://www.themoviedb.org/auth/access?request_token={request_token} to approve it.
+// Once approved, the user is redirected to redirectTo, if provided, and the token can be exchanged
+// for an access token with CreateAccessToken. Request tokens expire after 15 minutes.
+func (ar *AuthenticationV4Resource) CreateRequestToken(redirectTo string, options ...RequestOptionFn) (*RequestTokenV4, *http.Response, error) {
+	path := ar.client.urlV4("/auth/request_token")
+	body := map[string]string{}
+	if redirectTo != "" {
+		body["redirect_to"] = redirectTo
+	}
+	var token RequestTokenV4
+	resp, err := ar.client.post(path, &token, WithBody(body), withOptions(options...))
+	return &token, resp, errors.Wrap(err, "failed to create request token")
+}
+
+// AccessToken represents a v4 user access token in TMDb.
+type AccessToken struct {
+	AccessToken   string `json:"access_token"`
+	AccountID     string `json:"account_id"`
+	StatusCode    int    `json:"status_code"`
+	StatusMessage string `json:"status_message"`
+	Success       bool   `json:"success"`
+}
+
+// CreateAccessToken creates an access token from a request token approved by the user.
+// The access token does not expire and must be sent with the user requests, see WithAccessToken.
+func (ar *AuthenticationV4Resource) CreateAccessToken(requestToken string, options ...RequestOptionFn) (*AccessToken, *http.Response, error) {
+	path := ar.client.urlV4("/auth/access_token")
+	body := map[string]string{
+		"request_token": requestToken,
+	}
+	var token AccessToken
+	resp, err := ar.client.post(path, &token, WithBody(body), withOptions(options...))
+	return &token, resp, errors.Wrap(err, "failed to create access token")
+}
+
+// DeleteAccessTokenResponse represents the response for deleting an access token.
+type DeleteAccessTokenResponse statusResponse
+
+// DeleteAccessToken logs out of a session by deleting its access token.
+func (ar *AuthenticationV4Resource) DeleteAccessToken(accessToken string, options ...RequestOptionFn) (*DeleteAccessTokenResponse, *http.Response, error) {
+	path := ar.client.urlV4("/auth/access_token")
+	body := map[string]string{
+		"access_token": accessToken,
+	}
+	var response DeleteAccessTokenResponse
+	resp, err := ar.client.delete(path, &response, WithBody(body), withOptions(options...))
+	return &response, resp, errors.Wrap(err, "failed to delete access token")
+}
@@ -0,0 +1,228 @@
+package tmdb
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultBatchConcurrency is the default maximum number of concurrent requests of a batch.
+const DefaultBatchConcurrency = 4
+
+// BatchOptions represents the options of batch requests.
+// The context of batch requests may be nil, in which case context.Background() is used.
+type BatchOptions struct {
+	// Maximum number of concurrent requests, DefaultBatchConcurrency if not set.
+	Concurrency int
+
+	// Maximum number of requests started per second, unlimited if not set.
+	// It applies to the batch only, on top of the rate limit of the client, if any.
+	RequestsPerSecond float64
+}
+
+// Validate checks the batch options.
+func (bo BatchOptions) Validate() error {
+	var errs fieldErrors
+	if bo.Concurrency < 0 {
+		errs.add("concurrency", bo.Concurrency, "must not be negative")
+	}
+	if bo.RequestsPerSecond < 0 {
+		errs.add("requests_per_second", bo.RequestsPerSecond, "must not be negative")
+	}
+	return errs.err()
+}
+
+// concurrency retrieves the maximum number of concurrent requests.
+func (bo BatchOptions) concurrency() int {
+	if bo.Concurrency == 0 {
+		return DefaultBatchConcurrency
+	}
+	return bo.Concurrency
+}
+
+// BatchResult represents the result of a batch request for a single id.
+type BatchResult[T any] struct {
+	ID       int
+	Value    T // set when there is no error
+	Response *http.Response
+	Err      error
+}
+
+// batchFetcher fetches a single item of a batch.
+type batchFetcher[T any] func(id int, options ...RequestOptionFn) (T, *http.Response, error)
+
+// streamBatch fetches the items with the given ids, sending the results as they come.
+// Duplicated ids are fetched once. Ids not fetched before the context is done get its error.
+// The channel is closed once every id has a result. A nil context is treated as context.Background().
+func streamBatch[T any](ctx context.Context, ids []int, batch BatchOptions, fetch batchFetcher[T], options []RequestOptionFn) <-chan BatchResult[T] {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ids = uniqueIDs(ids)
+	concurrency := batch.concurrency()
+	var limiter *rateLimiter
+	if batch.RequestsPerSecond > 0 {
+		limiter = newRateLimiter(batch.RequestsPerSecond, 1)
+	}
+	options = append(append([]RequestOptionFn{}, options...), WithContext(ctx))
+
+	results := make(chan BatchResult[T], concurrency)
+	go func() {
+		defer close(results)
+		var wg sync.WaitGroup
+		sem := make(chan struct{}, concurrency)
+		for _, id := range ids {
+			err := ctx.Err()
+			if err == nil {
+				select {
+				case sem <- struct{}{}:
+				case <-ctx.Done():
+					err = ctx.Err()
+				}
+			}
+			if err == nil && limiter != nil {
+				if err = limiter.Wait(ctx); err != nil {
+					<-sem
+				}
+			}
+			if err != nil {
+				results <- BatchResult[T]{ID: id, Err: err}
+				continue
+			}
+
+			wg.Add(1)
+			go func(id int) {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+				value, resp, err := fetch(id, options...)
+				if err != nil {
+					var zero T
+					value = zero
+				}
+				results <- BatchResult[T]{ID: id, Value: value, Response: resp, Err: err}
+			}(id)
+		}
+		wg.Wait()
+	}()
+	return results
+}
+
+// collectBatch fetches the items with the given ids, returning the results in the order of the ids.
+func collectBatch[T any](ctx context.Context, ids []int, batch BatchOptions, fetch batchFetcher[T], options []RequestOptionFn) []BatchResult[T] {
+	ids = uniqueIDs(ids)
+	index := make(map[int]int, len(ids))
+	for i, id := range ids {
+		index[id] = i
+	}
+	results := make([]BatchResult[T], len(ids))
+	for result := range streamBatch(ctx, ids, batch, fetch, options) {
+		results[index[result.ID]] = result
+	}
+	return results
+}
+
+// uniqueIDs removes duplicated ids, keeping the first occurrence of each one.
+func uniqueIDs(ids []int) []int {
+	seen := make(map[int]bool, len(ids))
+	unique := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
+
+// validateBatch checks the batch options and the options of each request.
+func validateBatch(batch BatchOptions, opt interface{}) error {
+	if err := batch.Validate(); err != nil {
+		return errors.Wrap(err, "invalid batch options")
+	}
+	return errors.Wrap(validateOptions(opt), "invalid request query params")
+}
+
+// GetMany retrieves the primary information about many movies, with concurrent requests.
+// Results are returned in the order of the ids, without duplicates, each with its own error.
+// The error is only returned for invalid options, before sending any request.
+func (mr *MoviesResource) GetMany(ctx context.Context, movieIDs []int, opt *MovieDetailsOptions, batch BatchOptions, options ...RequestOptionFn) ([]BatchResult[*MovieDetails], error) {
+	if err := validateBatch(batch, opt); err != nil {
+		return nil, errors.Wrap(err, "failed to get movies")
+	}
+	return collectBatch(ctx, movieIDs, batch, mr.batchFetcher(opt), options), nil
+}
+
+// StreamMany retrieves the primary information about many movies, with concurrent requests.
+// Results are sent as they come, without duplicates, each with its own error.
+// The channel is closed after the last result and must be drained, even when the context is done.
+// The error is only returned for invalid options, before sending any request.
+func (mr *MoviesResource) StreamMany(ctx context.Context, movieIDs []int, opt *MovieDetailsOptions, batch BatchOptions, options ...RequestOptionFn) (<-chan BatchResult[*MovieDetails], error) {
+	if err := validateBatch(batch, opt); err != nil {
+		return nil, errors.Wrap(err, "failed to get movies")
+	}
+	return streamBatch(ctx, movieIDs, batch, mr.batchFetcher(opt), options), nil
+}
+
+func (mr *MoviesResource) batchFetcher(opt *MovieDetailsOptions) batchFetcher[*MovieDetails] {
+	return func(movieID int, options ...RequestOptionFn) (*MovieDetails, *http.Response, error) {
+		return mr.GetMovie(movieID, opt, options...)
+	}
+}
+
+// GetMany retrieves the primary details of many people, with concurrent requests.
+// Results are returned in the order of the ids, without duplicates, each with its own error.
+// The error is only returned for invalid options, before sending any request.
+func (pr *PeopleResource) GetMany(ctx context.Context, personIDs []int, opt *PersonDetailsOptions, batch BatchOptions, options ...RequestOptionFn) ([]BatchResult[*PersonDetails], error) {
+	if err := validateBatch(batch, opt); err != nil {
+		return nil, errors.Wrap(err, "failed to get people")
+	}
+	return collectBatch(ctx, personIDs, batch, pr.batchFetcher(opt), options), nil
+}
+
+// StreamMany retrieves the primary details of many people, with concurrent requests.
+// Results are sent as they come, without duplicates, each with its own error.
+// The channel is closed after the last result and must be drained, even when the context is done.
+// The error is only returned for invalid options, before sending any request.
+func (pr *PeopleResource) StreamMany(ctx context.Context, personIDs []int, opt *PersonDetailsOptions, batch BatchOptions, options ...RequestOptionFn) (<-chan BatchResult[*PersonDetails], error) {
+	if err := validateBatch(batch, opt); err != nil {
+		return nil, errors.Wrap(err, "failed to get people")
+	}
+	return streamBatch(ctx, personIDs, batch, pr.batchFetcher(opt), options), nil
+}
+
+func (pr *PeopleResource) batchFetcher(opt *PersonDetailsOptions) batchFetcher[*PersonDetails] {
+	return func(personID int, options ...RequestOptionFn) (*PersonDetails, *http.Response, error) {
+		return pr.GetPerson(personID, opt, options...)
+	}
+}
+
+// GetMany retrieves the primary details of many TV shows, with concurrent requests.
+// Results are returned in the order of the ids, without duplicates, each with its own error.
+// The error is only returned for invalid options, before sending any request.
+func (tr *TVResource) GetMany(ctx context.Context, tvIDs []int, opt *TVShowDetailsOptions, batch BatchOptions, options ...RequestOptionFn) ([]BatchResult[*TVShowDetails], error) {
+	if err := validateBatch(batch, opt); err != nil {
+		return nil, errors.Wrap(err, "failed to get tv shows")
+	}
+	return collectBatch(ctx, tvIDs, batch, tr.batchFetcher(opt), options), nil
+}
+
+// StreamMany retrieves the primary details of many TV shows, with concurrent requests.
+// Results are sent as they come, without duplicates, each with its own error.
+// The channel is closed after the last result and must be drained, even when the context is done.
+// The error is only returned for invalid options, before sending any request.
+func (tr *TVResource) StreamMany(ctx context.Context, tvIDs []int, opt *TVShowDetailsOptions, batch BatchOptions, options ...RequestOptionFn) (<-chan BatchResult[*TVShowDetails], error) {
+	if err := validateBatch(batch, opt); err != nil {
+		return nil, errors.Wrap(err, "failed to get tv shows")
+	}
+	return streamBatch(ctx, tvIDs, batch, tr.batchFetcher(opt), options), nil
+}
+
+func (tr *TVResource) batchFetcher(opt *TVShowDetailsOptions) batchFetcher[*TVShowDetails] {
+	return func(tvID int, options ...RequestOptionFn) (*TVShowDetails, *http.Response, error) {
+		return tr.GetTVShow(tvID, opt, options...)
+	}
+}
@@ -0,0 +1,215 @@
+package tmdb_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mdvalv/go-tmdb"
+	"github.com/mdvalv/go-tmdb/tmdbtest"
+)
+
+func TestGetMany(t *testing.T) {
+	tests := []struct {
+		name         string
+		ids          []int
+		batch        tmdb.BatchOptions
+		wantIDs      []int
+		wantNotFound []int
+	}{
+		{name: "in the order of the ids", ids: []int{603, tmdbtest.MovieID, 604}, wantIDs: []int{603, tmdbtest.MovieID, 604}},
+		{name: "duplicated ids are fetched once", ids: []int{603, 603, 604, 603}, wantIDs: []int{603, 604}},
+		{name: "failures are per id", ids: []int{603, 1, 604}, wantIDs: []int{603, 1, 604}, wantNotFound: []int{1}},
+		{name: "single request at a time", ids: []int{603, 604, 605}, batch: tmdb.BatchOptions{Concurrency: 1}, wantIDs: []int{603, 604, 605}},
+		{name: "no ids", ids: nil, wantIDs: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := tmdbtest.NewServer()
+			defer server.Close()
+			for _, id := range []int{603, 604, 605} {
+				if err := server.Handle(http.MethodGet, fmt.Sprintf("/3/movie/%d", id), http.StatusOK, tmdb.MovieDetails{ID: id}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			client, err := server.Client()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			results, err := client.Movies.GetMany(context.Background(), tt.ids, nil, tt.batch)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ids := []int{}
+			notFound := []int{}
+			for _, result := range results {
+				ids = append(ids, result.ID)
+				switch {
+				case tmdb.IsNotFound(result.Err):
+					notFound = append(notFound, result.ID)
+					if result.Value != nil {
+						t.Errorf("got value %+v for failed id %d", result.Value, result.ID)
+					}
+				case result.Err != nil:
+					t.Errorf("unexpected error %v for id %d", result.Err, result.ID)
+				case result.Value.ID != result.ID:
+					t.Errorf("got movie %d for id %d", result.Value.ID, result.ID)
+				}
+			}
+			if fmt.Sprint(ids) != fmt.Sprint(tt.wantIDs) {
+				t.Errorf("got ids %v, want %v", ids, tt.wantIDs)
+			}
+			if fmt.Sprint(notFound) != fmt.Sprint(tt.wantNotFound) {
+				t.Errorf("got not found ids %v, want %v", notFound, tt.wantNotFound)
+			}
+			if got := len(server.Requests()); got != len(tt.wantIDs) {
+				t.Errorf("got %d requests, want %d", got, len(tt.wantIDs))
+			}
+		})
+	}
+}
+
+func TestStreamManyConcurrency(t *testing.T) {
+	tests := []struct {
+		name            string
+		batch           tmdb.BatchOptions
+		wantConcurrency int32
+		wantMinDuration time.Duration
+	}{
+		{name: "default concurrency", wantConcurrency: tmdb.DefaultBatchConcurrency},
+		{name: "concurrency", batch: tmdb.BatchOptions{Concurrency: 2}, wantConcurrency: 2},
+		{name: "requests per second", batch: tmdb.BatchOptions{RequestsPerSecond: 50}, wantConcurrency: tmdb.DefaultBatchConcurrency, wantMinDuration: 90 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := tmdbtest.NewServer()
+			defer server.Close()
+			var running, maxRunning int32
+			ids := make([]int, 6)
+			for i := range ids {
+				ids[i] = tmdbtest.PersonID + i
+				server.HandleFunc(http.MethodGet, fmt.Sprintf("/3/person/%d", ids[i]), func(w http.ResponseWriter, r *http.Request) {
+					n := atomic.AddInt32(&running, 1)
+					defer atomic.AddInt32(&running, -1)
+					for max := atomic.LoadInt32(&maxRunning); n > max; max = atomic.LoadInt32(&maxRunning) {
+						if atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+							break
+						}
+					}
+					time.Sleep(10 * time.Millisecond)
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = w.Write([]byte(`{}`))
+				})
+			}
+			client, err := server.Client()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			start := time.Now()
+			stream, err := client.People.StreamMany(context.Background(), ids, nil, tt.batch)
+			if err != nil {
+				t.Fatal(err)
+			}
+			count := 0
+			for result := range stream {
+				if result.Err != nil {
+					t.Errorf("unexpected error %v for id %d", result.Err, result.ID)
+				}
+				count++
+			}
+			if count != len(ids) {
+				t.Errorf("got %d results, want %d", count, len(ids))
+			}
+			if got := atomic.LoadInt32(&maxRunning); got > tt.wantConcurrency {
+				t.Errorf("got %d concurrent requests, want at most %d", got, tt.wantConcurrency)
+			}
+			if elapsed := time.Since(start); elapsed < tt.wantMinDuration {
+				t.Errorf("got %v for %d requests, want at least %v", elapsed, len(ids), tt.wantMinDuration)
+			}
+		})
+	}
+}
+
+func TestGetManyCanceledContext(t *testing.T) {
+	server := tmdbtest.NewServer()
+	defer server.Close()
+	client, err := server.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results, err := client.TV.GetMany(ctx, []int{tmdbtest.TVShowID, 1400}, nil, tmdb.BatchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2", len(results))
+	}
+	for _, result := range results {
+		if result.Err != context.Canceled {
+			t.Errorf("got error %v for id %d, want %v", result.Err, result.ID, context.Canceled)
+		}
+	}
+	if got := len(server.Requests()); got != 0 {
+		t.Errorf("got %d requests with a canceled context", got)
+	}
+}
+
+func TestGetManyNilContext(t *testing.T) {
+	server := tmdbtest.NewServer()
+	defer server.Close()
+	if err := server.Handle(http.MethodGet, "/3/person/287", http.StatusOK, tmdb.PersonDetails{ID: tmdbtest.PersonID}); err != nil {
+		t.Fatal(err)
+	}
+	client, err := server.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ctx context.Context
+	results, err := client.People.GetMany(ctx, []int{tmdbtest.PersonID}, nil, tmdb.BatchOptions{RequestsPerSecond: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].Err != nil {
+		t.Fatalf("got results %+v, want a single person", results)
+	}
+	if results[0].Value.ID != tmdbtest.PersonID {
+		t.Errorf("got person %d, want %d", results[0].Value.ID, tmdbtest.PersonID)
+	}
+}
+
+func TestGetManyInvalidOptions(t *testing.T) {
+	tests := []struct {
+		name  string
+		opt   *tmdb.MovieDetailsOptions
+		batch tmdb.BatchOptions
+	}{
+		{name: "negative concurrency", batch: tmdb.BatchOptions{Concurrency: -1}},
+		{name: "negative rate", batch: tmdb.BatchOptions{RequestsPerSecond: -1}},
+		{name: "invalid request options", opt: &tmdb.MovieDetailsOptions{Language: "english"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := tmdbtest.NewServer()
+			defer server.Close()
+			client, err := server.Client()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := client.Movies.GetMany(context.Background(), []int{tmdbtest.MovieID}, tt.opt, tt.batch); err == nil {
+				t.Error("got no error for invalid options")
+			}
+			if got := len(server.Requests()); got != 0 {
+				t.Errorf("got %d requests with invalid options", got)
+			}
+		})
+	}
+}
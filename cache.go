@@ -0,0 +1,248 @@
+package tmdb
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/pkg/errors"
+)
+
+// Cache stores responses of TMDb API, see WithCache.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get retrieves a response from the cache, if present.
+	Get(key string) (*CachedResponse, bool)
+
+	// Set stores a response in the cache.
+	Set(key string, response *CachedResponse)
+
+	// Delete removes a response from the cache.
+	Delete(key string)
+}
+
+// CachedResponse represents a response stored in a cache.
+type CachedResponse struct {
+	Body      []byte
+	ETag      string
+	Header    http.Header
+	ExpiresAt time.Time
+}
+
+// CacheRule represents how long the responses of an endpoint family can be cached.
+type CacheRule struct {
+	// Path prefix of the endpoints, e.g. /configuration matches /configuration/languages.
+	Prefix string
+
+	// How long a response is considered fresh.
+	// Stale responses are revalidated with their ETag, when TMDb sent one.
+	TTL time.Duration
+}
+
+// DefaultCacheRules caches the endpoints that rarely change.
+var DefaultCacheRules = []CacheRule{
+	{Prefix: "/configuration", TTL: 24 * time.Hour},
+	{Prefix: "/genre", TTL: 24 * time.Hour},
+	{Prefix: "/certification", TTL: 24 * time.Hour},
+	{Prefix: "/watch/providers", TTL: 24 * time.Hour},
+}
+
+// matches checks whether the rule applies to the path.
+func (r CacheRule) matches(path string) bool {
+	if !strings.HasPrefix(path, r.Prefix) {
+		return false
+	}
+	rest := path[len(r.Prefix):]
+	return rest == "" || strings.HasPrefix(rest, "/") || strings.HasSuffix(r.Prefix, "/")
+}
+
+// responseCache caches responses according to a set of rules.
+type responseCache struct {
+	cache Cache
+	rules []CacheRule
+}
+
+// ttl returns how long responses of the path can be cached, zero meaning they are not cached.
+func (rc *responseCache) ttl(path string) time.Duration {
+	for _, rule := range rc.rules {
+		if rule.matches(path) {
+			return rule.TTL
+		}
+	}
+	return 0
+}
+
+// cacheKey returns the key identifying the request in the cache.
+// The API key is left out so that it is never stored, and the bearer token is hashed,
+// so that requests with different credentials, such as user access tokens, do not share responses.
+func cacheKey(client *resty.Client, req *resty.Request, path string) string {
+	params := url.Values{}
+	for k, v := range client.QueryParam {
+		params[k] = v
+	}
+	for k, v := range req.QueryParam {
+		params[k] = v
+	}
+	params.Del("api_key")
+	key := path + "?" + params.Encode()
+	if authorization := authorization(client, req); authorization != "" {
+		sum := sha256.Sum256([]byte(authorization))
+		key += "#" + hex.EncodeToString(sum[:])
+	}
+	return key
+}
+
+// authorization returns the credentials sent in the Authorization header of the request, if any.
+// Like resty, the token of the request takes precedence over the token of the client.
+func authorization(client *resty.Client, req *resty.Request) string {
+	switch {
+	case req.Token != "":
+		return req.Token
+	case client.Token != "":
+		return client.Token
+	case req.Header.Get("Authorization") != "":
+		return req.Header.Get("Authorization")
+	default:
+		return client.Header.Get("Authorization")
+	}
+}
+
+// cachedGet performs a get request, serving fresh responses from the cache and revalidating stale ones.
+func (c *Client) cachedGet(req *resty.Request, path string, resource interface{}) (*http.Response, error) {
+	relativePath := strings.TrimPrefix(path, c.baseURLv4)
+	ttl := c.cache.ttl(relativePath)
+	if ttl <= 0 {
+		resp, err := req.Get(path)
+		return rawResponse(resp), errors.Wrap(err, "failed to execute request")
+	}
+
+	key := cacheKey(c.HTTPClient, req, path)
+	cached, ok := c.cache.cache.Get(key)
+	if ok && time.Now().Before(cached.ExpiresAt) {
+		return cached.httpResponse(), errors.Wrap(cached.decode(resource), "failed to decode cached response")
+	}
+	if ok && cached.ETag != "" {
+		req.SetHeader("If-None-Match", cached.ETag)
+	}
+
+	resp, err := req.Get(path)
+	if err != nil {
+		return rawResponse(resp), errors.Wrap(err, "failed to execute request")
+	}
+
+	if resp.StatusCode() == http.StatusNotModified && ok {
+		cached.ExpiresAt = time.Now().Add(ttl)
+		c.cache.cache.Set(key, cached)
+		return resp.RawResponse, errors.Wrap(cached.decode(resource), "failed to decode cached response")
+	}
+
+	c.cache.cache.Set(key, &CachedResponse{
+		Body:      resp.Body(),
+		ETag:      resp.Header().Get("ETag"),
+		Header:    resp.Header().Clone(),
+		ExpiresAt: time.Now().Add(ttl),
+	})
+	return resp.RawResponse, nil
+}
+
+// decode unmarshals the cached body into the resource.
+func (cr *CachedResponse) decode(resource interface{}) error {
+	return json.Unmarshal(cr.Body, resource)
+}
+
+// httpResponse builds an HTTP response from the cached response.
+func (cr *CachedResponse) httpResponse() *http.Response {
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        cr.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(cr.Body)),
+		ContentLength: int64(len(cr.Body)),
+	}
+}
+
+// MemoryCache is an in-memory cache that evicts the least recently used responses
+// once it holds more than its capacity.
+type MemoryCache struct {
+	mu       sync.Mutex
+	capacity int
+	items    map[string]*list.Element
+	order    *list.List
+}
+
+// memoryCacheItem represents an item of the memory cache.
+type memoryCacheItem struct {
+	key      string
+	response *CachedResponse
+}
+
+// NewMemoryCache returns a memory cache holding up to capacity responses.
+func NewMemoryCache(capacity int) *MemoryCache {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &MemoryCache{
+		capacity: capacity,
+		items:    make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// Get retrieves a response from the cache, if present.
+func (mc *MemoryCache) Get(key string) (*CachedResponse, bool) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	element, ok := mc.items[key]
+	if !ok {
+		return nil, false
+	}
+	mc.order.MoveToFront(element)
+	response := *element.Value.(*memoryCacheItem).response
+	return &response, true
+}
+
+// Set stores a response in the cache.
+func (mc *MemoryCache) Set(key string, response *CachedResponse) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	if element, ok := mc.items[key]; ok {
+		element.Value.(*memoryCacheItem).response = response
+		mc.order.MoveToFront(element)
+		return
+	}
+	mc.items[key] = mc.order.PushFront(&memoryCacheItem{key: key, response: response})
+	for mc.order.Len() > mc.capacity {
+		oldest := mc.order.Back()
+		mc.order.Remove(oldest)
+		delete(mc.items, oldest.Value.(*memoryCacheItem).key)
+	}
+}
+
+// Delete removes a response from the cache.
+func (mc *MemoryCache) Delete(key string) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	if element, ok := mc.items[key]; ok {
+		mc.order.Remove(element)
+		delete(mc.items, key)
+	}
+}
+
+// Len returns the number of responses in the cache.
+func (mc *MemoryCache) Len() int {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	return mc.order.Len()
+}
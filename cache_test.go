@@ -0,0 +1,229 @@
+package tmdb_test
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mdvalv/go-tmdb"
+	"github.com/mdvalv/go-tmdb/tmdbtest"
+)
+
+// recordingCache records the keys stored in a memory cache.
+type recordingCache struct {
+	*tmdb.MemoryCache
+	mu   sync.Mutex
+	keys []string
+}
+
+func (rc *recordingCache) Set(key string, response *tmdb.CachedResponse) {
+	rc.mu.Lock()
+	rc.keys = append(rc.keys, key)
+	rc.mu.Unlock()
+	rc.MemoryCache.Set(key, response)
+}
+
+func TestMemoryCache(t *testing.T) {
+	tests := []struct {
+		name     string
+		capacity int
+		ops      func(c *tmdb.MemoryCache)
+		wantKeys []string
+		gone     []string
+	}{
+		{
+			name:     "keeps up to capacity",
+			capacity: 2,
+			ops: func(c *tmdb.MemoryCache) {
+				c.Set("a", &tmdb.CachedResponse{})
+				c.Set("b", &tmdb.CachedResponse{})
+			},
+			wantKeys: []string{"a", "b"},
+		},
+		{
+			name:     "evicts the least recently set",
+			capacity: 2,
+			ops: func(c *tmdb.MemoryCache) {
+				c.Set("a", &tmdb.CachedResponse{})
+				c.Set("b", &tmdb.CachedResponse{})
+				c.Set("c", &tmdb.CachedResponse{})
+			},
+			wantKeys: []string{"b", "c"},
+			gone:     []string{"a"},
+		},
+		{
+			name:     "evicts the least recently read",
+			capacity: 2,
+			ops: func(c *tmdb.MemoryCache) {
+				c.Set("a", &tmdb.CachedResponse{})
+				c.Set("b", &tmdb.CachedResponse{})
+				c.Get("a")
+				c.Set("c", &tmdb.CachedResponse{})
+			},
+			wantKeys: []string{"a", "c"},
+			gone:     []string{"b"},
+		},
+		{
+			name:     "replacing does not evict",
+			capacity: 2,
+			ops: func(c *tmdb.MemoryCache) {
+				c.Set("a", &tmdb.CachedResponse{})
+				c.Set("b", &tmdb.CachedResponse{})
+				c.Set("a", &tmdb.CachedResponse{ETag: "2"})
+			},
+			wantKeys: []string{"a", "b"},
+		},
+		{
+			name:     "delete",
+			capacity: 2,
+			ops: func(c *tmdb.MemoryCache) {
+				c.Set("a", &tmdb.CachedResponse{})
+				c.Delete("a")
+			},
+			gone: []string{"a"},
+		},
+		{
+			name:     "capacity of at least 1",
+			capacity: 0,
+			ops: func(c *tmdb.MemoryCache) {
+				c.Set("a", &tmdb.CachedResponse{})
+				c.Set("b", &tmdb.CachedResponse{})
+			},
+			wantKeys: []string{"b"},
+			gone:     []string{"a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := tmdb.NewMemoryCache(tt.capacity)
+			tt.ops(cache)
+			if cache.Len() != len(tt.wantKeys) {
+				t.Errorf("got %d items, want %d", cache.Len(), len(tt.wantKeys))
+			}
+			for _, key := range tt.wantKeys {
+				if _, ok := cache.Get(key); !ok {
+					t.Errorf("missing %s", key)
+				}
+			}
+			for _, key := range tt.gone {
+				if _, ok := cache.Get(key); ok {
+					t.Errorf("got evicted %s", key)
+				}
+			}
+		})
+	}
+}
+
+func TestCachedGet(t *testing.T) {
+	const etag = `"tmdbtest"`
+	tests := []struct {
+		name            string
+		ttl             time.Duration
+		path            string
+		wantRequests    int
+		wantNotModified int
+	}{
+		{name: "fresh responses are served from the cache", ttl: time.Hour, path: "/configuration", wantRequests: 1},
+		{name: "stale responses are revalidated with their etag", ttl: time.Nanosecond, path: "/configuration", wantRequests: 3, wantNotModified: 2},
+		{name: "paths without rules are not cached", ttl: time.Hour, path: "/genre", wantRequests: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := tmdbtest.NewServer()
+			defer server.Close()
+			var mu sync.Mutex
+			requests, notModified := 0, 0
+			server.HandleFunc(http.MethodGet, "/3/configuration", func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				requests++
+				w.Header().Set("ETag", etag)
+				if r.Header.Get("If-None-Match") == etag {
+					notModified++
+					w.WriteHeader(http.StatusNotModified)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"change_keys": ["adult", "air_date"]}`))
+			})
+			cache := &recordingCache{MemoryCache: tmdb.NewMemoryCache(10)}
+			client, err := server.Client(tmdb.WithCache(cache, tmdb.CacheRule{Prefix: tt.path, TTL: tt.ttl}))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for i := 0; i < 3; i++ {
+				configuration, _, err := client.Configuration.GetAPIConfiguration()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(configuration.ChangeKeys) != 2 {
+					t.Errorf("request %d: got change keys %v", i, configuration.ChangeKeys)
+				}
+			}
+			if requests != tt.wantRequests {
+				t.Errorf("got %d requests, want %d", requests, tt.wantRequests)
+			}
+			if notModified != tt.wantNotModified {
+				t.Errorf("got %d not modified responses, want %d", notModified, tt.wantNotModified)
+			}
+			for _, key := range cache.keys {
+				if strings.Contains(key, tmdbtest.APIKey) {
+					t.Errorf("got api key in cache key %s", key)
+				}
+			}
+		})
+	}
+}
+
+func TestCachedGetCredentials(t *testing.T) {
+	server := tmdbtest.NewServer()
+	defer server.Close()
+	if err := server.Handle(http.MethodGet, "/4/list/1", http.StatusOK, tmdb.ListV4{ID: 1}); err != nil {
+		t.Fatal(err)
+	}
+	cache := &recordingCache{MemoryCache: tmdb.NewMemoryCache(10)}
+	client, err := server.ClientWithToken(tmdbtest.ReadAccessToken, tmdb.WithCache(cache, tmdb.CacheRule{Prefix: "/list", TTL: time.Hour}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, accessToken := range []string{"", tmdbtest.AccessToken, "", tmdbtest.AccessToken} {
+		if _, _, err := client.ListsV4.GetList(1, nil, tmdb.WithAccessToken(accessToken)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Responses are cached once per token, so the user access token does not get the response of the read access token.
+	if got := len(cache.keys); got != 2 {
+		t.Fatalf("got %d responses cached, want one per token", got)
+	}
+	if cache.keys[0] == cache.keys[1] {
+		t.Errorf("got the same cache key %s for different tokens", cache.keys[0])
+	}
+	for _, key := range cache.keys {
+		if strings.Contains(key, tmdbtest.ReadAccessToken) || strings.Contains(key, tmdbtest.AccessToken) {
+			t.Errorf("got token in cache key %s", key)
+		}
+	}
+}
+
+func TestWithCacheInvalidRules(t *testing.T) {
+	tests := []struct {
+		name  string
+		cache tmdb.Cache
+		rule  tmdb.CacheRule
+	}{
+		{name: "nil cache", rule: tmdb.CacheRule{Prefix: "/genre", TTL: time.Hour}},
+		{name: "relative prefix", cache: tmdb.NewMemoryCache(1), rule: tmdb.CacheRule{Prefix: "genre", TTL: time.Hour}},
+		{name: "zero ttl", cache: tmdb.NewMemoryCache(1), rule: tmdb.CacheRule{Prefix: "/genre"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tmdb.NewClient(tmdbtest.APIKey, tmdb.WithCache(tt.cache, tt.rule)); err == nil {
+				t.Error("got no error for an invalid cache")
+			}
+		})
+	}
+}
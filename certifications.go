@@ -56,17 +56,17 @@ type TVCertificationsResponse struct {
 }
 
 // GetMovieCertifications gets an up to date list of the officially supported movie certifications on TMDB.
-func (cr *CertificationsResource) GetMovieCertifications() (*MovieCertificationsResponse, *http.Response, error) {
+func (cr *CertificationsResource) GetMovieCertifications(options ...RequestOptionFn) (*MovieCertificationsResponse, *http.Response, error) {
 	path := "/certification/movie/list"
 	var certifications MovieCertificationsResponse
-	resp, err := cr.client.get(path, &certifications)
+	resp, err := cr.client.get(path, &certifications, options...)
 	return &certifications, resp, errors.Wrap(err, "failed to get movie certifications")
 }
 
 // GetTVCertifications gets an up to date list of the officially supported TV show certifications on TMDB.
-func (cr *CertificationsResource) GetTVCertifications() (*TVCertificationsResponse, *http.Response, error) {
+func (cr *CertificationsResource) GetTVCertifications(options ...RequestOptionFn) (*TVCertificationsResponse, *http.Response, error) {
 	path := "/certification/tv/list"
 	var certifications TVCertificationsResponse
-	resp, err := cr.client.get(path, &certifications)
+	resp, err := cr.client.get(path, &certifications, options...)
 	return &certifications, resp, errors.Wrap(err, "failed to get tv certifications")
 }
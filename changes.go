@@ -18,7 +18,7 @@ type ChangesOptions struct {
 type ChangeItem struct {
 	ID            string      `json:"id"`
 	Action        string      `json:"action"`
-	Time          string      `json:"time"`
+	Time          Timestamp   `json:"time"`
 	ISO6391       string      `json:"iso_639_1"`
 	ISO31661      string      `json:"iso_3166_1"`
 	OriginalValue interface{} `json:"original_value"`
@@ -44,6 +44,6 @@ type MediaChange struct {
 
 // MediaChanges represents media changes in TMDb.
 type MediaChanges struct {
-	pagination
+	Pagination
 	Changes []MediaChange `json:"results"`
 }
@@ -0,0 +1,251 @@
+package tmdb
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/pkg/errors"
+)
+
+var (
+	languagePattern = regexp.MustCompile(`^[a-z]{2}(-[A-Z]{2})?$`)
+	regionPattern   = regexp.MustCompile(`^[A-Z]{2}$`)
+)
+
+// clientConfig represents the configuration used to build a client.
+type clientConfig struct {
+	baseURL     string
+	httpClient  *http.Client
+	transport   http.RoundTripper
+	timeout     time.Duration
+	userAgent   string
+	language    string
+	region      string
+	authMethod  AuthMethod
+	rateLimiter *rateLimiter
+	retryPolicy *RetryPolicy
+	logger      resty.Logger
+	cache       *responseCache
+}
+
+// AuthMethod represents how the token is sent to TMDb API.
+type AuthMethod int
+
+const (
+	// AuthAuto sends JWT-shaped tokens (v4 API Read Access Tokens) as bearer tokens
+	// and any other token (v3 API keys) as the api_key query parameter.
+	AuthAuto AuthMethod = iota
+
+	// AuthAPIKey sends the token as the api_key query parameter.
+	AuthAPIKey
+
+	// AuthBearer sends the token in the Authorization header.
+	AuthBearer
+)
+
+// isJWT checks whether the token looks like a JSON Web Token.
+func isJWT(token string) bool {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return false
+	}
+	for _, part := range parts {
+		if part == "" {
+			return false
+		}
+		if _, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(part, "=")); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// useBearer checks whether the token must be sent in the Authorization header.
+func (c *clientConfig) useBearer(token string) bool {
+	switch c.authMethod {
+	case AuthAPIKey:
+		return false
+	case AuthBearer:
+		return true
+	default:
+		return isJWT(token)
+	}
+}
+
+// ClientOption can be used to customize the client.
+// Options are validated when the client is created.
+type ClientOption func(*clientConfig) error
+
+// WithBaseURL sets the base URL of the API, which defaults to BaseURL.
+// Useful to point the client to a proxy or a mock server.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *clientConfig) error {
+		u, err := url.Parse(baseURL)
+		if err != nil {
+			return errors.Wrap(err, "invalid base url")
+		}
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.Errorf("invalid base url %q: an absolute http(s) url is required", baseURL)
+		}
+		c.baseURL = strings.TrimSuffix(baseURL, "/")
+		return nil
+	}
+}
+
+// WithHTTPClient sets the underlying HTTP client, which can be used to
+// customize the transport (proxies, tracing, etc).
+// The client is copied, so that other options such as WithTimeout do not change it.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *clientConfig) error {
+		if httpClient == nil {
+			return errors.New("http client must not be nil")
+		}
+		c.httpClient = httpClient
+		return nil
+	}
+}
+
+// WithTransport sets the transport used to send requests, e.g. to record and replay responses in tests.
+// When combined with WithHTTPClient, it replaces the transport of the copy of that HTTP client.
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return func(c *clientConfig) error {
+		if transport == nil {
+			return errors.New("transport must not be nil")
+		}
+		c.transport = transport
+		return nil
+	}
+}
+
+// WithTimeout sets the time limit of each attempt of a request, retries getting their own time limit.
+// The total time of a request and its retries can be limited with WithContext.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *clientConfig) error {
+		if timeout <= 0 {
+			return errors.New("timeout must be positive")
+		}
+		c.timeout = timeout
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *clientConfig) error {
+		if strings.TrimSpace(userAgent) == "" {
+			return errors.New("user agent must not be empty")
+		}
+		c.userAgent = userAgent
+		return nil
+	}
+}
+
+// WithDefaultLanguage sets the language sent with every request,
+// unless the request options specify one.
+// The language is a ISO 639-1 value, optionally followed by a ISO 3166-1 value, e.g. en or en-US.
+func WithDefaultLanguage(language string) ClientOption {
+	return func(c *clientConfig) error {
+		if !languagePattern.MatchString(language) {
+			return errors.Errorf("invalid language %q: expected format like en or en-US", language)
+		}
+		c.language = language
+		return nil
+	}
+}
+
+// WithDefaultRegion sets the region sent with every request,
+// unless the request options specify one.
+// The region is an uppercase ISO 3166-1 code, e.g. US.
+func WithDefaultRegion(region string) ClientOption {
+	return func(c *clientConfig) error {
+		if !regionPattern.MatchString(region) {
+			return errors.Errorf("invalid region %q: expected uppercase ISO 3166-1 code like US", region)
+		}
+		c.region = region
+		return nil
+	}
+}
+
+// WithAuthMethod sets how the token is sent to TMDb API.
+// By default the method is detected from the token shape, see AuthAuto.
+func WithAuthMethod(method AuthMethod) ClientOption {
+	return func(c *clientConfig) error {
+		switch method {
+		case AuthAuto, AuthAPIKey, AuthBearer:
+			c.authMethod = method
+			return nil
+		}
+		return errors.Errorf("invalid auth method %d", method)
+	}
+}
+
+// WithRateLimit limits the number of requests sent to TMDb API.
+// Requests wait for their turn when more than burst requests are sent at once,
+// so that on average no more than requestsPerSecond are sent.
+func WithRateLimit(requestsPerSecond float64, burst int) ClientOption {
+	return func(c *clientConfig) error {
+		if requestsPerSecond <= 0 {
+			return errors.New("requests per second must be positive")
+		}
+		if burst < 1 {
+			return errors.New("burst must be at least 1")
+		}
+		c.rateLimiter = newRateLimiter(requestsPerSecond, burst)
+		return nil
+	}
+}
+
+// WithRetry retries failed requests according to the given policy.
+func WithRetry(policy RetryPolicy) ClientOption {
+	return func(c *clientConfig) error {
+		if err := policy.validate(); err != nil {
+			return errors.Wrap(err, "invalid retry policy")
+		}
+		c.retryPolicy = &policy
+		return nil
+	}
+}
+
+// WithLogger sets the logger of warnings, errors and debug output, which defaults to the standard error.
+// When retrying, the error of each failed attempt is logged, so a logger discarding errors
+// can be set to keep only the error returned after the last attempt.
+func WithLogger(logger resty.Logger) ClientOption {
+	return func(c *clientConfig) error {
+		if logger == nil {
+			return errors.New("logger must not be nil")
+		}
+		c.logger = logger
+		return nil
+	}
+}
+
+// WithCache caches the responses of get requests matching the given rules.
+// When no rules are given, DefaultCacheRules are used.
+func WithCache(cache Cache, rules ...CacheRule) ClientOption {
+	return func(c *clientConfig) error {
+		if cache == nil {
+			return errors.New("cache must not be nil")
+		}
+		if len(rules) == 0 {
+			rules = DefaultCacheRules
+		}
+		for _, rule := range rules {
+			if !strings.HasPrefix(rule.Prefix, "/") {
+				return errors.Errorf("invalid cache rule prefix %q: it must start with /", rule.Prefix)
+			}
+			if rule.TTL <= 0 {
+				return errors.Errorf("invalid cache rule ttl for %s: it must be positive", rule.Prefix)
+			}
+		}
+		c.cache = &responseCache{
+			cache: cache,
+			rules: append([]CacheRule(nil), rules...),
+		}
+		return nil
+	}
+}
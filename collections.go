@@ -21,7 +21,7 @@ type Part struct {
 	OriginalLanguage string  `json:"original_language"`
 	OriginalTitle    string  `json:"original_title"`
 	Overview         string  `json:"overview"`
-	ReleaseDate      string  `json:"release_date"`
+	ReleaseDate      Date    `json:"release_date"`
 	PosterPath       *string `json:"poster_path"`
 	Popularity       float64 `json:"popularity"`
 	Title            string  `json:"title"`
@@ -44,10 +44,10 @@ type Collection struct {
 type CollectionsOptions languageOptions
 
 // GetCollection retrieves collection details by id.
-func (cr *CollectionsResource) GetCollection(id int, opt *CollectionsOptions) (*Collection, *http.Response, error) {
+func (cr *CollectionsResource) GetCollection(id int, opt *CollectionsOptions, options ...RequestOptionFn) (*Collection, *http.Response, error) {
 	path := fmt.Sprintf("/collection/%d", id)
 	var collection Collection
-	resp, err := cr.client.get(path, &collection, WithQueryParams(opt))
+	resp, err := cr.client.get(path, &collection, WithQueryParams(opt), withOptions(options...))
 	return &collection, resp, errors.Wrap(err, "failed to get collection")
 }
 
@@ -76,10 +76,10 @@ type CollectionImages struct {
 }
 
 // GetImages retrieves the images for a collection by id.
-func (cr *CollectionsResource) GetImages(id int, opt *CollectionsOptions) (*CollectionImages, *http.Response, error) {
+func (cr *CollectionsResource) GetImages(id int, opt *CollectionsOptions, options ...RequestOptionFn) (*CollectionImages, *http.Response, error) {
 	path := fmt.Sprintf("/collection/%d/images", id)
 	var images CollectionImages
-	resp, err := cr.client.get(path, &images, WithQueryParams(opt))
+	resp, err := cr.client.get(path, &images, WithQueryParams(opt), withOptions(options...))
 	return &images, resp, errors.Wrap(err, "failed to get collection images")
 }
 
@@ -106,9 +106,9 @@ type CollectionTranslations struct {
 }
 
 // GetTranslations retrieves the list translations for a collection by id.
-func (cr *CollectionsResource) GetTranslations(id int, opt *CollectionsOptions) (*CollectionTranslations, *http.Response, error) {
+func (cr *CollectionsResource) GetTranslations(id int, opt *CollectionsOptions, options ...RequestOptionFn) (*CollectionTranslations, *http.Response, error) {
 	path := fmt.Sprintf("/collection/%d/translations", id)
 	var translations CollectionTranslations
-	resp, err := cr.client.get(path, &translations, WithQueryParams(opt))
+	resp, err := cr.client.get(path, &translations, WithQueryParams(opt), withOptions(options...))
 	return &translations, resp, errors.Wrap(err, "failed to get collection translations")
 }
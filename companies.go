@@ -32,10 +32,10 @@ type CompanyDetails struct {
 }
 
 // GetCompany retrieves company details by id.
-func (cr *CompaniesResource) GetCompany(id int) (*CompanyDetails, *http.Response, error) {
+func (cr *CompaniesResource) GetCompany(id int, options ...RequestOptionFn) (*CompanyDetails, *http.Response, error) {
 	path := fmt.Sprintf("/company/%d", id)
 	var company CompanyDetails
-	resp, err := cr.client.get(path, &company)
+	resp, err := cr.client.get(path, &company, options...)
 	return &company, resp, errors.Wrap(err, "failed to get company")
 }
 
@@ -52,10 +52,10 @@ type CompanyAlternativeNames struct {
 }
 
 // GetAlternativeNames retrieves the alternative names of a company.
-func (cr *CompaniesResource) GetAlternativeNames(id int) (*CompanyAlternativeNames, *http.Response, error) {
+func (cr *CompaniesResource) GetAlternativeNames(id int, options ...RequestOptionFn) (*CompanyAlternativeNames, *http.Response, error) {
 	path := fmt.Sprintf("/company/%d/alternative_names", id)
 	var names CompanyAlternativeNames
-	resp, err := cr.client.get(path, &names)
+	resp, err := cr.client.get(path, &names, options...)
 	return &names, resp, errors.Wrap(err, "failed to get company alternative names")
 }
 
@@ -79,9 +79,9 @@ type CompanyImages struct {
 
 // GetImages retrieves company logos by id.
 // There are two image formats that are supported for companies, PNG's and SVG's.
-func (cr *CompaniesResource) GetImages(id int) (*CompanyImages, *http.Response, error) {
+func (cr *CompaniesResource) GetImages(id int, options ...RequestOptionFn) (*CompanyImages, *http.Response, error) {
 	path := fmt.Sprintf("/company/%d/images", id)
 	var images CompanyImages
-	resp, err := cr.client.get(path, &images)
+	resp, err := cr.client.get(path, &images, options...)
 	return &images, resp, errors.Wrap(err, "failed to get company images")
 }
@@ -38,10 +38,10 @@ type Configuration struct {
 // https://image.tmdb.org/t/p/w500/8uO0gUM8aNqYLs1OsTBQiXu0fEv.jpg
 // The configuration method also contains the list of change keys which can be useful
 // if building an app that consumes data from the change feed.
-func (cr *ConfigurationResource) GetAPIConfiguration() (*Configuration, *http.Response, error) {
+func (cr *ConfigurationResource) GetAPIConfiguration(options ...RequestOptionFn) (*Configuration, *http.Response, error) {
 	path := "/configuration"
 	var configuration Configuration
-	resp, err := cr.client.get(path, &configuration)
+	resp, err := cr.client.get(path, &configuration, options...)
 	return &configuration, resp, errors.Wrap(err, "failed to get API configuration")
 }
 
@@ -52,10 +52,10 @@ type Countries []struct {
 }
 
 // GetCountries retrieves the list of countries (ISO 3166-1 tags) used throughout TMDB.
-func (cr *ConfigurationResource) GetCountries() (Countries, *http.Response, error) {
+func (cr *ConfigurationResource) GetCountries(options ...RequestOptionFn) (Countries, *http.Response, error) {
 	path := "/configuration/countries"
 	var countries Countries
-	resp, err := cr.client.get(path, &countries)
+	resp, err := cr.client.get(path, &countries, options...)
 	return countries, resp, errors.Wrap(err, "failed to get countries")
 }
 
@@ -66,10 +66,10 @@ type Jobs []struct {
 }
 
 // GetJobs retrieves a list of the jobs and departments used on TMDB.
-func (cr *ConfigurationResource) GetJobs() (Jobs, *http.Response, error) {
+func (cr *ConfigurationResource) GetJobs(options ...RequestOptionFn) (Jobs, *http.Response, error) {
 	path := "/configuration/jobs"
 	var jobs Jobs
-	resp, err := cr.client.get(path, &jobs)
+	resp, err := cr.client.get(path, &jobs, options...)
 	return jobs, resp, errors.Wrap(err, "failed to get jobs")
 }
 
@@ -81,10 +81,10 @@ type Languages []struct {
 }
 
 // GetLanguages retrieves the list of languages (ISO 639-1 tags) used throughout TMDB.
-func (cr *ConfigurationResource) GetLanguages() (Languages, *http.Response, error) {
+func (cr *ConfigurationResource) GetLanguages(options ...RequestOptionFn) (Languages, *http.Response, error) {
 	path := "/configuration/languages"
 	var languages Languages
-	resp, err := cr.client.get(path, &languages)
+	resp, err := cr.client.get(path, &languages, options...)
 	return languages, resp, errors.Wrap(err, "failed to get languages")
 }
 
@@ -92,10 +92,10 @@ func (cr *ConfigurationResource) GetLanguages() (Languages, *http.Response, erro
 type PrimaryTranslations []string
 
 // GetPrimaryTranslations retrieves a list of the officially supported translations on TMDB.
-func (cr *ConfigurationResource) GetPrimaryTranslations() (PrimaryTranslations, *http.Response, error) {
+func (cr *ConfigurationResource) GetPrimaryTranslations(options ...RequestOptionFn) (PrimaryTranslations, *http.Response, error) {
 	path := "/configuration/primary_translations"
 	var translations PrimaryTranslations
-	resp, err := cr.client.get(path, &translations)
+	resp, err := cr.client.get(path, &translations, options...)
 	return translations, resp, errors.Wrap(err, "failed to get primary translations")
 }
 
@@ -106,9 +106,9 @@ type Timezones []struct {
 }
 
 // GetTimezones retrieves the list of timezones used throughout TMDB.
-func (cr *ConfigurationResource) GetTimezones() (Timezones, *http.Response, error) {
+func (cr *ConfigurationResource) GetTimezones(options ...RequestOptionFn) (Timezones, *http.Response, error) {
 	path := "/configuration/timezones"
 	var timezones Timezones
-	resp, err := cr.client.get(path, &timezones)
+	resp, err := cr.client.get(path, &timezones, options...)
 	return timezones, resp, errors.Wrap(err, "failed to get timezones")
 }
@@ -1,6 +1,7 @@
 package tmdb
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 
@@ -12,25 +13,6 @@ type CreditsResource struct {
 	client *Client
 }
 
-// GetMediaType retrieves the media type from a media credit.
-func (mc MediaCredit) GetMediaType() string {
-	return mc["media_type"].(string)
-}
-
-// ToMovieCredit converts the data to a movie credit.
-func (mc MediaCredit) ToMovieCredit() (*MovieCredit, error) {
-	var credit MovieCredit
-	err := convert("movie", mc, &credit)
-	return &credit, errors.Wrap(err, "failed to convert object to movie credit")
-}
-
-// ToTVShowCredit converts the data to a tv show credit.
-func (mc MediaCredit) ToTVShowCredit() (*TVShowCredit, error) {
-	var credit TVShowCredit
-	err := convert("tv", mc, &credit)
-	return &credit, errors.Wrap(err, "failed to convert object to tv credit")
-}
-
 // MovieCredit represents a movie credit in TMDb.
 type MovieCredit struct {
 	Adult            bool    `json:"adult"`
@@ -44,7 +26,7 @@ type MovieCredit struct {
 	Overview         string  `json:"overview"`
 	Popularity       float64 `json:"popularity"`
 	PosterPath       *string `json:"poster_path"`
-	ReleaseDate      string  `json:"release_date"`
+	ReleaseDate      Date    `json:"release_date"`
 	Title            string  `json:"title"`
 	Video            bool    `json:"video"`
 	VoteAverage      float64 `json:"vote_average"`
@@ -56,7 +38,7 @@ type TVShowCredit struct {
 	Adult            bool        `json:"adult"`
 	BackdropPath     *string     `json:"backdrop_path"`
 	Episodes         []TVEpisode `json:"episodes"`
-	FirstAirDate     string      `json:"first_air_date"`
+	FirstAirDate     Date        `json:"first_air_date"`
 	GenreIDs         []int       `json:"genre_ids"`
 	ID               int         `json:"id"`
 	MediaType        string      `json:"media_type"`
@@ -72,9 +54,6 @@ type TVShowCredit struct {
 	VoteCount        int         `json:"vote_count"`
 }
 
-// MediaCredit represents a media credit from TMSb.
-type MediaCredit map[string]interface{}
-
 // PersonCredit represents a person credit in TMDb.
 type PersonCredit struct {
 	Adult              bool    `json:"adult"`
@@ -99,10 +78,29 @@ type Credit struct {
 	Person     PersonCredit `json:"person"`
 }
 
+// UnmarshalJSON decodes the credit media according to its media type.
+func (c *Credit) UnmarshalJSON(data []byte) error {
+	type credit Credit
+	var raw struct {
+		credit
+		Media json.RawMessage `json:"media"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	media, err := mediaCreditDecoder.decodeAs(raw.Media, raw.MediaType)
+	if err != nil {
+		return err
+	}
+	*c = Credit(raw.credit)
+	c.Media = media
+	return nil
+}
+
 // GetCredit retrieves a movie or TV credit details by id.
-func (cr *CreditsResource) GetCredit(id string) (*Credit, *http.Response, error) {
+func (cr *CreditsResource) GetCredit(id string, options ...RequestOptionFn) (*Credit, *http.Response, error) {
 	path := fmt.Sprintf("/credit/%s", id)
 	var credit Credit
-	resp, err := cr.client.get(path, &credit)
+	resp, err := cr.client.get(path, &credit, withOptions(options...))
 	return &credit, resp, errors.Wrap(err, "failed to get credit")
 }
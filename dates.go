@@ -0,0 +1,130 @@
+package tmdb
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DateLayout is the layout of dates in TMDb, e.g. release and air dates.
+const DateLayout = "2006-01-02"
+
+// Layouts of timestamps in TMDb.
+// The v3 API mostly sends the first two, while some fields and the v4 API send the others.
+var timestampLayouts = []string{
+	"2006-01-02 15:04:05 UTC",
+	"2006-01-02T15:04:05.000Z07:00",
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05",
+}
+
+// Date represents a date in TMDb, such as a release or air date.
+// Empty and null dates are decoded as the zero time, see IsZero.
+type Date struct {
+	time.Time
+
+	// Whether the date was sent as null rather than an empty string.
+	null bool
+}
+
+// NewDate returns the date of the given day.
+func NewDate(year int, month time.Month, day int) Date {
+	return Date{Time: time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
+}
+
+// String returns the date in TMDb layout, or an empty string for the zero date.
+func (d Date) String() string {
+	if d.IsZero() {
+		return ""
+	}
+	return d.Format(DateLayout)
+}
+
+// UnmarshalJSON decodes a date in TMDb layout.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	value, null, err := unmarshalTimeString(data)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode date")
+	}
+	*d = Date{null: null}
+	if value == "" {
+		return nil
+	}
+	t, err := time.Parse(DateLayout, value)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode date")
+	}
+	d.Time = t
+	return nil
+}
+
+// MarshalJSON encodes the date in TMDb layout, keeping empty and null dates as they were sent.
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsZero() && d.null {
+		return []byte("null"), nil
+	}
+	return json.Marshal(d.String())
+}
+
+// Timestamp represents a point in time in TMDb, such as a creation date or an expiration date.
+// Empty and null timestamps are decoded as the zero time, see IsZero.
+type Timestamp struct {
+	time.Time
+
+	// Layout the timestamp was sent with, so that it is encoded back identically.
+	layout string
+
+	// Whether the timestamp was sent as null rather than an empty string.
+	null bool
+}
+
+// String returns the timestamp in the layout it was sent with, or an empty string for the zero timestamp.
+func (t Timestamp) String() string {
+	if t.IsZero() {
+		return ""
+	}
+	layout := t.layout
+	if layout == "" {
+		layout = time.RFC3339
+	}
+	return t.Format(layout)
+}
+
+// UnmarshalJSON decodes a timestamp in any of the TMDb layouts.
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	value, null, err := unmarshalTimeString(data)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode timestamp")
+	}
+	*t = Timestamp{null: null}
+	if value == "" {
+		return nil
+	}
+	for _, layout := range timestampLayouts {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			t.Time, t.layout = parsed, layout
+			return nil
+		}
+	}
+	return errors.Errorf("failed to decode timestamp: unknown layout of %q", value)
+}
+
+// MarshalJSON encodes the timestamp in the layout it was sent with, keeping empty and null timestamps as they were sent.
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.IsZero() && t.null {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.String())
+}
+
+// unmarshalTimeString decodes a JSON string that may be null.
+func unmarshalTimeString(data []byte) (string, bool, error) {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return "", true, nil
+	}
+	var value string
+	err := json.Unmarshal(data, &value)
+	return value, false, err
+}
@@ -0,0 +1,125 @@
+package tmdb_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/mdvalv/go-tmdb"
+	"github.com/mdvalv/go-tmdb/tmdbtest"
+)
+
+func TestDate(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		want     time.Time
+		wantZero bool
+		wantErr  bool
+	}{
+		{name: "date", data: `"1999-10-15"`, want: time.Date(1999, time.October, 15, 0, 0, 0, 0, time.UTC)},
+		{name: "empty", data: `""`, wantZero: true},
+		{name: "null", data: `null`, wantZero: true},
+		{name: "invalid layout", data: `"15/10/1999"`, wantErr: true},
+		{name: "not a string", data: `19991015`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var date tmdb.Date
+			err := json.Unmarshal([]byte(tt.data), &date)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %t", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if date.IsZero() != tt.wantZero || (!tt.wantZero && !date.Equal(tt.want)) {
+				t.Errorf("got %v, want %v", date.Time, tt.want)
+			}
+			data, err := json.Marshal(date)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != tt.data {
+				t.Errorf("got %s after round-trip, want %s", data, tt.data)
+			}
+		})
+	}
+}
+
+func TestNewDate(t *testing.T) {
+	date := tmdb.NewDate(1999, time.October, 15)
+	if got := date.String(); got != "1999-10-15" {
+		t.Errorf("got %q, want 1999-10-15", got)
+	}
+	if got := (tmdb.Date{}).String(); got != "" {
+		t.Errorf("got %q for the zero date, want an empty string", got)
+	}
+}
+
+func TestTimestamp(t *testing.T) {
+	want := time.Date(2023, time.March, 14, 15, 9, 26, 0, time.UTC)
+	tests := []struct {
+		name     string
+		data     string
+		want     time.Time
+		wantZero bool
+		wantErr  bool
+	}{
+		{name: "v3 expiration", data: `"2023-03-14 15:09:26 UTC"`, want: want},
+		{name: "v3 milliseconds", data: `"2023-03-14T15:09:26.000Z"`, want: want},
+		{name: "rfc3339", data: `"2023-03-14T15:09:26Z"`, want: want},
+		{name: "rfc3339 nanoseconds", data: `"2023-03-14T15:09:26.5Z"`, want: want.Add(500 * time.Millisecond)},
+		{name: "v4 without zone", data: `"2023-03-14 15:09:26"`, want: want},
+		{name: "empty", data: `""`, wantZero: true},
+		{name: "null", data: `null`, wantZero: true},
+		{name: "unknown layout", data: `"14/03/2023"`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var timestamp tmdb.Timestamp
+			err := json.Unmarshal([]byte(tt.data), &timestamp)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %t", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if timestamp.IsZero() != tt.wantZero || (!tt.wantZero && !timestamp.Equal(tt.want)) {
+				t.Errorf("got %v, want %v", timestamp.Time, tt.want)
+			}
+			data, err := json.Marshal(timestamp)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != tt.data {
+				t.Errorf("got %s after round-trip, want %s", data, tt.data)
+			}
+		})
+	}
+}
+
+func TestDatesFromServer(t *testing.T) {
+	server := tmdbtest.NewServer()
+	defer server.Close()
+	client, err := server.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	movie, _, err := client.Movies.GetMovie(tmdbtest.MovieID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := movie.ReleaseDate.String(); got != "1999-10-15" {
+		t.Errorf("got release date %q, want 1999-10-15", got)
+	}
+
+	token, _, err := client.Authentication.CreateRequestToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.ExpiresAt.IsZero() {
+		t.Error("got zero expiration of the request token")
+	}
+}
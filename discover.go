@@ -28,7 +28,7 @@ type DiscoverMoviesOptions struct {
 	//    vote_average.asc / vote_average.desc
 	//    vote_count.asc / vote_count.des
 	// default: popularity.desc
-	SortBy string `url:"sort_by,omitempty" json:"sort_by,omitempty"`
+	SortBy DiscoverSort `url:"sort_by,omitempty" json:"sort_by,omitempty"`
 
 	// Specify a ISO 3166-1 code to filter release dates. Must be uppercase.
 	Region string `url:"region,omitempty" json:"region,omitempty"`
@@ -164,10 +164,10 @@ type DiscoverMoviesOptions struct {
 // Comma's are treated like an AND and query while pipe's are an OR.
 //
 // Some examples can be found here: https://www.themoviedb.org/documentation/api/discover
-func (dr *DiscoverResource) DiscoverMovies(opt *DiscoverMoviesOptions) (*DiscoverMovies, *http.Response, error) {
+func (dr *DiscoverResource) DiscoverMovies(opt *DiscoverMoviesOptions, options ...RequestOptionFn) (*DiscoverMovies, *http.Response, error) {
 	path := "/discover/movie"
 	var discover DiscoverMovies
-	resp, err := dr.client.get(path, &discover, WithQueryParams(opt))
+	resp, err := dr.client.get(path, &discover, WithQueryParams(opt), withOptions(options...))
 	return &discover, resp, errors.Wrap(err, "failed to discover movies")
 }
 
@@ -178,7 +178,7 @@ type DiscoverTVShowsOptions struct {
 	//    first_air_date.desc / first_air_date.asc
 	//    popularity.desc / popularity.asc
 	// default: popularity.desc
-	SortBy string `url:"sort_by,omitempty" json:"sort_by,omitempty"`
+	SortBy DiscoverSort `url:"sort_by,omitempty" json:"sort_by,omitempty"`
 
 	// Filter and only include TV shows that have a air date (by looking at all episodes) that is greater or equal to the specified value.
 	// format: YYYY-MM-DD
@@ -300,9 +300,9 @@ type DiscoverTVShowsOptions struct {
 // Comma's are treated like an AND and query while pipe's are an OR.
 //
 // Some examples can be found here: https://www.themoviedb.org/documentation/api/discover
-func (dr *DiscoverResource) DiscoverTVShows(opt *DiscoverTVShowsOptions) (*DiscoverTVShows, *http.Response, error) {
+func (dr *DiscoverResource) DiscoverTVShows(opt *DiscoverTVShowsOptions, options ...RequestOptionFn) (*DiscoverTVShows, *http.Response, error) {
 	path := "/discover/tv"
 	var discover DiscoverTVShows
-	resp, err := dr.client.get(path, &discover, WithQueryParams(opt))
+	resp, err := dr.client.get(path, &discover, WithQueryParams(opt), withOptions(options...))
 	return &discover, resp, errors.Wrap(err, "failed to discover tv shows")
 }
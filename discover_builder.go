@@ -0,0 +1,754 @@
+package tmdb
+
+import (
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-querystring/query"
+	"github.com/pkg/errors"
+)
+
+// IDFilter represents a list of ids combined with AND or OR logic in discover requests.
+type IDFilter struct {
+	ids []int
+	any bool
+}
+
+// AllOf returns a filter matching items having all the ids (AND logic).
+func AllOf(ids ...int) IDFilter {
+	return IDFilter{ids: ids}
+}
+
+// AnyOf returns a filter matching items having at least one of the ids (OR logic).
+func AnyOf(ids ...int) IDFilter {
+	return IDFilter{ids: ids, any: true}
+}
+
+// IDs retrieves the ids of the filter.
+func (f IDFilter) IDs() []int {
+	return f.ids
+}
+
+// IsAny checks whether the filter uses OR logic.
+func (f IDFilter) IsAny() bool {
+	return f.any
+}
+
+// String returns the filter as expected by TMDb API: comma separated for AND, pipe separated for OR.
+func (f IDFilter) String() string {
+	values := make([]string, len(f.ids))
+	for i, id := range f.ids {
+		values[i] = strconv.Itoa(id)
+	}
+	if f.any {
+		return strings.Join(values, "|")
+	}
+	return strings.Join(values, ",")
+}
+
+// ParseIDFilter parses a comma (AND) or pipe (OR) separated list of ids.
+func ParseIDFilter(value string) (IDFilter, error) {
+	if value == "" {
+		return IDFilter{}, nil
+	}
+	if strings.Contains(value, ",") && strings.Contains(value, "|") {
+		return IDFilter{}, errors.Errorf("cannot mix AND (,) and OR (|) in %q", value)
+	}
+	filter := IDFilter{any: strings.Contains(value, "|")}
+	for _, part := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == '|' }) {
+		id, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return IDFilter{}, errors.Errorf("invalid id %q in %q", part, value)
+		}
+		filter.ids = append(filter.ids, id)
+	}
+	return filter, nil
+}
+
+// DiscoverSort represents a sort option of discover requests.
+type DiscoverSort string
+
+// Available sort options.
+// Movies can be sorted by all of them except first air date, tv shows by popularity, vote average and first air date.
+const (
+	SortPopularityAsc          DiscoverSort = "popularity.asc"
+	SortPopularityDesc         DiscoverSort = "popularity.desc"
+	SortReleaseDateAsc         DiscoverSort = "release_date.asc"
+	SortReleaseDateDesc        DiscoverSort = "release_date.desc"
+	SortRevenueAsc             DiscoverSort = "revenue.asc"
+	SortRevenueDesc            DiscoverSort = "revenue.desc"
+	SortPrimaryReleaseDateAsc  DiscoverSort = "primary_release_date.asc"
+	SortPrimaryReleaseDateDesc DiscoverSort = "primary_release_date.desc"
+	SortOriginalTitleAsc       DiscoverSort = "original_title.asc"
+	SortOriginalTitleDesc      DiscoverSort = "original_title.desc"
+	SortVoteAverageAsc         DiscoverSort = "vote_average.asc"
+	SortVoteAverageDesc        DiscoverSort = "vote_average.desc"
+	SortVoteCountAsc           DiscoverSort = "vote_count.asc"
+	SortVoteCountDesc          DiscoverSort = "vote_count.desc"
+	SortFirstAirDateAsc        DiscoverSort = "first_air_date.asc"
+	SortFirstAirDateDesc       DiscoverSort = "first_air_date.desc"
+)
+
+var (
+	movieDiscoverSorts = []DiscoverSort{
+		SortPopularityAsc, SortPopularityDesc,
+		SortReleaseDateAsc, SortReleaseDateDesc,
+		SortRevenueAsc, SortRevenueDesc,
+		SortPrimaryReleaseDateAsc, SortPrimaryReleaseDateDesc,
+		SortOriginalTitleAsc, SortOriginalTitleDesc,
+		SortVoteAverageAsc, SortVoteAverageDesc,
+		SortVoteCountAsc, SortVoteCountDesc,
+	}
+	tvDiscoverSorts = []DiscoverSort{
+		SortPopularityAsc, SortPopularityDesc,
+		SortVoteAverageAsc, SortVoteAverageDesc,
+		SortFirstAirDateAsc, SortFirstAirDateDesc,
+	}
+)
+
+// MovieDiscover builds the options of a movie discover request.
+// Filters are checked when the options are built, see Options.
+type MovieDiscover struct {
+	opt DiscoverMoviesOptions
+}
+
+// NewMovieDiscover returns an empty movie discover builder.
+func NewMovieDiscover() *MovieDiscover {
+	return &MovieDiscover{}
+}
+
+// ParseMovieDiscover returns a movie discover builder from a URL query string, e.g. built with Query.
+func ParseMovieDiscover(rawQuery string) (*MovieDiscover, error) {
+	var b MovieDiscover
+	if err := decodeQuery(rawQuery, &b.opt); err != nil {
+		return nil, err
+	}
+	if err := b.opt.Validate(); err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// Options builds the options, checking the filters.
+func (b *MovieDiscover) Options() (*DiscoverMoviesOptions, error) {
+	if err := b.opt.Validate(); err != nil {
+		return nil, err
+	}
+	opt := b.opt
+	return &opt, nil
+}
+
+// Query builds the URL query of the options, checking the filters.
+func (b *MovieDiscover) Query() (url.Values, error) {
+	opt, err := b.Options()
+	if err != nil {
+		return nil, err
+	}
+	values, err := query.Values(opt)
+	return values, errors.Wrap(err, "failed to encode query")
+}
+
+// SortBy sets the sort order of the results.
+func (b *MovieDiscover) SortBy(sort DiscoverSort) *MovieDiscover {
+	b.opt.SortBy = sort
+	return b
+}
+
+// Page sets the page of results to query.
+func (b *MovieDiscover) Page(page int) *MovieDiscover {
+	b.opt.Page = &page
+	return b
+}
+
+// Language sets the language of translatable fields.
+func (b *MovieDiscover) Language(language string) *MovieDiscover {
+	b.opt.Language = language
+	return b
+}
+
+// Region sets the ISO 3166-1 code used to filter release dates.
+func (b *MovieDiscover) Region(region string) *MovieDiscover {
+	b.opt.Region = region
+	return b
+}
+
+// IncludeAdult includes or excludes adult movies.
+func (b *MovieDiscover) IncludeAdult(include bool) *MovieDiscover {
+	b.opt.IncludeAdult = include
+	return b
+}
+
+// IncludeVideo includes or excludes videos.
+func (b *MovieDiscover) IncludeVideo(include bool) *MovieDiscover {
+	b.opt.IncludeVideo = include
+	return b
+}
+
+// Certification only includes movies with the certification in the country.
+func (b *MovieDiscover) Certification(country, certification string) *MovieDiscover {
+	b.opt.CertificationCountry, b.opt.Certification = country, certification
+	return b
+}
+
+// MaxCertification only includes movies with a certification less than or equal to the one in the country.
+func (b *MovieDiscover) MaxCertification(country, certification string) *MovieDiscover {
+	b.opt.CertificationCountry, b.opt.CertificationLte = country, certification
+	return b
+}
+
+// MinCertification only includes movies with a certification greater than or equal to the one in the country.
+func (b *MovieDiscover) MinCertification(country, certification string) *MovieDiscover {
+	b.opt.CertificationCountry, b.opt.CertificationGte = country, certification
+	return b
+}
+
+// Year only includes movies released in the year, looking at all release dates.
+func (b *MovieDiscover) Year(year int) *MovieDiscover {
+	b.opt.Year = &year
+	return b
+}
+
+// PrimaryReleaseYear only includes movies with a primary release in the year.
+func (b *MovieDiscover) PrimaryReleaseYear(year int) *MovieDiscover {
+	b.opt.PrimaryReleaseYear = &year
+	return b
+}
+
+// ReleasedBetween only includes movies released between the dates, looking at all release dates.
+// A zero time leaves the bound open.
+func (b *MovieDiscover) ReleasedBetween(from, to time.Time) *MovieDiscover {
+	b.opt.ReleaseDateGte, b.opt.ReleaseDateLte = formatDate(from), formatDate(to)
+	return b
+}
+
+// PrimaryReleasedBetween only includes movies with a primary release between the dates.
+// A zero time leaves the bound open.
+func (b *MovieDiscover) PrimaryReleasedBetween(from, to time.Time) *MovieDiscover {
+	b.opt.PrimaryReleaseDateGte, b.opt.PrimaryReleaseDateLte = formatDate(from), formatDate(to)
+	return b
+}
+
+// ReleaseTypes only includes movies with any of the release types.
+// The order matters, as the release date returned is the first one matching the types.
+func (b *MovieDiscover) ReleaseTypes(types ...ReleaseType) *MovieDiscover {
+	ids := make([]int, len(types))
+	for i, t := range types {
+		ids[i] = int(t)
+	}
+	b.opt.WithReleaseType = AnyOf(ids...).String()
+	return b
+}
+
+// MinVoteCount only includes movies with a vote count greater than or equal to the value.
+func (b *MovieDiscover) MinVoteCount(count int) *MovieDiscover {
+	b.opt.VoteCountGte = &count
+	return b
+}
+
+// MaxVoteCount only includes movies with a vote count less than or equal to the value.
+func (b *MovieDiscover) MaxVoteCount(count int) *MovieDiscover {
+	b.opt.VoteCountLte = &count
+	return b
+}
+
+// MinVoteAverage only includes movies with a rating greater than or equal to the value.
+func (b *MovieDiscover) MinVoteAverage(average float64) *MovieDiscover {
+	b.opt.VoteAverageGte = &average
+	return b
+}
+
+// MaxVoteAverage only includes movies with a rating less than or equal to the value.
+func (b *MovieDiscover) MaxVoteAverage(average float64) *MovieDiscover {
+	b.opt.VoteAverageLte = &average
+	return b
+}
+
+// MinRuntime only includes movies with a runtime greater than or equal to the value, in minutes.
+func (b *MovieDiscover) MinRuntime(minutes int) *MovieDiscover {
+	b.opt.WithRuntimeGte = &minutes
+	return b
+}
+
+// MaxRuntime only includes movies with a runtime less than or equal to the value, in minutes.
+func (b *MovieDiscover) MaxRuntime(minutes int) *MovieDiscover {
+	b.opt.WithRuntimeLte = &minutes
+	return b
+}
+
+// Cast only includes movies with the people as actors.
+func (b *MovieDiscover) Cast(filter IDFilter) *MovieDiscover {
+	b.opt.WithCast = filter.String()
+	return b
+}
+
+// Crew only includes movies with the people as crew members.
+func (b *MovieDiscover) Crew(filter IDFilter) *MovieDiscover {
+	b.opt.WithCrew = filter.String()
+	return b
+}
+
+// People only includes movies with the people as either actors or crew members.
+func (b *MovieDiscover) People(filter IDFilter) *MovieDiscover {
+	b.opt.WithPeople = filter.String()
+	return b
+}
+
+// Companies only includes movies with the production companies.
+func (b *MovieDiscover) Companies(filter IDFilter) *MovieDiscover {
+	b.opt.WithCompanies = filter.String()
+	return b
+}
+
+// WithoutCompanies excludes movies with the production companies.
+func (b *MovieDiscover) WithoutCompanies(filter IDFilter) *MovieDiscover {
+	b.opt.WithoutCompanies = filter.String()
+	return b
+}
+
+// Genres only includes movies with the genres.
+func (b *MovieDiscover) Genres(filter IDFilter) *MovieDiscover {
+	b.opt.WithGenres = filter.String()
+	return b
+}
+
+// WithoutGenres excludes movies with the genres.
+func (b *MovieDiscover) WithoutGenres(filter IDFilter) *MovieDiscover {
+	b.opt.WithoutGenres = filter.String()
+	return b
+}
+
+// Keywords only includes movies with the keywords.
+func (b *MovieDiscover) Keywords(filter IDFilter) *MovieDiscover {
+	b.opt.WithKeywords = filter.String()
+	return b
+}
+
+// WithoutKeywords excludes movies with the keywords.
+func (b *MovieDiscover) WithoutKeywords(filter IDFilter) *MovieDiscover {
+	b.opt.WithoutKeywords = filter.String()
+	return b
+}
+
+// OriginalLanguage only includes movies with the ISO 639-1 original language.
+func (b *MovieDiscover) OriginalLanguage(language string) *MovieDiscover {
+	b.opt.WithOriginalLanguage = language
+	return b
+}
+
+// WatchProviders only includes movies available with the watch providers in the ISO 3166-1 region.
+func (b *MovieDiscover) WatchProviders(region string, filter IDFilter) *MovieDiscover {
+	b.opt.WatchRegion, b.opt.WithWatchProviders = region, filter.String()
+	return b
+}
+
+// WatchMonetization only includes movies available with any of the monetization types, see WatchProviders.
+func (b *MovieDiscover) WatchMonetization(kinds ...Monetization) *MovieDiscover {
+	b.opt.WithWatchMonetizationTypes = joinMonetization(kinds)
+	return b
+}
+
+// Validate checks the filters of the options.
+func (opt *DiscoverMoviesOptions) Validate() error {
+	if opt == nil {
+		return nil
+	}
+	errs := checkFields(opt)
+	errs.checkSort(opt.SortBy, movieDiscoverSorts)
+	errs.checkDateRange("primary_release_date", opt.PrimaryReleaseDateGte, opt.PrimaryReleaseDateLte)
+	errs.checkDateRange("release_date", opt.ReleaseDateGte, opt.ReleaseDateLte)
+	checkRange(&errs, "vote_count", opt.VoteCountGte, opt.VoteCountLte, 0, -1)
+	checkRange(&errs, "vote_average", opt.VoteAverageGte, opt.VoteAverageLte, 0, 10)
+	checkRange(&errs, "with_runtime", opt.WithRuntimeGte, opt.WithRuntimeLte, 0, -1)
+	errs.checkReleaseTypes("with_release_type", opt.WithReleaseType)
+	errs.checkIDFilter("with_cast", opt.WithCast)
+	errs.checkIDFilter("with_crew", opt.WithCrew)
+	errs.checkIDFilter("with_people", opt.WithPeople)
+	errs.checkIDFilter("with_companies", opt.WithCompanies)
+	errs.checkIDFilter("without_companies", opt.WithoutCompanies)
+	errs.checkIDFilter("with_genres", opt.WithGenres)
+	errs.checkIDFilter("without_genres", opt.WithoutGenres)
+	errs.checkIDFilter("with_keywords", opt.WithKeywords)
+	errs.checkIDFilter("without_keywords", opt.WithoutKeywords)
+	errs.checkIDFilter("with_watch_providers", opt.WithWatchProviders)
+	errs.checkMonetization("with_watch_monetization_types", opt.WithWatchMonetizationTypes)
+	return errs.err()
+}
+
+// TVDiscover builds the options of a tv show discover request.
+// Filters are checked when the options are built, see Options.
+type TVDiscover struct {
+	opt DiscoverTVShowsOptions
+}
+
+// NewTVDiscover returns an empty tv show discover builder.
+func NewTVDiscover() *TVDiscover {
+	return &TVDiscover{}
+}
+
+// ParseTVDiscover returns a tv show discover builder from a URL query string, e.g. built with Query.
+func ParseTVDiscover(rawQuery string) (*TVDiscover, error) {
+	var b TVDiscover
+	if err := decodeQuery(rawQuery, &b.opt); err != nil {
+		return nil, err
+	}
+	if err := b.opt.Validate(); err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// Options builds the options, checking the filters.
+func (b *TVDiscover) Options() (*DiscoverTVShowsOptions, error) {
+	if err := b.opt.Validate(); err != nil {
+		return nil, err
+	}
+	opt := b.opt
+	return &opt, nil
+}
+
+// Query builds the URL query of the options, checking the filters.
+func (b *TVDiscover) Query() (url.Values, error) {
+	opt, err := b.Options()
+	if err != nil {
+		return nil, err
+	}
+	values, err := query.Values(opt)
+	return values, errors.Wrap(err, "failed to encode query")
+}
+
+// SortBy sets the sort order of the results.
+func (b *TVDiscover) SortBy(sort DiscoverSort) *TVDiscover {
+	b.opt.SortBy = sort
+	return b
+}
+
+// Page sets the page of results to query.
+func (b *TVDiscover) Page(page int) *TVDiscover {
+	b.opt.Page = &page
+	return b
+}
+
+// Language sets the language of translatable fields.
+func (b *TVDiscover) Language(language string) *TVDiscover {
+	b.opt.Language = language
+	return b
+}
+
+// Timezone sets the timezone used to filter air dates, e.g. America/New_York.
+func (b *TVDiscover) Timezone(timezone string) *TVDiscover {
+	b.opt.Timezone = timezone
+	return b
+}
+
+// AiredBetween only includes tv shows with an episode aired between the dates.
+// A zero time leaves the bound open.
+func (b *TVDiscover) AiredBetween(from, to time.Time) *TVDiscover {
+	b.opt.AirDateGte, b.opt.AirDateLte = formatDate(from), formatDate(to)
+	return b
+}
+
+// FirstAiredBetween only includes tv shows first aired between the dates.
+// A zero time leaves the bound open.
+func (b *TVDiscover) FirstAiredBetween(from, to time.Time) *TVDiscover {
+	b.opt.FirstAirDateGte, b.opt.FirstAirDateLte = formatDate(from), formatDate(to)
+	return b
+}
+
+// FirstAirDateYear only includes tv shows first aired in the year.
+func (b *TVDiscover) FirstAirDateYear(year int) *TVDiscover {
+	b.opt.FirstAirDateYear = &year
+	return b
+}
+
+// IncludeNullFirstAirDates includes tv shows without air date when filtering by first air date.
+func (b *TVDiscover) IncludeNullFirstAirDates(include bool) *TVDiscover {
+	b.opt.IncludeNullFirstAirDates = include
+	return b
+}
+
+// MinVoteCount only includes tv shows with a vote count greater than or equal to the value.
+func (b *TVDiscover) MinVoteCount(count int) *TVDiscover {
+	b.opt.VoteCountGte = &count
+	return b
+}
+
+// MaxVoteCount only includes tv shows with a vote count less than or equal to the value.
+func (b *TVDiscover) MaxVoteCount(count int) *TVDiscover {
+	b.opt.VoteCountLte = &count
+	return b
+}
+
+// MinVoteAverage only includes tv shows with a rating greater than or equal to the value.
+func (b *TVDiscover) MinVoteAverage(average float64) *TVDiscover {
+	b.opt.VoteAverageGte = &average
+	return b
+}
+
+// MaxVoteAverage only includes tv shows with a rating less than or equal to the value.
+func (b *TVDiscover) MaxVoteAverage(average float64) *TVDiscover {
+	b.opt.VoteAverageLte = &average
+	return b
+}
+
+// MinRuntime only includes tv shows with an episode runtime greater than or equal to the value, in minutes.
+func (b *TVDiscover) MinRuntime(minutes int) *TVDiscover {
+	b.opt.WithRuntimeGte = &minutes
+	return b
+}
+
+// MaxRuntime only includes tv shows with an episode runtime less than or equal to the value, in minutes.
+func (b *TVDiscover) MaxRuntime(minutes int) *TVDiscover {
+	b.opt.WithRuntimeLte = &minutes
+	return b
+}
+
+// Genres only includes tv shows with the genres.
+func (b *TVDiscover) Genres(filter IDFilter) *TVDiscover {
+	b.opt.WithGenres = filter.String()
+	return b
+}
+
+// WithoutGenres excludes tv shows with the genres.
+func (b *TVDiscover) WithoutGenres(filter IDFilter) *TVDiscover {
+	b.opt.WithoutGenres = filter.String()
+	return b
+}
+
+// Networks only includes tv shows aired on the networks.
+func (b *TVDiscover) Networks(filter IDFilter) *TVDiscover {
+	b.opt.WithNetworks = filter.String()
+	return b
+}
+
+// Companies only includes tv shows with the production companies.
+func (b *TVDiscover) Companies(filter IDFilter) *TVDiscover {
+	b.opt.WithCompanies = filter.String()
+	return b
+}
+
+// WithoutCompanies excludes tv shows with the production companies.
+func (b *TVDiscover) WithoutCompanies(filter IDFilter) *TVDiscover {
+	b.opt.WithoutCompanies = filter.String()
+	return b
+}
+
+// Keywords only includes tv shows with the keywords.
+func (b *TVDiscover) Keywords(filter IDFilter) *TVDiscover {
+	b.opt.WithKeywords = filter.String()
+	return b
+}
+
+// WithoutKeywords excludes tv shows with the keywords.
+func (b *TVDiscover) WithoutKeywords(filter IDFilter) *TVDiscover {
+	b.opt.WithoutKeywords = filter.String()
+	return b
+}
+
+// OriginalLanguage only includes tv shows with the ISO 639-1 original language.
+func (b *TVDiscover) OriginalLanguage(language string) *TVDiscover {
+	b.opt.WithOriginalLanguage = language
+	return b
+}
+
+// ScreenedTheatrically only includes tv shows that have, or have not, been screened theatrically.
+func (b *TVDiscover) ScreenedTheatrically(screened bool) *TVDiscover {
+	b.opt.ScreenedTheatrically = &screened
+	return b
+}
+
+// WatchProviders only includes tv shows available with the watch providers in the ISO 3166-1 region.
+func (b *TVDiscover) WatchProviders(region string, filter IDFilter) *TVDiscover {
+	b.opt.WatchRegion, b.opt.WithWatchProviders = region, filter.String()
+	return b
+}
+
+// WatchMonetization only includes tv shows available with any of the monetization types, see WatchProviders.
+func (b *TVDiscover) WatchMonetization(kinds ...Monetization) *TVDiscover {
+	b.opt.WithWatchMonetizationTypes = joinMonetization(kinds)
+	return b
+}
+
+// Status only includes tv shows with the statuses, see DiscoverTVShowsOptions.WithStatus.
+func (b *TVDiscover) Status(filter IDFilter) *TVDiscover {
+	b.opt.WithStatus = filter.String()
+	return b
+}
+
+// Type only includes tv shows with the types, see DiscoverTVShowsOptions.WithType.
+func (b *TVDiscover) Type(filter IDFilter) *TVDiscover {
+	b.opt.WithType = filter.String()
+	return b
+}
+
+// Validate checks the filters of the options.
+func (opt *DiscoverTVShowsOptions) Validate() error {
+	if opt == nil {
+		return nil
+	}
+	errs := checkFields(opt)
+	errs.checkSort(opt.SortBy, tvDiscoverSorts)
+	errs.checkDateRange("air_date", opt.AirDateGte, opt.AirDateLte)
+	errs.checkDateRange("first_air_date", opt.FirstAirDateGte, opt.FirstAirDateLte)
+	checkRange(&errs, "vote_count", opt.VoteCountGte, opt.VoteCountLte, 0, -1)
+	checkRange(&errs, "vote_average", opt.VoteAverageGte, opt.VoteAverageLte, 0, 10)
+	checkRange(&errs, "with_runtime", opt.WithRuntimeGte, opt.WithRuntimeLte, 0, -1)
+	errs.checkIDFilter("with_genres", opt.WithGenres)
+	errs.checkIDFilter("without_genres", opt.WithoutGenres)
+	errs.checkIDFilter("with_networks", opt.WithNetworks)
+	errs.checkIDFilter("with_companies", opt.WithCompanies)
+	errs.checkIDFilter("without_companies", opt.WithoutCompanies)
+	errs.checkIDFilter("with_keywords", opt.WithKeywords)
+	errs.checkIDFilter("without_keywords", opt.WithoutKeywords)
+	errs.checkIDFilter("with_watch_providers", opt.WithWatchProviders)
+	errs.checkIDFilter("with_status", opt.WithStatus)
+	errs.checkIDFilter("with_type", opt.WithType)
+	errs.checkMonetization("with_watch_monetization_types", opt.WithWatchMonetizationTypes)
+	return errs.err()
+}
+
+// formatDate formats a date as expected by TMDb API, leaving zero times empty.
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(DateLayout)
+}
+
+// joinMonetization joins monetization types with OR logic.
+func joinMonetization(kinds []Monetization) string {
+	values := make([]string, len(kinds))
+	for i, kind := range kinds {
+		values[i] = string(kind)
+	}
+	return strings.Join(values, "|")
+}
+
+func (fe *fieldErrors) checkSort(sort DiscoverSort, allowed []DiscoverSort) {
+	if sort == "" {
+		return
+	}
+	for _, s := range allowed {
+		if s == sort {
+			return
+		}
+	}
+	fe.add("sort_by", string(sort), "unsupported sort option")
+}
+
+func (fe *fieldErrors) checkDateRange(name string, gte, lte string) {
+	from, fromErr := time.Parse(DateLayout, gte)
+	if gte != "" && fromErr != nil {
+		fe.add(name+".gte", gte, "must be formatted as YYYY-MM-DD")
+	}
+	to, toErr := time.Parse(DateLayout, lte)
+	if lte != "" && toErr != nil {
+		fe.add(name+".lte", lte, "must be formatted as YYYY-MM-DD")
+	}
+	if fromErr == nil && toErr == nil && from.After(to) {
+		fe.add(name+".gte", gte, "must not be after %s.lte %s", name, lte)
+	}
+}
+
+// checkRange checks that the bounds are within [min, max] and in order, a negative max meaning no maximum.
+func checkRange[T int | float64](fe *fieldErrors, name string, gte, lte *T, min, max T) {
+	outOfRange := func(value *T) bool {
+		return value != nil && (*value < min || (max >= 0 && *value > max))
+	}
+	if outOfRange(gte) {
+		fe.add(name+".gte", *gte, "out of range")
+	}
+	if outOfRange(lte) {
+		fe.add(name+".lte", *lte, "out of range")
+	}
+	if gte != nil && lte != nil && *gte > *lte {
+		fe.add(name+".gte", *gte, "must not be greater than %s.lte %v", name, *lte)
+	}
+}
+
+func (fe *fieldErrors) checkIDFilter(name, value string) {
+	if _, err := ParseIDFilter(value); err != nil {
+		fe.add(name, value, "%s", err)
+	}
+}
+
+func (fe *fieldErrors) checkReleaseTypes(name, value string) {
+	filter, err := ParseIDFilter(value)
+	if err != nil {
+		fe.add(name, value, "%s", err)
+		return
+	}
+	for _, id := range filter.IDs() {
+		if err := ReleaseType(id).Validate(); err != nil {
+			fe.add(name, value, "%s", err)
+		}
+	}
+}
+
+func (fe *fieldErrors) checkMonetization(name, value string) {
+	if value == "" {
+		return
+	}
+	for _, kind := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == '|' }) {
+		switch Monetization(kind) {
+		case MonetizationFlatrate, MonetizationRent, MonetizationBuy, MonetizationAds, MonetizationFree:
+		default:
+			fe.add(name, value, "unsupported monetization type %q", kind)
+		}
+	}
+}
+
+// decodeQuery decodes a URL query string into options, using their url tags.
+func decodeQuery(rawQuery string, opt interface{}) error {
+	values, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse query")
+	}
+	v := reflect.ValueOf(opt).Elem()
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("url"), ",")[0]
+		if name == "" || !values.Has(name) {
+			continue
+		}
+		if err := setQueryValue(v.Field(i), values.Get(name)); err != nil {
+			return errors.Wrapf(err, "failed to decode %s", name)
+		}
+	}
+	return nil
+}
+
+// setQueryValue sets a string, bool, int or float field, or a pointer to one, from its query value.
+func setQueryValue(field reflect.Value, value string) error {
+	if field.Kind() == reflect.Pointer {
+		field.Set(reflect.New(field.Type().Elem()))
+		field = field.Elem()
+	}
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Int:
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(n))
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
+	default:
+		return errors.Errorf("unsupported field kind %s", field.Kind())
+	}
+	return nil
+}
@@ -0,0 +1,265 @@
+package tmdb_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mdvalv/go-tmdb"
+	"github.com/pkg/errors"
+)
+
+// invalidFields returns the names of the invalid fields of a validation error.
+func invalidFields(err error) []string {
+	var validationErr *tmdb.ValidationError
+	if !errors.As(err, &validationErr) {
+		return nil
+	}
+	fields := make([]string, len(validationErr.Errors))
+	for i, fe := range validationErr.Errors {
+		fields[i] = fe.Field
+	}
+	return fields
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestMovieDiscoverRoundTrip(t *testing.T) {
+	tests := []struct {
+		name      string
+		discover  *tmdb.MovieDiscover
+		wantQuery string
+	}{
+		{name: "empty", discover: tmdb.NewMovieDiscover()},
+		{
+			name:      "and or filters",
+			discover:  tmdb.NewMovieDiscover().Genres(tmdb.AnyOf(28, 12)).WithoutKeywords(tmdb.AllOf(9715, 9717)),
+			wantQuery: "with_genres=28%7C12&without_keywords=9715%2C9717",
+		},
+		{
+			name:      "dates and sort",
+			discover:  tmdb.NewMovieDiscover().ReleasedBetween(date(1999, time.January, 1), date(1999, time.December, 31)).SortBy(tmdb.SortPopularityDesc),
+			wantQuery: "release_date.gte=1999-01-01&release_date.lte=1999-12-31&sort_by=popularity.desc",
+		},
+		{
+			name: "every kind of field",
+			discover: tmdb.NewMovieDiscover().
+				Language("en-US").Region("US").Page(2).IncludeAdult(false).IncludeVideo(true).
+				Certification("US", "R").PrimaryReleaseYear(1999).
+				ReleaseTypes(tmdb.ReleaseTypeTheatrical, tmdb.ReleaseTypeDigital).
+				MinVoteCount(100).MinVoteAverage(7.5).MaxVoteAverage(10).MaxRuntime(180).
+				Cast(tmdb.AllOf(287, 819)).Companies(tmdb.AnyOf(508, 711)).
+				WatchProviders("US", tmdb.AnyOf(8, 9)).WatchMonetization(tmdb.MonetizationFlatrate, tmdb.MonetizationRent),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want, err := tt.discover.Options()
+			if err != nil {
+				t.Fatal(err)
+			}
+			query, err := tt.discover.Query()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.wantQuery != "" && query.Encode() != tt.wantQuery {
+				t.Errorf("got query %s, want %s", query.Encode(), tt.wantQuery)
+			}
+
+			parsed, err := tmdb.ParseMovieDiscover(query.Encode())
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := parsed.Options()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("got %+v after a round trip, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestTVDiscoverRoundTrip(t *testing.T) {
+	tests := []struct {
+		name      string
+		discover  *tmdb.TVDiscover
+		wantQuery string
+	}{
+		{name: "empty", discover: tmdb.NewTVDiscover()},
+		{
+			name:      "and or filters",
+			discover:  tmdb.NewTVDiscover().Networks(tmdb.AnyOf(49, 213)).Genres(tmdb.AllOf(18, 10765)),
+			wantQuery: "with_genres=18%2C10765&with_networks=49%7C213",
+		},
+		{
+			name:      "dates and sort",
+			discover:  tmdb.NewTVDiscover().FirstAiredBetween(date(2011, time.January, 1), date(2011, time.December, 31)).SortBy(tmdb.SortFirstAirDateDesc),
+			wantQuery: "first_air_date.gte=2011-01-01&first_air_date.lte=2011-12-31&sort_by=first_air_date.desc",
+		},
+		{
+			name: "every kind of field",
+			discover: tmdb.NewTVDiscover().
+				Language("en-US").Page(3).Timezone("America/New_York").
+				AiredBetween(date(2019, time.April, 14), date(2019, time.May, 19)).
+				IncludeNullFirstAirDates(false).ScreenedTheatrically(true).
+				MinVoteCount(10).MinVoteAverage(8).MinRuntime(30).
+				WithoutCompanies(tmdb.AllOf(1)).Status(tmdb.AnyOf(0, 3)).
+				WatchProviders("US", tmdb.AnyOf(384)).WatchMonetization(tmdb.MonetizationFlatrate),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want, err := tt.discover.Options()
+			if err != nil {
+				t.Fatal(err)
+			}
+			query, err := tt.discover.Query()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.wantQuery != "" && query.Encode() != tt.wantQuery {
+				t.Errorf("got query %s, want %s", query.Encode(), tt.wantQuery)
+			}
+
+			parsed, err := tmdb.ParseTVDiscover(query.Encode())
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := parsed.Options()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("got %+v after a round trip, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestDiscoverInvalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		options    func() (interface{}, error)
+		wantFields []string
+	}{
+		{
+			name: "sort of tv shows for movies",
+			options: func() (interface{}, error) {
+				return tmdb.NewMovieDiscover().SortBy(tmdb.SortFirstAirDateDesc).Options()
+			},
+			wantFields: []string{"sort_by"},
+		},
+		{
+			name: "sort of movies for tv shows",
+			options: func() (interface{}, error) {
+				return tmdb.NewTVDiscover().SortBy(tmdb.SortRevenueDesc).Options()
+			},
+			wantFields: []string{"sort_by"},
+		},
+		{
+			name: "dates out of order",
+			options: func() (interface{}, error) {
+				return tmdb.NewMovieDiscover().ReleasedBetween(date(2000, time.January, 1), date(1999, time.January, 1)).Options()
+			},
+			wantFields: []string{"release_date.gte"},
+		},
+		{
+			name: "vote average out of range",
+			options: func() (interface{}, error) {
+				return tmdb.NewTVDiscover().MaxVoteAverage(11).Options()
+			},
+			wantFields: []string{"vote_average.lte"},
+		},
+		{
+			name: "vote counts out of order",
+			options: func() (interface{}, error) {
+				return tmdb.NewMovieDiscover().MinVoteCount(100).MaxVoteCount(10).Options()
+			},
+			wantFields: []string{"vote_count.gte"},
+		},
+		{
+			name: "unknown sort in query",
+			options: func() (interface{}, error) {
+				return tmdb.ParseMovieDiscover("sort_by=budget.desc")
+			},
+			wantFields: []string{"sort_by"},
+		},
+		{
+			name: "malformed date in query",
+			options: func() (interface{}, error) {
+				return tmdb.ParseTVDiscover("air_date.gte=14/04/2019")
+			},
+			wantFields: []string{"air_date.gte"},
+		},
+		{
+			name: "mixed and or in query",
+			options: func() (interface{}, error) {
+				return tmdb.ParseMovieDiscover("with_genres=28,12|16")
+			},
+			wantFields: []string{"with_genres"},
+		},
+		{
+			name: "unknown release type in query",
+			options: func() (interface{}, error) {
+				return tmdb.ParseMovieDiscover("with_release_type=7")
+			},
+			wantFields: []string{"with_release_type"},
+		},
+		{
+			name: "malformed number in query",
+			options: func() (interface{}, error) {
+				return tmdb.ParseMovieDiscover("vote_count.gte=many")
+			},
+		},
+		{
+			name: "malformed query",
+			options: func() (interface{}, error) {
+				return tmdb.ParseTVDiscover("page=%zz")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.options()
+			if err == nil {
+				t.Fatal("got no error")
+			}
+			if got := invalidFields(err); !reflect.DeepEqual(got, tt.wantFields) {
+				t.Errorf("got invalid fields %v, want %v", got, tt.wantFields)
+			}
+		})
+	}
+}
+
+func TestParseIDFilter(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    tmdb.IDFilter
+		wantErr bool
+	}{
+		{value: ""},
+		{value: "28", want: tmdb.AllOf(28)},
+		{value: "28,12", want: tmdb.AllOf(28, 12)},
+		{value: "28|12", want: tmdb.AnyOf(28, 12)},
+		{value: "28,12|16", wantErr: true},
+		{value: "action", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := tmdb.ParseIDFilter(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %t", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			if !tt.wantErr && got.String() != tt.value {
+				t.Errorf("got string %q, want %q", got.String(), tt.value)
+			}
+		})
+	}
+}
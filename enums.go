@@ -0,0 +1,136 @@
+package tmdb
+
+import (
+	"github.com/pkg/errors"
+)
+
+// TimeWindow represents the time window of trending requests.
+type TimeWindow string
+
+// Available time windows.
+const (
+	TimeWindowDay  TimeWindow = "day"
+	TimeWindowWeek TimeWindow = "week"
+)
+
+// Validate checks whether the time window is supported by TMDb.
+func (tw TimeWindow) Validate() error {
+	switch tw {
+	case TimeWindowDay, TimeWindowWeek:
+		return nil
+	}
+	return errors.Errorf("unsupported time window %q, allowed values: day, week", tw)
+}
+
+// ExternalSource represents an external source of ids in find requests.
+type ExternalSource string
+
+// Available external sources.
+const (
+	ExternalSourceIMDb        ExternalSource = "imdb_id"
+	ExternalSourceFreebaseMID ExternalSource = "freebase_mid"
+	ExternalSourceFreebaseID  ExternalSource = "freebase_id"
+	ExternalSourceTVDB        ExternalSource = "tvdb_id"
+	ExternalSourceTVRage      ExternalSource = "tvrage_id"
+	ExternalSourceFacebook    ExternalSource = "facebook_id"
+	ExternalSourceTwitter     ExternalSource = "twitter_id"
+	ExternalSourceInstagram   ExternalSource = "instagram_id"
+)
+
+// Validate checks whether the external source is supported by TMDb.
+func (es ExternalSource) Validate() error {
+	switch es {
+	case ExternalSourceIMDb, ExternalSourceFreebaseMID, ExternalSourceFreebaseID, ExternalSourceTVDB,
+		ExternalSourceTVRage, ExternalSourceFacebook, ExternalSourceTwitter, ExternalSourceInstagram:
+		return nil
+	}
+	return errors.Errorf("unsupported external source %q, allowed values: imdb_id, freebase_mid, freebase_id, "+
+		"tvdb_id, tvrage_id, facebook_id, twitter_id, instagram_id", es)
+}
+
+// ReleaseType represents the type of a movie release.
+type ReleaseType int
+
+// Available release types.
+const (
+	ReleaseTypePremiere ReleaseType = iota + 1
+	ReleaseTypeTheatricalLimited
+	ReleaseTypeTheatrical
+	ReleaseTypeDigital
+	ReleaseTypePhysical
+	ReleaseTypeTV
+)
+
+// Validate checks whether the release type is supported by TMDb.
+func (rt ReleaseType) Validate() error {
+	if rt < ReleaseTypePremiere || rt > ReleaseTypeTV {
+		return errors.Errorf("unsupported release type %d, allowed values: 1 to 6", rt)
+	}
+	return nil
+}
+
+// EpisodeGroupType represents the type of a tv show episode group.
+type EpisodeGroupType int
+
+// Available episode group types.
+const (
+	EpisodeGroupTypeOriginalAirDate EpisodeGroupType = iota + 1
+	EpisodeGroupTypeAbsolute
+	EpisodeGroupTypeDVD
+	EpisodeGroupTypeDigital
+	EpisodeGroupTypeStoryArc
+	EpisodeGroupTypeProduction
+	EpisodeGroupTypeTV
+)
+
+// Validate checks whether the episode group type is supported by TMDb.
+func (gt EpisodeGroupType) Validate() error {
+	if gt < EpisodeGroupTypeOriginalAirDate || gt > EpisodeGroupTypeTV {
+		return errors.Errorf("unsupported episode group type %d, allowed values: 1 to 7", gt)
+	}
+	return nil
+}
+
+// AccountSort represents a sort option of account requests.
+type AccountSort string
+
+// Available account sort options.
+const (
+	AccountSortCreatedAtAsc  AccountSort = "created_at.asc"
+	AccountSortCreatedAtDesc AccountSort = "created_at.desc"
+)
+
+// Validate checks whether the sort option is supported by TMDb, an empty one meaning the default.
+func (s AccountSort) Validate() error {
+	switch s {
+	case "", AccountSortCreatedAtAsc, AccountSortCreatedAtDesc:
+		return nil
+	}
+	return errors.Errorf("unsupported sort_by %q, allowed values: created_at.asc, created_at.desc", s)
+}
+
+// ListSort represents a sort option of v4 list items.
+type ListSort string
+
+// Available list sort options.
+const (
+	ListSortOriginalOrderAsc       ListSort = "original_order.asc"
+	ListSortOriginalOrderDesc      ListSort = "original_order.desc"
+	ListSortVoteAverageAsc         ListSort = "vote_average.asc"
+	ListSortVoteAverageDesc        ListSort = "vote_average.desc"
+	ListSortPrimaryReleaseDateAsc  ListSort = "primary_release_date.asc"
+	ListSortPrimaryReleaseDateDesc ListSort = "primary_release_date.desc"
+	ListSortTitleAsc               ListSort = "title.asc"
+	ListSortTitleDesc              ListSort = "title.desc"
+)
+
+// Validate checks whether the sort option is supported by TMDb, an empty one meaning the default.
+func (s ListSort) Validate() error {
+	switch s {
+	case "", ListSortOriginalOrderAsc, ListSortOriginalOrderDesc, ListSortVoteAverageAsc, ListSortVoteAverageDesc,
+		ListSortPrimaryReleaseDateAsc, ListSortPrimaryReleaseDateDesc, ListSortTitleAsc, ListSortTitleDesc:
+		return nil
+	}
+	return errors.Errorf("unsupported sort_by %q, allowed values: original_order, vote_average, "+
+		"primary_release_date, title, each with .asc or .desc", s)
+}
@@ -0,0 +1,97 @@
+package tmdb_test
+
+import (
+	"testing"
+
+	"github.com/mdvalv/go-tmdb"
+	"github.com/mdvalv/go-tmdb/tmdbtest"
+)
+
+func TestEnumsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{ Validate() error }
+		wantErr bool
+	}{
+		{name: "time window day", value: tmdb.TimeWindowDay},
+		{name: "time window week", value: tmdb.TimeWindowWeek},
+		{name: "time window month", value: tmdb.TimeWindow("month"), wantErr: true},
+		{name: "empty time window", value: tmdb.TimeWindow(""), wantErr: true},
+		{name: "imdb source", value: tmdb.ExternalSourceIMDb},
+		{name: "instagram source", value: tmdb.ExternalSourceInstagram},
+		{name: "unknown source", value: tmdb.ExternalSource("imdb"), wantErr: true},
+		{name: "first release type", value: tmdb.ReleaseTypePremiere},
+		{name: "last release type", value: tmdb.ReleaseTypeTV},
+		{name: "zero release type", value: tmdb.ReleaseType(0), wantErr: true},
+		{name: "release type after the last", value: tmdb.ReleaseType(7), wantErr: true},
+		{name: "first episode group type", value: tmdb.EpisodeGroupTypeOriginalAirDate},
+		{name: "last episode group type", value: tmdb.EpisodeGroupTypeTV},
+		{name: "zero episode group type", value: tmdb.EpisodeGroupType(0), wantErr: true},
+		{name: "episode group type after the last", value: tmdb.EpisodeGroupType(8), wantErr: true},
+		{name: "default account sort", value: tmdb.AccountSort("")},
+		{name: "account sort", value: tmdb.AccountSortCreatedAtDesc},
+		{name: "unknown account sort", value: tmdb.AccountSort("created_at"), wantErr: true},
+		{name: "default list sort", value: tmdb.ListSort("")},
+		{name: "list sort", value: tmdb.ListSortPrimaryReleaseDateAsc},
+		{name: "unknown list sort", value: tmdb.ListSort("popularity.desc"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.value.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %t", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEnumsCheckedBeforeSending(t *testing.T) {
+	tests := []struct {
+		name string
+		do   func(client *tmdb.Client) error
+	}{
+		{
+			name: "trending time window",
+			do: func(client *tmdb.Client) error {
+				_, _, err := client.Trending.GetTrendingMovies("month")
+				return err
+			},
+		},
+		{
+			name: "find external source",
+			do: func(client *tmdb.Client) error {
+				_, _, err := client.Find.Find("tt0137523", "imdb", nil)
+				return err
+			},
+		},
+		{
+			name: "account sort",
+			do: func(client *tmdb.Client) error {
+				_, _, err := client.Account.GetFavoriteMovies(tmdbtest.AccountID, tmdbtest.SessionID, &tmdb.AccountOptions{SortBy: "title.asc"})
+				return err
+			},
+		},
+		{
+			name: "list sort",
+			do: func(client *tmdb.Client) error {
+				_, _, err := client.ListsV4.GetList(1, &tmdb.ListV4Options{SortBy: "popularity.desc"})
+				return err
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := tmdbtest.NewServer()
+			defer server.Close()
+			client, err := server.Client()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tt.do(client); err == nil {
+				t.Error("got no error")
+			}
+			if got := len(server.Requests()); got != 0 {
+				t.Errorf("got %d requests sent", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package tmdb
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/pkg/errors"
+)
+
+// TMDb status codes.
+// Full list at https://developers.themoviedb.org/3/getting-started/status-codes
+const (
+	StatusAuthenticationFailed     = 3
+	StatusInvalidAPIKey            = 7
+	StatusSuspendedAPIKey          = 10
+	StatusUserAuthenticationFailed = 14
+	StatusSessionDenied            = 17
+	StatusRequestLimitExceeded     = 25
+	StatusInvalidCredentials       = 30
+	StatusInvalidRequestToken      = 33
+	StatusResourceNotFound         = 34
+)
+
+// APIError represents an error returned by TMDb API.
+type APIError struct {
+	// HTTP status code of the response.
+	HTTPStatus int `json:"-"`
+
+	// HTTP method and path of the request that failed.
+	Method string `json:"-"`
+	Path   string `json:"-"`
+
+	// TMDb status code and message.
+	StatusCode    int    `json:"status_code"`
+	StatusMessage string `json:"status_message"`
+
+	// Some endpoints return a list of errors instead of a status code.
+	Errors []string `json:"errors"`
+}
+
+// Error returns the error message.
+func (e *APIError) Error() string {
+	message := e.StatusMessage
+	if message == "" {
+		message = strings.Join(e.Errors, ", ")
+	}
+	if message == "" {
+		message = http.StatusText(e.HTTPStatus)
+	}
+	if e.StatusCode != 0 {
+		return fmt.Sprintf("%s %s: %d %s (status code %d)", e.Method, e.Path, e.HTTPStatus, message, e.StatusCode)
+	}
+	return fmt.Sprintf("%s %s: %d %s", e.Method, e.Path, e.HTTPStatus, message)
+}
+
+// IsNotFound reports whether the error means the requested resource could not be found.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.HTTPStatus == http.StatusNotFound || apiErr.StatusCode == StatusResourceNotFound
+}
+
+// IsUnauthorized reports whether the error means the API key, session or credentials were rejected.
+func IsUnauthorized(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	if apiErr.HTTPStatus == http.StatusUnauthorized {
+		return true
+	}
+	switch apiErr.StatusCode {
+	case StatusAuthenticationFailed, StatusInvalidAPIKey, StatusSuspendedAPIKey,
+		StatusUserAuthenticationFailed, StatusSessionDenied, StatusInvalidCredentials, StatusInvalidRequestToken:
+		return true
+	}
+	return false
+}
+
+// IsRateLimited reports whether the error means the request count is over the allowed limit.
+func IsRateLimited(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.HTTPStatus == http.StatusTooManyRequests || apiErr.StatusCode == StatusRequestLimitExceeded
+}
+
+// newAPIError builds an APIError from an unsuccessful response.
+func newAPIError(resp *resty.Response) *APIError {
+	apiErr := &APIError{
+		HTTPStatus: resp.StatusCode(),
+	}
+	if req := resp.Request; req != nil {
+		apiErr.Method = req.Method
+		if req.RawRequest != nil {
+			apiErr.Path = req.RawRequest.URL.Path
+		}
+	}
+	// Bodies that are not in the TMDb error format are ignored
+	// and the HTTP status text is used as message instead.
+	_ = json.Unmarshal(resp.Body(), apiErr)
+	return apiErr
+}
@@ -0,0 +1,145 @@
+package tmdb_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/mdvalv/go-tmdb"
+	"github.com/mdvalv/go-tmdb/tmdbtest"
+	"github.com/pkg/errors"
+)
+
+func TestAPIErrorMessage(t *testing.T) {
+	tests := []struct {
+		name string
+		err  *tmdb.APIError
+		want string
+	}{
+		{
+			name: "status message",
+			err: &tmdb.APIError{
+				HTTPStatus: http.StatusNotFound, Method: http.MethodGet, Path: "/3/movie/1",
+				StatusCode: tmdb.StatusResourceNotFound, StatusMessage: "The resource you requested could not be found.",
+			},
+			want: "GET /3/movie/1: 404 The resource you requested could not be found. (status code 34)",
+		},
+		{
+			name: "list of errors",
+			err: &tmdb.APIError{
+				HTTPStatus: http.StatusUnprocessableEntity, Method: http.MethodGet, Path: "/3/search/movie",
+				Errors: []string{"query must be provided", "page must be less than or equal to 500"},
+			},
+			want: "GET /3/search/movie: 422 query must be provided, page must be less than or equal to 500",
+		},
+		{
+			name: "http status text",
+			err:  &tmdb.APIError{HTTPStatus: http.StatusBadGateway, Method: http.MethodPost, Path: "/3/list"},
+			want: "POST /3/list: 502 Bad Gateway",
+		},
+		{
+			name: "status code without message",
+			err:  &tmdb.APIError{HTTPStatus: http.StatusUnauthorized, Method: http.MethodGet, Path: "/3/account", StatusCode: tmdb.StatusSessionDenied},
+			want: "GET /3/account: 401 Unauthorized (status code 17)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Error(); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsErrors(t *testing.T) {
+	tests := []struct {
+		name             string
+		err              error
+		wantNotFound     bool
+		wantUnauthorized bool
+		wantRateLimited  bool
+	}{
+		{name: "nil"},
+		{name: "not an api error", err: errors.New("failed")},
+		{name: "not found status", err: &tmdb.APIError{HTTPStatus: http.StatusNotFound}, wantNotFound: true},
+		{name: "resource not found code", err: &tmdb.APIError{StatusCode: tmdb.StatusResourceNotFound}, wantNotFound: true},
+		{name: "unauthorized status", err: &tmdb.APIError{HTTPStatus: http.StatusUnauthorized}, wantUnauthorized: true},
+		{name: "authentication failed", err: &tmdb.APIError{StatusCode: tmdb.StatusAuthenticationFailed}, wantUnauthorized: true},
+		{name: "invalid api key", err: &tmdb.APIError{StatusCode: tmdb.StatusInvalidAPIKey}, wantUnauthorized: true},
+		{name: "suspended api key", err: &tmdb.APIError{StatusCode: tmdb.StatusSuspendedAPIKey}, wantUnauthorized: true},
+		{name: "user authentication failed", err: &tmdb.APIError{StatusCode: tmdb.StatusUserAuthenticationFailed}, wantUnauthorized: true},
+		{name: "session denied", err: &tmdb.APIError{StatusCode: tmdb.StatusSessionDenied}, wantUnauthorized: true},
+		{name: "invalid credentials", err: &tmdb.APIError{StatusCode: tmdb.StatusInvalidCredentials}, wantUnauthorized: true},
+		{name: "invalid request token", err: &tmdb.APIError{StatusCode: tmdb.StatusInvalidRequestToken}, wantUnauthorized: true},
+		{name: "too many requests status", err: &tmdb.APIError{HTTPStatus: http.StatusTooManyRequests}, wantRateLimited: true},
+		{name: "request limit exceeded code", err: &tmdb.APIError{StatusCode: tmdb.StatusRequestLimitExceeded}, wantRateLimited: true},
+		{name: "server error", err: &tmdb.APIError{HTTPStatus: http.StatusInternalServerError}},
+		{name: "wrapped", err: errors.Wrap(&tmdb.APIError{HTTPStatus: http.StatusNotFound}, "failed to get movie"), wantNotFound: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tmdb.IsNotFound(tt.err); got != tt.wantNotFound {
+				t.Errorf("got IsNotFound %t, want %t", got, tt.wantNotFound)
+			}
+			if got := tmdb.IsUnauthorized(tt.err); got != tt.wantUnauthorized {
+				t.Errorf("got IsUnauthorized %t, want %t", got, tt.wantUnauthorized)
+			}
+			if got := tmdb.IsRateLimited(tt.err); got != tt.wantRateLimited {
+				t.Errorf("got IsRateLimited %t, want %t", got, tt.wantRateLimited)
+			}
+		})
+	}
+}
+
+func TestAPIErrorFromResponse(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   interface{}
+		want   tmdb.APIError
+	}{
+		{
+			name:   "status code",
+			status: http.StatusUnauthorized,
+			body:   map[string]interface{}{"status_code": tmdb.StatusInvalidAPIKey, "status_message": "Invalid API key"},
+			want:   tmdb.APIError{StatusCode: tmdb.StatusInvalidAPIKey, StatusMessage: "Invalid API key"},
+		},
+		{
+			name:   "list of errors",
+			status: http.StatusUnprocessableEntity,
+			body:   map[string]interface{}{"errors": []string{"invalid page"}},
+			want:   tmdb.APIError{Errors: []string{"invalid page"}},
+		},
+		{
+			name:   "not in the error format",
+			status: http.StatusServiceUnavailable,
+			body:   "unavailable",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := tmdbtest.NewServer()
+			defer server.Close()
+			if err := server.Handle(http.MethodGet, "/3/movie/550", tt.status, tt.body); err != nil {
+				t.Fatal(err)
+			}
+			client, err := server.Client()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, _, err = client.Movies.GetMovie(tmdbtest.MovieID, nil)
+			var apiErr *tmdb.APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("got error %v, want an APIError", err)
+			}
+			if apiErr.HTTPStatus != tt.status || apiErr.Method != http.MethodGet || apiErr.Path != "/3/movie/550" {
+				t.Errorf("got request %s %s %d", apiErr.Method, apiErr.Path, apiErr.HTTPStatus)
+			}
+			if apiErr.StatusCode != tt.want.StatusCode || apiErr.StatusMessage != tt.want.StatusMessage ||
+				len(apiErr.Errors) != len(tt.want.Errors) {
+				t.Errorf("got %+v, want %+v", *apiErr, tt.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@
 package main
 
 import (
+	"context"
 	"os"
 
 	"github.com/mdvalv/go-tmdb"
@@ -48,7 +49,7 @@ func (e example) GetFavoriteMovies() {
 func (e example) GetFavoriteMoviesWithOptions() {
 	opt := tmdb.AccountOptions{
 		Language: "pt-BR",
-		SortBy:   "created_at.desc",
+		SortBy:   tmdb.AccountSortCreatedAtDesc,
 	}
 	movies, _, err := e.client.Account.GetFavoriteMovies(accountID, sessionID, &opt)
 	examples.PanicOnError(err)
@@ -64,7 +65,7 @@ func (e example) GetFavoriteTVShows() {
 func (e example) GetFavoriteTVShowsWithOptions() {
 	opt := tmdb.AccountOptions{
 		Language: "pt-BR",
-		SortBy:   "created_at.desc",
+		SortBy:   tmdb.AccountSortCreatedAtDesc,
 	}
 	movies, _, err := e.client.Account.GetFavoriteTVShows(accountID, sessionID, &opt)
 	examples.PanicOnError(err)
@@ -80,7 +81,7 @@ func (e example) GetRatedMovies() {
 func (e example) GetRatedMoviesWithOptions() {
 	opt := tmdb.AccountOptions{
 		Language: "pt-BR",
-		SortBy:   "created_at.desc",
+		SortBy:   tmdb.AccountSortCreatedAtDesc,
 	}
 	movies, _, err := e.client.Account.GetRatedMovies(accountID, sessionID, &opt)
 	examples.PanicOnError(err)
@@ -96,7 +97,7 @@ func (e example) GetRatedTVShows() {
 func (e example) GetRatedTVShowsWithOptions() {
 	opt := tmdb.AccountOptions{
 		Language: "pt-BR",
-		SortBy:   "created_at.desc",
+		SortBy:   tmdb.AccountSortCreatedAtDesc,
 	}
 	tvShows, _, err := e.client.Account.GetRatedTVShows(accountID, sessionID, &opt)
 	examples.PanicOnError(err)
@@ -112,7 +113,7 @@ func (e example) GetRatedTVEpisodes() {
 func (e example) GetRatedTVEpisodesWithOptions() {
 	opt := tmdb.AccountOptions{
 		Language: "pt-BR",
-		SortBy:   "created_at.desc",
+		SortBy:   tmdb.AccountSortCreatedAtDesc,
 	}
 	episodes, _, err := e.client.Account.GetRatedTVEpisodes(accountID, sessionID, &opt)
 	examples.PanicOnError(err)
@@ -128,7 +129,7 @@ func (e example) GetWatchlistMovies() {
 func (e example) GetWatchlistMoviesWithOptions() {
 	opt := tmdb.AccountOptions{
 		Language: "pt-BR",
-		SortBy:   "created_at.desc",
+		SortBy:   tmdb.AccountSortCreatedAtDesc,
 	}
 	movies, _, err := e.client.Account.GetWatchlistMovies(accountID, sessionID, &opt)
 	examples.PanicOnError(err)
@@ -144,7 +145,7 @@ func (e example) GetWatchlistTVShows() {
 func (e example) GetWatchlistTVShowsWithOptions() {
 	opt := tmdb.AccountOptions{
 		Language: "pt-BR",
-		SortBy:   "created_at.desc",
+		SortBy:   tmdb.AccountSortCreatedAtDesc,
 	}
 	tvShows, _, err := e.client.Account.GetWatchlistTVShows(accountID, sessionID, &opt)
 	examples.PanicOnError(err)
@@ -173,6 +174,13 @@ func (e example) Watchlist() {
 	examples.PrettyPrint(*movies)
 }
 
+func (e example) Me() {
+	me := e.client.WithSession(sessionID).Me()
+	movies, _, err := me.Favorites().Movies(context.Background(), nil)
+	examples.PanicOnError(err)
+	examples.PrettyPrint(*movies)
+}
+
 func main() {
 	example := example{
 		client: examples.GetClient(),
@@ -198,5 +206,6 @@ func main() {
 		example.GetWatchlistTVShowsWithOptions, // 17
 		example.Favorite,                       // 18
 		example.Watchlist,                      // 19
+		example.Me,                             // 20
 	)
 }
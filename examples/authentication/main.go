@@ -57,6 +57,15 @@ func (e example) DeleteSession() {
 	examples.PrettyPrint(*success)
 }
 
+func (e example) SessionManagerLogin() {
+	manager := tmdb.NewSessionManager(e.client, tmdb.NewFileSessionStore("sessions.json"))
+	session, err := manager.Login(username, password)
+	examples.PanicOnError(err)
+	account, _, err := session.GetAccount()
+	examples.PanicOnError(err)
+	examples.PrettyPrint(*account)
+}
+
 func main() {
 	example := example{
 		client: examples.GetClient(),
@@ -69,5 +78,6 @@ func main() {
 		example.CreateSession,            // 4
 		example.CreateSessionWithV4Token, // 5
 		example.DeleteSession,            // 6
+		example.SessionManagerLogin,      // 7
 	)
 }
@@ -14,8 +14,8 @@ type example struct {
 func (e example) GetCreditTV() {
 	credit, _, err := e.client.Credits.GetCredit("525331fd19c295794001a5de")
 	examples.PanicOnError(err)
-	if credit.Media.GetMediaType() != "tv" {
-		panic(errors.New("expected tv media type"))
+	if _, ok := credit.Media.(*tmdb.TVShowCredit); !ok {
+		panic(errors.New("expected tv credit"))
 	}
 	examples.PrettyPrint(*credit)
 }
@@ -23,8 +23,8 @@ func (e example) GetCreditTV() {
 func (e example) GetCreditMovie() {
 	credit, _, err := e.client.Credits.GetCredit("52fe43f9c3a368484e0089e3")
 	examples.PanicOnError(err)
-	if credit.Media.GetMediaType() != "movie" {
-		panic(errors.New("expected movie media type"))
+	if _, ok := credit.Media.(*tmdb.MovieCredit); !ok {
+		panic(errors.New("expected movie credit"))
 	}
 	examples.PrettyPrint(*credit)
 }
@@ -2,6 +2,8 @@
 package main
 
 import (
+	"time"
+
 	"github.com/mdvalv/go-tmdb"
 	"github.com/mdvalv/go-tmdb/examples"
 )
@@ -29,6 +31,19 @@ func (e example) DiscoverMoviesWithOptions() {
 	examples.PrettyPrint(*discover)
 }
 
+func (e example) DiscoverMoviesWithBuilder() {
+	opt, err := tmdb.NewMovieDiscover().
+		Genres(tmdb.AnyOf(28, 12)).
+		WithoutKeywords(tmdb.AllOf(210024)).
+		ReleasedBetween(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC)).
+		SortBy(tmdb.SortPopularityDesc).
+		Options()
+	examples.PanicOnError(err)
+	discover, _, err := e.client.Discover.DiscoverMovies(opt)
+	examples.PanicOnError(err)
+	examples.PrettyPrint(*discover)
+}
+
 func (e example) DiscoverTvShows() {
 	discover, _, err := e.client.Discover.DiscoverTVShows(nil)
 	examples.PanicOnError(err)
@@ -60,5 +75,6 @@ func main() {
 		example.DiscoverMoviesWithOptions,  // 2
 		example.DiscoverTvShows,            // 3
 		example.DiscoverTvShowsWithOptions, // 4
+		example.DiscoverMoviesWithBuilder,  // 5
 	)
 }
@@ -13,8 +13,8 @@ type example struct {
 }
 
 const (
-	imdbID = "imdb_id"
-	tvdbID = "tvdb_id"
+	imdbID = tmdb.ExternalSourceIMDb
+	tvdbID = tmdb.ExternalSourceTVDB
 )
 
 func (e example) FindMovie() {
@@ -29,19 +29,11 @@ func (e example) FindPerson() {
 	for _, person := range findings.People {
 		fmt.Println("->", person.Name, "known for:")
 		for _, work := range person.KnownFor {
-			switch work.GetMediaType() {
-			case "movie":
-				movie, err := work.ToMovie()
-				if err != nil {
-					panic(err)
-				}
-				fmt.Printf("movie: %s\n", movie.Title)
-			case "tv":
-				tv, err := work.ToTVShow()
-				if err != nil {
-					panic(err)
-				}
-				fmt.Printf("tv: %s\n", tv.OriginalName)
+			switch work := work.(type) {
+			case *tmdb.Movie:
+				fmt.Printf("movie: %s\n", work.Title)
+			case *tmdb.TVShow:
+				fmt.Printf("tv: %s\n", work.OriginalName)
 			}
 		}
 	}
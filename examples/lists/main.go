@@ -24,19 +24,11 @@ func (e example) GetList() {
 	examples.PanicOnError(err)
 
 	for _, item := range list.Items {
-		switch item.GetMediaType() {
-		case "movie":
-			movie, err := item.ToMovie()
-			if err != nil {
-				panic(err)
-			}
-			fmt.Printf("movie: %s\n", movie.Title)
-		case "tv":
-			tv, err := item.ToTVShow()
-			if err != nil {
-				panic(err)
-			}
-			fmt.Printf("tv: %s\n", tv.OriginalName)
+		switch item := item.(type) {
+		case *tmdb.Movie:
+			fmt.Printf("movie: %s\n", item.Title)
+		case *tmdb.TVShow:
+			fmt.Printf("tv: %s\n", item.OriginalName)
 		}
 	}
 }
@@ -48,19 +40,11 @@ func (e example) GetListWithOptions() {
 	list, _, err := e.client.Lists.GetList(listID, &opt)
 	examples.PanicOnError(err)
 	for _, item := range list.Items {
-		switch item.GetMediaType() {
-		case "movie":
-			movie, err := item.ToMovie()
-			if err != nil {
-				panic(err)
-			}
-			fmt.Printf("movie: %s\n", movie.Title)
-		case "tv":
-			tv, err := item.ToTVShow()
-			if err != nil {
-				panic(err)
-			}
-			fmt.Printf("tv: %s\n", tv.OriginalName)
+		switch item := item.(type) {
+		case *tmdb.Movie:
+			fmt.Printf("movie: %s\n", item.Title)
+		case *tmdb.TVShow:
+			fmt.Printf("tv: %s\n", item.OriginalName)
 		}
 	}
 }
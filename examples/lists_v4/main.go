@@ -0,0 +1,118 @@
+// Lists v4 examples.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/mdvalv/go-tmdb"
+	"github.com/mdvalv/go-tmdb/examples"
+)
+
+type example struct {
+	client *tmdb.Client
+}
+
+const (
+	listID = 1
+)
+
+var accessToken = os.Getenv("ACCESS_TOKEN")
+
+func (e example) GetList() {
+	list, _, err := e.client.ListsV4.GetList(listID, nil, tmdb.WithAccessToken(accessToken))
+	examples.PanicOnError(err)
+
+	for _, item := range list.Items {
+		switch item := item.(type) {
+		case *tmdb.Movie:
+			fmt.Printf("movie: %s\n", item.Title)
+		case *tmdb.TVShow:
+			fmt.Printf("tv: %s\n", item.OriginalName)
+		}
+	}
+}
+
+func (e example) CreateList() {
+	list := tmdb.CreateListV4{
+		Name:    "new list",
+		ISO6391: "en",
+	}
+	response, _, err := e.client.ListsV4.CreateList(accessToken, list)
+	examples.PanicOnError(err)
+	examples.PrettyPrint(*response)
+}
+
+func (e example) UpdateList() {
+	list := tmdb.UpdateListV4{
+		Description: "new list description",
+		SortBy:      tmdb.ListSortTitleAsc,
+	}
+	response, _, err := e.client.ListsV4.UpdateList(accessToken, listID, list)
+	examples.PanicOnError(err)
+	examples.PrettyPrint(*response)
+}
+
+func (e example) AddItems() {
+	items := []tmdb.ListItemV4{
+		{MediaType: "movie", MediaID: 597219},
+		{MediaType: "tv", MediaID: 1399},
+	}
+	response, _, err := e.client.ListsV4.AddItems(accessToken, listID, items)
+	examples.PanicOnError(err)
+	examples.PrettyPrint(*response)
+}
+
+func (e example) UpdateItems() {
+	items := []tmdb.ListItemV4{
+		{MediaType: "tv", MediaID: 1399, Comment: "winter is coming"},
+	}
+	response, _, err := e.client.ListsV4.UpdateItems(accessToken, listID, items)
+	examples.PanicOnError(err)
+	examples.PrettyPrint(*response)
+}
+
+func (e example) GetItemStatus() {
+	status, _, err := e.client.ListsV4.GetItemStatus(accessToken, listID, "movie", 597219)
+	examples.PanicOnError(err)
+	examples.PrettyPrint(*status)
+}
+
+func (e example) RemoveItems() {
+	items := []tmdb.ListItemV4{
+		{MediaType: "movie", MediaID: 597219},
+	}
+	response, _, err := e.client.ListsV4.RemoveItems(accessToken, listID, items)
+	examples.PanicOnError(err)
+	examples.PrettyPrint(*response)
+}
+
+func (e example) Clear() {
+	response, _, err := e.client.ListsV4.Clear(accessToken, listID)
+	examples.PanicOnError(err)
+	examples.PrettyPrint(*response)
+}
+
+func (e example) Delete() {
+	response, _, err := e.client.ListsV4.Delete(accessToken, listID)
+	examples.PanicOnError(err)
+	examples.PrettyPrint(*response)
+}
+
+func main() {
+	example := example{
+		client: examples.GetClient(),
+	}
+
+	examples.RunExamples(
+		example.GetList,       // 1
+		example.CreateList,    // 2
+		example.UpdateList,    // 3
+		example.AddItems,      // 4
+		example.UpdateItems,   // 5
+		example.GetItemStatus, // 6
+		example.RemoveItems,   // 7
+		example.Clear,         // 8
+		example.Delete,        // 9
+	)
+}
@@ -2,7 +2,10 @@
 package main
 
 import (
+	"context"
+	"fmt"
 	"os"
+	"time"
 
 	"github.com/mdvalv/go-tmdb"
 	"github.com/mdvalv/go-tmdb/examples"
@@ -40,8 +43,10 @@ func (e example) DeleteRating() {
 
 func (e example) GetMovie() {
 	opt := tmdb.MovieDetailsOptions{
-		AppendToResponse: "alternative_titles,changes,credits,external_ids,images,keywords,lists,recommendations," +
-			"release_dates,reviews,similar,translations,videos",
+		AppendToResponse: tmdb.MovieAppendAlternativeTitles | tmdb.MovieAppendChanges | tmdb.MovieAppendCredits |
+			tmdb.MovieAppendExternalIDs | tmdb.MovieAppendImages | tmdb.MovieAppendKeywords | tmdb.MovieAppendLists |
+			tmdb.MovieAppendRecommendations | tmdb.MovieAppendReleaseDates | tmdb.MovieAppendReviews |
+			tmdb.MovieAppendSimilar | tmdb.MovieAppendTranslations | tmdb.MovieAppendVideos,
 	}
 	movie, _, err := e.client.Movies.GetMovie(430602, &opt)
 	examples.PanicOnError(err)
@@ -142,6 +147,19 @@ func (e example) GetWatchProviders() {
 	examples.PrettyPrint(*providers)
 }
 
+func (e example) GetFlatrateProviders() {
+	providers, _, err := e.client.Movies.GetWatchProviders(18620)
+	examples.PanicOnError(err)
+	br, ok := providers.Providers.AvailableIn("BR")
+	if !ok {
+		fmt.Println("not available in BR")
+		return
+	}
+	for _, provider := range br.ByMonetization(tmdb.MonetizationFlatrate) {
+		fmt.Printf("streaming on %s\n", provider.ProviderName)
+	}
+}
+
 func (e example) GetMoviesChanges() {
 	opt := tmdb.ChangesOptions{
 		StartDate: "2020-03-26",
@@ -152,7 +170,9 @@ func (e example) GetMoviesChanges() {
 }
 
 func (e example) GetLatest() {
-	latest, _, err := e.client.Movies.GetLatest(nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	latest, _, err := e.client.Movies.GetLatest(nil, tmdb.WithContext(ctx))
 	examples.PanicOnError(err)
 	examples.PrettyPrint(*latest)
 }
@@ -181,6 +201,20 @@ func (e example) GetUpcoming() {
 	examples.PrettyPrint(*movies)
 }
 
+func (e example) GetMany() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	movies, err := e.client.Movies.GetMany(ctx, []int{550, 603, 680, 550}, nil, tmdb.BatchOptions{Concurrency: 2})
+	examples.PanicOnError(err)
+	for _, movie := range movies {
+		if movie.Err != nil {
+			fmt.Printf("%d: %v\n", movie.ID, movie.Err)
+			continue
+		}
+		fmt.Printf("%d: %s\n", movie.ID, movie.Value.Title)
+	}
+}
+
 func main() {
 	example := example{
 		client: examples.GetClient(),
@@ -211,5 +245,7 @@ func main() {
 		example.GetPopular,           // 22
 		example.GetTopRated,          // 23
 		example.GetUpcoming,          // 24
+		example.GetFlatrateProviders, // 25
+		example.GetMany,              // 26
 	)
 }
@@ -20,7 +20,9 @@ func (e example) GetPerson() {
 
 func (e example) GetPersonAppendToResponse() {
 	opt := tmdb.PersonDetailsOptions{
-		AppendToResponse: "changes,combined_credits,external_ids,images,movie_credits,tagged_images,translations,tv_credits",
+		AppendToResponse: tmdb.PersonAppendChanges | tmdb.PersonAppendCombinedCredits | tmdb.PersonAppendExternalIDs |
+			tmdb.PersonAppendImages | tmdb.PersonAppendMovieCredits | tmdb.PersonAppendTaggedImages |
+			tmdb.PersonAppendTranslations | tmdb.PersonAppendTVCredits,
 	}
 	person, _, err := e.client.People.GetPerson(1196961, &opt)
 	examples.PanicOnError(err)
@@ -67,36 +69,20 @@ func (e example) GetCombinedCredits() {
 	examples.PanicOnError(err)
 	fmt.Println("-> Cast:")
 	for _, credit := range credits.Cast {
-		switch credit.GetMediaType() {
-		case "movie":
-			movieCast, err := credit.ToMovieCast()
-			if err != nil {
-				panic(err)
-			}
-			fmt.Printf("movie: %s as %s\n", movieCast.Title, movieCast.Character)
-		case "tv":
-			tvCast, err := credit.ToTVShowCast()
-			if err != nil {
-				panic(err)
-			}
-			fmt.Printf("tv: %s as %s\n", tvCast.Name, tvCast.Character)
+		switch credit := credit.(type) {
+		case *tmdb.CombinedCreditsMovieCast:
+			fmt.Printf("movie: %s as %s\n", credit.Title, credit.Character)
+		case *tmdb.CombinedCreditsTVShowCast:
+			fmt.Printf("tv: %s as %s\n", credit.Name, credit.Character)
 		}
 	}
 	fmt.Println("-> Crew:")
 	for _, credit := range credits.Crew {
-		switch credit.GetMediaType() {
-		case "movie":
-			movieCast, err := credit.ToMovieCrew()
-			if err != nil {
-				panic(err)
-			}
-			fmt.Printf("movie: %s in %s as %s\n", movieCast.Title, movieCast.Department, movieCast.Job)
-		case "tv":
-			tvCast, err := credit.ToTVShowCrew()
-			if err != nil {
-				panic(err)
-			}
-			fmt.Printf("tv: %s in %s as %s\n", tvCast.Name, tvCast.Department, tvCast.Job)
+		switch credit := credit.(type) {
+		case *tmdb.CombinedCreditsMovieCrew:
+			fmt.Printf("movie: %s in %s as %s\n", credit.Title, credit.Department, credit.Job)
+		case *tmdb.CombinedCreditsTVShowCrew:
+			fmt.Printf("tv: %s in %s as %s\n", credit.Name, credit.Department, credit.Job)
 		}
 	}
 }
@@ -2,7 +2,9 @@
 package main
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/mdvalv/go-tmdb"
 	"github.com/mdvalv/go-tmdb/examples"
@@ -36,6 +38,19 @@ func (e example) Movies() {
 	examples.PrettyPrint(*movies)
 }
 
+func (e example) MoviesAllPages() {
+	pager := tmdb.NewPager(func(ctx context.Context, page int) (*tmdb.SearchMovies, *http.Response, error) {
+		return e.client.Search.Movies("alien", nil, tmdb.WithContext(ctx), tmdb.WithPage(page))
+	})
+	pages, err := pager.All(context.Background(), 3)
+	examples.PanicOnError(err)
+	for _, page := range pages {
+		for _, movie := range page.Movies {
+			fmt.Println(movie.Title)
+		}
+	}
+}
+
 func (e example) TVShows() {
 	year := 2020
 	opt := tmdb.SearchTVShowsOptions{
@@ -52,19 +67,11 @@ func (e example) People() {
 	for _, person := range people.People {
 		fmt.Printf("-> %s (%s), known for:\n", person.Name, person.KnownForDepartment)
 		for _, work := range person.KnownFor {
-			switch work.GetMediaType() {
-			case "movie":
-				movie, err := work.ToMovie()
-				if err != nil {
-					panic(err)
-				}
-				fmt.Printf("movie: %s\n", movie.Title)
-			case "tv":
-				tv, err := work.ToTVShow()
-				if err != nil {
-					panic(err)
-				}
-				fmt.Printf("tv: %s\n", tv.OriginalName)
+			switch work := work.(type) {
+			case *tmdb.Movie:
+				fmt.Printf("movie: %s\n", work.Title)
+			case *tmdb.TVShow:
+				fmt.Printf("tv: %s\n", work.OriginalName)
 			}
 		}
 	}
@@ -74,25 +81,13 @@ func (e example) Multi() {
 	multi, _, err := e.client.Search.Multi("carol", nil)
 	examples.PanicOnError(err)
 	for _, result := range multi.Results {
-		switch result.GetMediaType() {
-		case "movie":
-			movie, err := result.ToMovie()
-			if err != nil {
-				panic(err)
-			}
-			fmt.Printf("movie: %s\n", movie.Title)
-		case "tv":
-			tv, err := result.ToTVShow()
-			if err != nil {
-				panic(err)
-			}
-			fmt.Printf("tv: %s\n", tv.OriginalName)
-		case "person":
-			person, err := result.ToPerson()
-			if err != nil {
-				panic(err)
-			}
-			fmt.Printf("person: %s\n", person.Name)
+		switch result := result.(type) {
+		case *tmdb.Movie:
+			fmt.Printf("movie: %s\n", result.Title)
+		case *tmdb.TVShow:
+			fmt.Printf("tv: %s\n", result.OriginalName)
+		case *tmdb.Person:
+			fmt.Printf("person: %s\n", result.Name)
 		}
 	}
 }
@@ -103,12 +98,13 @@ func main() {
 	}
 
 	examples.RunExamples(
-		example.Companies,   // 1
-		example.Collections, // 2
-		example.Keywords,    // 3
-		example.Movies,      // 4
-		example.TVShows,     // 5
-		example.People,      // 6
-		example.Multi,       // 7
+		example.Companies,      // 1
+		example.Collections,    // 2
+		example.Keywords,       // 3
+		example.Movies,         // 4
+		example.TVShows,        // 5
+		example.People,         // 6
+		example.Multi,          // 7
+		example.MoviesAllPages, // 8
 	)
 }
@@ -13,76 +13,56 @@ type example struct {
 }
 
 func (e example) GetTrendingParsingMediaTypes() {
-	trending, _, err := e.client.Trending.GetTrending("week")
+	trending, _, err := e.client.Trending.GetTrending(tmdb.TimeWindowWeek)
 	examples.PanicOnError(err)
 	for _, result := range trending.Results {
-		switch result.GetMediaType() {
-		case "movie":
-			movie, err := result.ToMovie()
-			if err != nil {
-				panic(err)
-			}
-			fmt.Printf("movie: %s\n", movie.Title)
-		case "tv":
-			tv, err := result.ToTVShow()
-			if err != nil {
-				panic(err)
-			}
-			fmt.Printf("tv: %s\n", tv.OriginalName)
-		case "person":
-			person, err := result.ToPerson()
-			if err != nil {
-				panic(err)
-			}
-			fmt.Printf("person: %s\n", person.Name)
+		switch result := result.(type) {
+		case *tmdb.Movie:
+			fmt.Printf("movie: %s\n", result.Title)
+		case *tmdb.TVShow:
+			fmt.Printf("tv: %s\n", result.OriginalName)
+		case *tmdb.Person:
+			fmt.Printf("person: %s\n", result.Name)
 		}
 	}
 }
 
 func (e example) GetTrendingPeopleParsingKnowFor() {
-	trending, _, err := e.client.Trending.GetTrendingPeople("week")
+	trending, _, err := e.client.Trending.GetTrendingPeople(tmdb.TimeWindowWeek)
 	examples.PanicOnError(err)
 	for _, person := range trending.People {
 		fmt.Println("->", person.Name, "known for:")
 		for _, work := range person.KnownFor {
-			switch work.GetMediaType() {
-			case "movie":
-				movie, err := work.ToMovie()
-				if err != nil {
-					panic(err)
-				}
-				fmt.Printf("movie: %s\n", movie.Title)
-			case "tv":
-				tv, err := work.ToTVShow()
-				if err != nil {
-					panic(err)
-				}
-				fmt.Printf("tv: %s\n", tv.OriginalName)
+			switch work := work.(type) {
+			case *tmdb.Movie:
+				fmt.Printf("movie: %s\n", work.Title)
+			case *tmdb.TVShow:
+				fmt.Printf("tv: %s\n", work.OriginalName)
 			}
 		}
 	}
 }
 
 func (e example) GetTrending() {
-	trending, _, err := e.client.Trending.GetTrending("day")
+	trending, _, err := e.client.Trending.GetTrending(tmdb.TimeWindowDay)
 	examples.PanicOnError(err)
 	examples.PrettyPrint(trending)
 }
 
 func (e example) GetTrendingMovies() {
-	trending, _, err := e.client.Trending.GetTrendingMovies("day")
+	trending, _, err := e.client.Trending.GetTrendingMovies(tmdb.TimeWindowDay)
 	examples.PanicOnError(err)
 	examples.PrettyPrint(trending)
 }
 
 func (e example) GetTrendingTVShows() {
-	trending, _, err := e.client.Trending.GetTrendingTVShows("day")
+	trending, _, err := e.client.Trending.GetTrendingTVShows(tmdb.TimeWindowDay)
 	examples.PanicOnError(err)
 	examples.PrettyPrint(trending)
 }
 
 func (e example) GetTrendingPeople() {
-	trending, _, err := e.client.Trending.GetTrendingPeople("day")
+	trending, _, err := e.client.Trending.GetTrendingPeople(tmdb.TimeWindowDay)
 	examples.PanicOnError(err)
 	examples.PrettyPrint(trending)
 }
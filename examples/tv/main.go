@@ -2,6 +2,7 @@
 package main
 
 import (
+	"fmt"
 	"os"
 
 	"github.com/mdvalv/go-tmdb"
@@ -16,14 +17,44 @@ var sessionID = os.Getenv("SESSIONID")
 
 func (e example) GetTVShow() {
 	opt := tmdb.TVShowDetailsOptions{
-		AppendToResponse: "aggregate_credits,alternative_titles,changes,content_ratings,credits,episode_groups," +
-			"external_ids,images,keywords,recommendations,reviews,screened_theatrically,similar,translations,videos",
+		AppendToResponse: tmdb.TVAppendAggregateCredits | tmdb.TVAppendAlternativeTitles | tmdb.TVAppendChanges |
+			tmdb.TVAppendContentRatings | tmdb.TVAppendCredits | tmdb.TVAppendEpisodeGroups | tmdb.TVAppendExternalIDs |
+			tmdb.TVAppendImages | tmdb.TVAppendKeywords | tmdb.TVAppendRecommendations | tmdb.TVAppendReviews |
+			tmdb.TVAppendScreenedTheatrically | tmdb.TVAppendSimilar | tmdb.TVAppendTranslations | tmdb.TVAppendVideos,
 	}
 	tvShow, _, err := e.client.TV.GetTVShow(107005, &opt)
 	examples.PanicOnError(err)
 	examples.PrettyPrint(*tvShow)
 }
 
+func (e example) GetTVShowSeasons() {
+	opt := tmdb.TVShowDetailsOptions{
+		AppendToResponse: tmdb.TVAppendCredits,
+		AppendSeasons:    []int{1, 2},
+	}
+	tvShow, _, err := e.client.TV.GetTVShow(1399, &opt)
+	examples.PanicOnError(err)
+	for seasonNumber, season := range tvShow.SeasonDetails {
+		fmt.Printf("Season %d: %s (%d episodes)\n", seasonNumber, season.Name, len(season.Episodes))
+	}
+}
+
+func (e example) GetFullShow() {
+	opt := tmdb.FullTVShowOptions{
+		AppendToResponse:   tmdb.TVAppendExternalIDs,
+		EpisodeExternalIDs: true,
+	}
+	tvShow, _, err := e.client.TV.GetFullShow(1399, &opt)
+	examples.PanicOnError(err)
+	for _, season := range tvShow.Seasons {
+		for _, episode := range tvShow.SeasonDetails[season.SeasonNumber].Episodes {
+			if episode.ExternalIDs != nil && episode.ExternalIDs.IMDbID != nil {
+				fmt.Printf("S%02dE%02d %s (%s)\n", episode.SeasonNumber, episode.EpisodeNumber, episode.Name, *episode.ExternalIDs.IMDbID)
+			}
+		}
+	}
+}
+
 func (e example) GetAccountStates() {
 	states, _, err := e.client.TV.GetAccountStates(47801, sessionID)
 	examples.PanicOnError(err)
@@ -226,5 +257,7 @@ func main() {
 		example.GetEpisodeGroup,         // 25
 		example.Rate,                    // 26
 		example.DeleteRating,            // 27
+		example.GetTVShowSeasons,        // 28
+		example.GetFullShow,             // 29
 	)
 }
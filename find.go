@@ -14,7 +14,7 @@ type FindResource struct {
 
 // EpisodeFinding represents an episode finding in TMDb.
 type EpisodeFinding struct {
-	AirDate        string  `json:"air_date"`
+	AirDate        Date    `json:"air_date"`
 	EpisodeNumber  int     `json:"episode_number"`
 	ID             int     `json:"id"`
 	MediaType      string  `json:"media_type"`
@@ -31,7 +31,7 @@ type EpisodeFinding struct {
 
 // SeasonFinding represents a season finding in TMDb.
 type SeasonFinding struct {
-	AirDate      string  `json:"air_date"`
+	AirDate      Date    `json:"air_date"`
 	EpisodeCount int     `json:"episode_count"`
 	ID           int     `json:"id"`
 	MediaType    string  `json:"media_type"`
@@ -57,9 +57,12 @@ type FindOptions languageOptions
 // This method will search all objects (movies, TV shows and people) and return the results in a single response.
 // Allowed values for external source:
 //    imdb_id, freebase_mid, freebase_id, tvdb_id, tvrage_id, facebook_id, twitter_id, instagram_id
-func (fr *FindResource) Find(externalID, externalSource string, opt *FindOptions) (*Findings, *http.Response, error) {
+func (fr *FindResource) Find(externalID string, externalSource ExternalSource, opt *FindOptions, options ...RequestOptionFn) (*Findings, *http.Response, error) {
+	if err := externalSource.Validate(); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to find by external id")
+	}
 	path := fmt.Sprintf("/find/%s", externalID)
 	var collection Findings
-	resp, err := fr.client.get(path, &collection, WithQueryParams(opt), WithQueryParam("external_source", externalSource))
+	resp, err := fr.client.get(path, &collection, WithQueryParams(opt), WithQueryParam("external_source", string(externalSource)), withOptions(options...))
 	return &collection, resp, errors.Wrap(err, "failed to find by external id")
 }
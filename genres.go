@@ -27,18 +27,18 @@ type GenresResponse struct {
 type GenresOptions languageOptions
 
 // GetMovieGenres retrieves the list of official genres for movies.
-func (gr *GenresResource) GetMovieGenres(opt *GenresOptions) (*GenresResponse, *http.Response, error) {
-	return gr.getGenres("movie", opt)
+func (gr *GenresResource) GetMovieGenres(opt *GenresOptions, options ...RequestOptionFn) (*GenresResponse, *http.Response, error) {
+	return gr.getGenres("movie", opt, options...)
 }
 
 // GetTVGenres retrieves the list of official genres for TV shows.
-func (gr *GenresResource) GetTVGenres(opt *GenresOptions) (*GenresResponse, *http.Response, error) {
-	return gr.getGenres("tv", opt)
+func (gr *GenresResource) GetTVGenres(opt *GenresOptions, options ...RequestOptionFn) (*GenresResponse, *http.Response, error) {
+	return gr.getGenres("tv", opt, options...)
 }
 
-func (gr *GenresResource) getGenres(listType string, opt *GenresOptions) (*GenresResponse, *http.Response, error) {
+func (gr *GenresResource) getGenres(listType string, opt *GenresOptions, options ...RequestOptionFn) (*GenresResponse, *http.Response, error) {
 	path := fmt.Sprintf("/genre/%s/list", listType)
 	var response GenresResponse
-	resp, err := gr.client.get(path, &response, WithQueryParams(opt))
+	resp, err := gr.client.get(path, &response, WithQueryParams(opt), withOptions(options...))
 	return &response, resp, errors.Wrap(err, fmt.Sprintf("failed to get %s genres", listType))
 }
@@ -23,32 +23,32 @@ type GuestSessionOptions struct {
 
 	// Sort the results.
 	// Allowed Values: created_at.asc, created_at.desc
-	SortBy string `url:"sort_by,omitempty" json:"sort_by,omitempty"`
+	SortBy AccountSort `url:"sort_by,omitempty" json:"sort_by,omitempty"`
 
 	// Specify which page to query.
 	Page *int `url:"page,omitempty" json:"page,omitempty"`
 }
 
 // GetRatedMovies retrieves the list of rated movies.
-func (ar *GuestSessionResource) GetRatedMovies(sessionID string, opt *GuestSessionOptions) (*RatedMovies, *http.Response, error) {
+func (ar *GuestSessionResource) GetRatedMovies(sessionID string, opt *GuestSessionOptions, options ...RequestOptionFn) (*RatedMovies, *http.Response, error) {
 	path := fmt.Sprintf("/guest_session/%s/rated/movies", sessionID)
 	var movies RatedMovies
-	resp, err := ar.client.get(path, &movies, WithQueryParams(opt))
+	resp, err := ar.client.get(path, &movies, WithQueryParams(opt), withOptions(options...))
 	return &movies, resp, errors.Wrap(err, "failed to get rated movies")
 }
 
 // GetRatedTVShows retrieves the list of rated tv shows.
-func (ar *GuestSessionResource) GetRatedTVShows(sessionID string, opt *GuestSessionOptions) (*RatedTVShows, *http.Response, error) {
+func (ar *GuestSessionResource) GetRatedTVShows(sessionID string, opt *GuestSessionOptions, options ...RequestOptionFn) (*RatedTVShows, *http.Response, error) {
 	path := fmt.Sprintf("/guest_session/%s/rated/tv", sessionID)
 	var tvShows RatedTVShows
-	resp, err := ar.client.get(path, &tvShows, WithQueryParams(opt))
+	resp, err := ar.client.get(path, &tvShows, WithQueryParams(opt), withOptions(options...))
 	return &tvShows, resp, errors.Wrap(err, "failed to get rated tv shows")
 }
 
 // GetRatedTVEpisodes retrieves the list of rated tv episodes.
-func (ar *GuestSessionResource) GetRatedTVEpisodes(sessionID string, opt *GuestSessionOptions) (*RatedTVEpisodes, *http.Response, error) {
+func (ar *GuestSessionResource) GetRatedTVEpisodes(sessionID string, opt *GuestSessionOptions, options ...RequestOptionFn) (*RatedTVEpisodes, *http.Response, error) {
 	path := fmt.Sprintf("/guest_session/%s/rated/tv/episodes", sessionID)
 	var episodes RatedTVEpisodes
-	resp, err := ar.client.get(path, &episodes, WithQueryParams(opt))
+	resp, err := ar.client.get(path, &episodes, WithQueryParams(opt), withOptions(options...))
 	return &episodes, resp, errors.Wrap(err, "failed to get rated tv episodes")
 }
@@ -0,0 +1,284 @@
+package tmdb
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// ImageKind represents a kind of image in TMDb, each having its own set of sizes.
+type ImageKind string
+
+// Available image kinds.
+const (
+	ImageKindBackdrop ImageKind = "backdrop"
+	ImageKindLogo     ImageKind = "logo"
+	ImageKindPoster   ImageKind = "poster"
+	ImageKindProfile  ImageKind = "profile"
+	ImageKindStill    ImageKind = "still"
+)
+
+// OriginalImageSize is the size of the image as it was uploaded.
+const OriginalImageSize = "original"
+
+// ImageURLBuilder builds image URLs from the image paths returned by TMDb API.
+// The image configuration is fetched on first use and kept for the lifetime of the builder.
+type ImageURLBuilder struct {
+	client *Client
+
+	mu     sync.Mutex
+	images *ConfigurationImages
+}
+
+// NewImageURLBuilder returns a builder that fetches the image configuration with the client.
+func NewImageURLBuilder(client *Client) *ImageURLBuilder {
+	return &ImageURLBuilder{client: client}
+}
+
+// NewImageURLBuilderWithConfiguration returns a builder using an already known image configuration.
+func NewImageURLBuilderWithConfiguration(images ConfigurationImages) *ImageURLBuilder {
+	return &ImageURLBuilder{images: &images}
+}
+
+// Load fetches the image configuration, unless it is already known.
+// Calling it is optional, but allows setting a context for the request.
+func (b *ImageURLBuilder) Load(ctx context.Context) error {
+	_, err := b.configuration(WithContext(ctx))
+	return err
+}
+
+// configuration retrieves the image configuration, fetching it with the request options if needed.
+// The request is sent without holding the lock, so that a slow request does not block other callers
+// once the configuration is known. The first configuration fetched is kept.
+func (b *ImageURLBuilder) configuration(options ...RequestOptionFn) (*ConfigurationImages, error) {
+	b.mu.Lock()
+	images := b.images
+	b.mu.Unlock()
+	if images != nil {
+		return images, nil
+	}
+	if b.client == nil {
+		return nil, errors.New("no image configuration available")
+	}
+	configuration, _, err := b.client.Configuration.GetAPIConfiguration(withOptions(options...))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get image configuration")
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.images == nil {
+		b.images = &configuration.Images
+	}
+	return b.images, nil
+}
+
+// Sizes retrieves the available sizes for a kind of image.
+// The request options are used to fetch the image configuration, if not known yet.
+func (b *ImageURLBuilder) Sizes(kind ImageKind, options ...RequestOptionFn) ([]string, error) {
+	images, err := b.configuration(options...)
+	if err != nil {
+		return nil, err
+	}
+	return images.sizes(kind)
+}
+
+// sizes retrieves the available sizes for a kind of image.
+func (images *ConfigurationImages) sizes(kind ImageKind) ([]string, error) {
+	switch kind {
+	case ImageKindBackdrop:
+		return images.BackdropSizes, nil
+	case ImageKindLogo:
+		return images.LogoSizes, nil
+	case ImageKindPoster:
+		return images.PosterSizes, nil
+	case ImageKindProfile:
+		return images.ProfileSizes, nil
+	case ImageKindStill:
+		return images.StillSizes, nil
+	}
+	return nil, errors.Errorf("unknown image kind %q", kind)
+}
+
+// URL builds the secure URL of an image, checking that the size is available for its kind.
+// The request options are used to fetch the image configuration, if not known yet.
+func (b *ImageURLBuilder) URL(kind ImageKind, path, size string, options ...RequestOptionFn) (string, error) {
+	if path == "" {
+		return "", errors.New("empty image path")
+	}
+	images, err := b.configuration(options...)
+	if err != nil {
+		return "", err
+	}
+	sizes, err := images.sizes(kind)
+	if err != nil {
+		return "", err
+	}
+	if !containsString(sizes, size) {
+		return "", errors.Errorf("invalid %s size %q, available sizes: %s", kind, size, strings.Join(sizes, ", "))
+	}
+	return strings.TrimSuffix(images.SecureBaseURL, "/") + "/" + size + "/" + strings.TrimPrefix(path, "/"), nil
+}
+
+// BackdropURL builds the URL of a backdrop.
+func (b *ImageURLBuilder) BackdropURL(path, size string, options ...RequestOptionFn) (string, error) {
+	return b.URL(ImageKindBackdrop, path, size, options...)
+}
+
+// LogoURL builds the URL of a logo.
+func (b *ImageURLBuilder) LogoURL(path, size string, options ...RequestOptionFn) (string, error) {
+	return b.URL(ImageKindLogo, path, size, options...)
+}
+
+// PosterURL builds the URL of a poster.
+func (b *ImageURLBuilder) PosterURL(path, size string, options ...RequestOptionFn) (string, error) {
+	return b.URL(ImageKindPoster, path, size, options...)
+}
+
+// ProfileURL builds the URL of a profile picture.
+func (b *ImageURLBuilder) ProfileURL(path, size string, options ...RequestOptionFn) (string, error) {
+	return b.URL(ImageKindProfile, path, size, options...)
+}
+
+// StillURL builds the URL of an episode still.
+func (b *ImageURLBuilder) StillURL(path, size string, options ...RequestOptionFn) (string, error) {
+	return b.URL(ImageKindStill, path, size, options...)
+}
+
+// BestSizeForWidth retrieves the smallest size of a kind of image that is at least px wide.
+// The original size is returned when no sized version is wide enough.
+func (b *ImageURLBuilder) BestSizeForWidth(kind ImageKind, px int, options ...RequestOptionFn) (string, error) {
+	sizes, err := b.Sizes(kind, options...)
+	if err != nil {
+		return "", err
+	}
+	best, bestWidth := OriginalImageSize, 0
+	for _, size := range sizes {
+		if !strings.HasPrefix(size, "w") {
+			continue
+		}
+		width, err := strconv.Atoi(size[1:])
+		if err != nil || width < px {
+			continue
+		}
+		if bestWidth == 0 || width < bestWidth {
+			best, bestWidth = size, width
+		}
+	}
+	return best, nil
+}
+
+// imageURL builds the URL of an optional image path.
+func imageURL(b *ImageURLBuilder, kind ImageKind, path *string, size string, options ...RequestOptionFn) (string, error) {
+	if path == nil {
+		return "", errors.Errorf("no %s available", kind)
+	}
+	return b.URL(kind, *path, size, options...)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// PosterURL builds the URL of the movie poster.
+func (m Movie) PosterURL(b *ImageURLBuilder, size string, options ...RequestOptionFn) (string, error) {
+	return imageURL(b, ImageKindPoster, m.PosterPath, size, options...)
+}
+
+// BackdropURL builds the URL of the movie backdrop.
+func (m Movie) BackdropURL(b *ImageURLBuilder, size string, options ...RequestOptionFn) (string, error) {
+	return imageURL(b, ImageKindBackdrop, m.BackdropPath, size, options...)
+}
+
+// PosterURL builds the URL of the movie poster.
+func (m MovieResult) PosterURL(b *ImageURLBuilder, size string, options ...RequestOptionFn) (string, error) {
+	return imageURL(b, ImageKindPoster, m.PosterPath, size, options...)
+}
+
+// BackdropURL builds the URL of the movie backdrop.
+func (m MovieResult) BackdropURL(b *ImageURLBuilder, size string, options ...RequestOptionFn) (string, error) {
+	return imageURL(b, ImageKindBackdrop, m.BackdropPath, size, options...)
+}
+
+// PosterURL builds the URL of the movie poster.
+func (m MovieDetails) PosterURL(b *ImageURLBuilder, size string, options ...RequestOptionFn) (string, error) {
+	return imageURL(b, ImageKindPoster, m.PosterPath, size, options...)
+}
+
+// BackdropURL builds the URL of the movie backdrop.
+func (m MovieDetails) BackdropURL(b *ImageURLBuilder, size string, options ...RequestOptionFn) (string, error) {
+	return imageURL(b, ImageKindBackdrop, m.BackdropPath, size, options...)
+}
+
+// PosterURL builds the URL of the tv show poster.
+func (t TVShow) PosterURL(b *ImageURLBuilder, size string, options ...RequestOptionFn) (string, error) {
+	return imageURL(b, ImageKindPoster, t.PosterPath, size, options...)
+}
+
+// BackdropURL builds the URL of the tv show backdrop.
+func (t TVShow) BackdropURL(b *ImageURLBuilder, size string, options ...RequestOptionFn) (string, error) {
+	return imageURL(b, ImageKindBackdrop, t.BackdropPath, size, options...)
+}
+
+// PosterURL builds the URL of the tv show poster.
+func (t TVShowResult) PosterURL(b *ImageURLBuilder, size string, options ...RequestOptionFn) (string, error) {
+	return imageURL(b, ImageKindPoster, t.PosterPath, size, options...)
+}
+
+// BackdropURL builds the URL of the tv show backdrop.
+func (t TVShowResult) BackdropURL(b *ImageURLBuilder, size string, options ...RequestOptionFn) (string, error) {
+	return imageURL(b, ImageKindBackdrop, t.BackdropPath, size, options...)
+}
+
+// PosterURL builds the URL of the tv show poster.
+func (t TVShowDetails) PosterURL(b *ImageURLBuilder, size string, options ...RequestOptionFn) (string, error) {
+	return imageURL(b, ImageKindPoster, t.PosterPath, size, options...)
+}
+
+// BackdropURL builds the URL of the tv show backdrop.
+func (t TVShowDetails) BackdropURL(b *ImageURLBuilder, size string, options ...RequestOptionFn) (string, error) {
+	return imageURL(b, ImageKindBackdrop, t.BackdropPath, size, options...)
+}
+
+// PosterURL builds the URL of the season poster.
+func (s Season) PosterURL(b *ImageURLBuilder, size string, options ...RequestOptionFn) (string, error) {
+	return imageURL(b, ImageKindPoster, s.PosterPath, size, options...)
+}
+
+// StillURL builds the URL of the episode still.
+func (e TVEpisode) StillURL(b *ImageURLBuilder, size string, options ...RequestOptionFn) (string, error) {
+	return imageURL(b, ImageKindStill, e.StillPath, size, options...)
+}
+
+// ProfileURL builds the URL of the person profile picture.
+func (p PersonDetails) ProfileURL(b *ImageURLBuilder, size string, options ...RequestOptionFn) (string, error) {
+	return imageURL(b, ImageKindProfile, p.ProfilePath, size, options...)
+}
+
+// ProfileURL builds the URL of the cast member profile picture.
+func (c MovieCast) ProfileURL(b *ImageURLBuilder, size string, options ...RequestOptionFn) (string, error) {
+	return imageURL(b, ImageKindProfile, c.ProfilePath, size, options...)
+}
+
+// ProfileURL builds the URL of the crew member profile picture.
+func (c MovieCrew) ProfileURL(b *ImageURLBuilder, size string, options ...RequestOptionFn) (string, error) {
+	return imageURL(b, ImageKindProfile, c.ProfilePath, size, options...)
+}
+
+// LogoURL builds the URL of the company logo.
+func (c ProductionCompany) LogoURL(b *ImageURLBuilder, size string, options ...RequestOptionFn) (string, error) {
+	return imageURL(b, ImageKindLogo, c.LogoPath, size, options...)
+}
+
+// LogoURL builds the URL of the provider logo.
+func (p Provider) LogoURL(b *ImageURLBuilder, size string, options ...RequestOptionFn) (string, error) {
+	return imageURL(b, ImageKindLogo, p.LogoPath, size, options...)
+}
@@ -0,0 +1,134 @@
+package tmdb_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/mdvalv/go-tmdb"
+	"github.com/mdvalv/go-tmdb/tmdbtest"
+)
+
+var testImages = tmdb.ConfigurationImages{
+	BaseURL:       "http://image.tmdb.org/t/p/",
+	SecureBaseURL: "https://image.tmdb.org/t/p/",
+	BackdropSizes: []string{"w300", "w780", "w1280", "original"},
+	LogoSizes:     []string{"w45", "w92", "w154", "w185", "w300", "w500", "original"},
+	PosterSizes:   []string{"w92", "w154", "w185", "w342", "w500", "w780", "original"},
+	ProfileSizes:  []string{"w45", "w185", "h632", "original"},
+	StillSizes:    []string{"w92", "w185", "w300", "original"},
+}
+
+func TestImageURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		kind    tmdb.ImageKind
+		path    string
+		size    string
+		want    string
+		wantErr bool
+	}{
+		{name: "poster", kind: tmdb.ImageKindPoster, path: "/pB8BM7pdSp6B6Ih7QZ4DrQ3PmJK.jpg", size: "w500", want: "https://image.tmdb.org/t/p/w500/pB8BM7pdSp6B6Ih7QZ4DrQ3PmJK.jpg"},
+		{name: "path without slash", kind: tmdb.ImageKindStill, path: "wrCVHdkBlBWdJUZPvnJWcBRuhSY.jpg", size: "w300", want: "https://image.tmdb.org/t/p/w300/wrCVHdkBlBWdJUZPvnJWcBRuhSY.jpg"},
+		{name: "original size", kind: tmdb.ImageKindBackdrop, path: "/hZkgoQYus5vegHoetLkCJzb17zJ.jpg", size: tmdb.OriginalImageSize, want: "https://image.tmdb.org/t/p/original/hZkgoQYus5vegHoetLkCJzb17zJ.jpg"},
+		{name: "height size", kind: tmdb.ImageKindProfile, path: "/8EueDe6rPF0jQU4LSpsH2Rmrqac.jpg", size: "h632", want: "https://image.tmdb.org/t/p/h632/8EueDe6rPF0jQU4LSpsH2Rmrqac.jpg"},
+		{name: "size of another kind", kind: tmdb.ImageKindLogo, path: "/logo.png", size: "w780", wantErr: true},
+		{name: "unknown kind", kind: "banner", path: "/banner.jpg", size: "w500", wantErr: true},
+		{name: "empty path", kind: tmdb.ImageKindPoster, size: "w500", wantErr: true},
+	}
+	builder := tmdb.NewImageURLBuilderWithConfiguration(testImages)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := builder.URL(tt.kind, tt.path, tt.size)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestImageURLOfModels(t *testing.T) {
+	builder := tmdb.NewImageURLBuilderWithConfiguration(testImages)
+	posterPath := "/pB8BM7pdSp6B6Ih7QZ4DrQ3PmJK.jpg"
+
+	url, err := tmdb.Movie{PosterPath: &posterPath}.PosterURL(builder, "w342")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "https://image.tmdb.org/t/p/w342/pB8BM7pdSp6B6Ih7QZ4DrQ3PmJK.jpg"; url != want {
+		t.Errorf("got %s, want %s", url, want)
+	}
+	if _, err := (tmdb.Movie{}).BackdropURL(builder, "w780"); err == nil {
+		t.Error("got no error without backdrop")
+	}
+}
+
+func TestBestSizeForWidth(t *testing.T) {
+	tests := []struct {
+		name string
+		kind tmdb.ImageKind
+		px   int
+		want string
+	}{
+		{name: "exact width", kind: tmdb.ImageKindPoster, px: 342, want: "w342"},
+		{name: "between widths", kind: tmdb.ImageKindPoster, px: 200, want: "w342"},
+		{name: "zero width", kind: tmdb.ImageKindPoster, px: 0, want: "w92"},
+		{name: "larger than every width", kind: tmdb.ImageKindPoster, px: 1920, want: tmdb.OriginalImageSize},
+		{name: "heights are skipped", kind: tmdb.ImageKindProfile, px: 500, want: tmdb.OriginalImageSize},
+		{name: "widest size", kind: tmdb.ImageKindBackdrop, px: 1280, want: "w1280"},
+	}
+	builder := tmdb.NewImageURLBuilderWithConfiguration(testImages)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := builder.BestSizeForWidth(tt.kind, tt.px)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := builder.BestSizeForWidth("banner", 500); err == nil {
+		t.Error("got no error for an unknown kind")
+	}
+}
+
+func TestImageURLBuilderConfiguration(t *testing.T) {
+	server := tmdbtest.NewServer()
+	defer server.Close()
+	if err := server.Handle(http.MethodGet, "/3/configuration", http.StatusOK, tmdb.Configuration{Images: testImages}); err != nil {
+		t.Fatal(err)
+	}
+	client, err := server.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	builder := tmdb.NewImageURLBuilder(client)
+
+	// The request options are used to fetch the configuration, and failures are not kept.
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := builder.PosterURL("/poster.jpg", "w500", tmdb.WithContext(canceled)); err == nil {
+		t.Fatal("got no error with a canceled context")
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := builder.PosterURL("/poster.jpg", "w500"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := len(server.Requests()); got != 1 {
+		t.Errorf("got %d configuration requests, want 1", got)
+	}
+
+	if _, err := tmdb.NewImageURLBuilderWithConfiguration(testImages).Sizes(tmdb.ImageKindPoster); err != nil {
+		t.Error(err)
+	}
+	if _, err := tmdb.NewImageURLBuilder(nil).Sizes(tmdb.ImageKindPoster); err == nil {
+		t.Error("got no error without client nor configuration")
+	}
+}
@@ -19,20 +19,18 @@ type Keyword struct {
 }
 
 // GetKeyword retrieves a specific keyword.
-func (kr *KeywordsResource) GetKeyword(id int) (*Keyword, *http.Response, error) {
+func (kr *KeywordsResource) GetKeyword(id int, options ...RequestOptionFn) (*Keyword, *http.Response, error) {
 	path := fmt.Sprintf("/keyword/%d", id)
 	var keyword Keyword
-	resp, err := kr.client.get(path, &keyword)
+	resp, err := kr.client.get(path, &keyword, options...)
 	return &keyword, resp, errors.Wrap(err, "failed to get keyword")
 }
 
 // KeywordMovies represents keyword movies in TMDb.
 type KeywordMovies struct {
-	ID           int           `json:"id"`
-	Page         int           `json:"page"`
-	Movies       []MovieResult `json:"results"`
-	TotalPages   int           `json:"total_pages"`
-	TotalResults int           `json:"total_results"`
+	Pagination
+	ID     int           `json:"id"`
+	Movies []MovieResult `json:"results"`
 }
 
 // KeywordMoviesOptions represents the available options for the request.
@@ -54,9 +52,9 @@ type KeywordMoviesOptions struct {
 
 // GetKeywordMovies retrieves the movies that belong to a keyword.
 // It is highly recommend using movie discover instead of this method as it is much more flexible.
-func (kr *KeywordsResource) GetKeywordMovies(id int, opt *KeywordMoviesOptions) (*KeywordMovies, *http.Response, error) {
+func (kr *KeywordsResource) GetKeywordMovies(id int, opt *KeywordMoviesOptions, options ...RequestOptionFn) (*KeywordMovies, *http.Response, error) {
 	path := fmt.Sprintf("/keyword/%d/movies", id)
 	var keyword KeywordMovies
-	resp, err := kr.client.get(path, &keyword, WithQueryParams(opt))
+	resp, err := kr.client.get(path, &keyword, WithQueryParams(opt), withOptions(options...))
 	return &keyword, resp, errors.Wrap(err, "failed to get keyword")
 }
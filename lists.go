@@ -14,25 +14,25 @@ type ListsResource struct {
 
 // List represents a list in TMDb.
 type List struct {
-	CreatedBy     string      `json:"created_by"`
-	Description   string      `json:"description"`
-	FavoriteCount int         `json:"favorite_count"`
-	ID            string      `json:"id"`
-	ISO6391       string      `json:"iso_639_1"`
-	ItemCount     int         `json:"item_count"`
-	Items         []MovieOrTV `json:"items"`
-	Name          string      `json:"name"`
-	PosterPath    *string     `json:"poster_path"`
+	CreatedBy     string          `json:"created_by"`
+	Description   string          `json:"description"`
+	FavoriteCount int             `json:"favorite_count"`
+	ID            string          `json:"id"`
+	ISO6391       string          `json:"iso_639_1"`
+	ItemCount     int             `json:"item_count"`
+	Items         MoviesOrTVShows `json:"items"`
+	Name          string          `json:"name"`
+	PosterPath    *string         `json:"poster_path"`
 }
 
 // ListOptions represents the available options for the request.
 type ListOptions languageOptions
 
 // GetList retrieves the details of a list.
-func (lr *ListsResource) GetList(listID string, opt *ListOptions) (*List, *http.Response, error) {
+func (lr *ListsResource) GetList(listID string, opt *ListOptions, options ...RequestOptionFn) (*List, *http.Response, error) {
 	path := fmt.Sprintf("/list/%s", listID)
 	var list List
-	resp, err := lr.client.get(path, &list, WithQueryParams(opt))
+	resp, err := lr.client.get(path, &list, WithQueryParams(opt), withOptions(options...))
 	return &list, resp, errors.Wrap(err, "failed to get list")
 }
 
@@ -43,10 +43,10 @@ type ItemStatus struct {
 }
 
 // GetItemStatus checks if a movie has already been added to the list.
-func (lr *ListsResource) GetItemStatus(listID string, movieID int) (*ItemStatus, *http.Response, error) {
+func (lr *ListsResource) GetItemStatus(listID string, movieID int, options ...RequestOptionFn) (*ItemStatus, *http.Response, error) {
 	path := fmt.Sprintf("/list/%s/item_status", listID)
 	var status ItemStatus
-	resp, err := lr.client.get(path, &status, WithQueryParam("movie_id", fmt.Sprint(movieID)))
+	resp, err := lr.client.get(path, &status, WithQueryParam("movie_id", fmt.Sprint(movieID)), withOptions(options...))
 	return &status, resp, errors.Wrap(err, "failed to get item status")
 }
 
@@ -57,6 +57,13 @@ type CreateList struct {
 	Language    string `url:"language,omitempty" json:"language,omitempty"`
 }
 
+// Validate checks the list fields.
+func (list CreateList) Validate() error {
+	errs := checkFields(list)
+	errs.checkRequired("name", list.Name)
+	return errs.err()
+}
+
 // CreateListResponse represents response for creating a list in TMDb.
 type CreateListResponse struct {
 	ListID        int    `json:"list_id"`
@@ -66,10 +73,10 @@ type CreateListResponse struct {
 }
 
 // CreateList creates a list.
-func (lr *ListsResource) CreateList(sessionID string, list CreateList) (*CreateListResponse, *http.Response, error) {
+func (lr *ListsResource) CreateList(sessionID string, list CreateList, options ...RequestOptionFn) (*CreateListResponse, *http.Response, error) {
 	path := "/list"
 	var response CreateListResponse
-	resp, err := lr.client.post(path, &response, WithBody(list), WithSessionID(sessionID))
+	resp, err := lr.client.post(path, &response, WithBody(list), WithSessionID(sessionID), withOptions(options...))
 	return &response, resp, errors.Wrap(err, "failed to get item status")
 }
 
@@ -77,10 +84,10 @@ func (lr *ListsResource) CreateList(sessionID string, list CreateList) (*CreateL
 type AddItemResponse statusResponse
 
 // AddMovie adds a movie to a list.
-func (lr *ListsResource) AddMovie(sessionID, listID string, itemID int) (*AddItemResponse, *http.Response, error) {
+func (lr *ListsResource) AddMovie(sessionID, listID string, itemID int, options ...RequestOptionFn) (*AddItemResponse, *http.Response, error) {
 	path := fmt.Sprintf("/list/%s/add_item", listID)
 	var response AddItemResponse
-	resp, err := lr.client.post(path, &response, WithQueryParam("media_id", fmt.Sprint(itemID)), WithSessionID(sessionID))
+	resp, err := lr.client.post(path, &response, WithQueryParam("media_id", fmt.Sprint(itemID)), WithSessionID(sessionID), withOptions(options...))
 	return &response, resp, errors.Wrap(err, "failed to add movie")
 }
 
@@ -88,10 +95,10 @@ func (lr *ListsResource) AddMovie(sessionID, listID string, itemID int) (*AddIte
 type RemoveItemResponse statusResponse
 
 // RemoveMovie removes a movie from a list.
-func (lr *ListsResource) RemoveMovie(sessionID, listID string, itemID int) (*RemoveItemResponse, *http.Response, error) {
+func (lr *ListsResource) RemoveMovie(sessionID, listID string, itemID int, options ...RequestOptionFn) (*RemoveItemResponse, *http.Response, error) {
 	path := fmt.Sprintf("/list/%s/remove_item", listID)
 	var response RemoveItemResponse
-	resp, err := lr.client.post(path, &response, WithQueryParam("media_id", fmt.Sprint(itemID)), WithSessionID(sessionID))
+	resp, err := lr.client.post(path, &response, WithQueryParam("media_id", fmt.Sprint(itemID)), WithSessionID(sessionID), withOptions(options...))
 	return &response, resp, errors.Wrap(err, "failed to remove movie")
 }
 
@@ -99,10 +106,10 @@ func (lr *ListsResource) RemoveMovie(sessionID, listID string, itemID int) (*Rem
 type ClearListResponse statusResponse
 
 // Clear clears all of the items from a list.
-func (lr *ListsResource) Clear(sessionID, listID string) (*ClearListResponse, *http.Response, error) {
+func (lr *ListsResource) Clear(sessionID, listID string, options ...RequestOptionFn) (*ClearListResponse, *http.Response, error) {
 	path := fmt.Sprintf("/list/%s/clear", listID)
 	var response ClearListResponse
-	resp, err := lr.client.post(path, &response, WithQueryParam("confirm", "true"), WithSessionID(sessionID))
+	resp, err := lr.client.post(path, &response, WithQueryParam("confirm", "true"), WithSessionID(sessionID), withOptions(options...))
 	return &response, resp, errors.Wrap(err, "failed to clear list")
 }
 
@@ -110,9 +117,9 @@ func (lr *ListsResource) Clear(sessionID, listID string) (*ClearListResponse, *h
 type DeleteListResponse statusResponse
 
 // Delete deletes a list.
-func (lr *ListsResource) Delete(sessionID, listID string) (*DeleteListResponse, *http.Response, error) {
+func (lr *ListsResource) Delete(sessionID, listID string, options ...RequestOptionFn) (*DeleteListResponse, *http.Response, error) {
 	path := fmt.Sprintf("/list/%s", listID)
 	var response DeleteListResponse
-	resp, err := lr.client.delete(path, &response, WithSessionID(sessionID))
+	resp, err := lr.client.delete(path, &response, WithSessionID(sessionID), withOptions(options...))
 	return &response, resp, errors.Wrap(err, "failed to delete list")
 }
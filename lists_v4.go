@@ -0,0 +1,252 @@
+package tmdb
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// ListsV4Resource handles v4 list-related requests of TMDb API.
+// Lists can hold both movies and tv shows, and their items can have comments.
+// Requests that change a list require a user access token, see AuthenticationV4Resource.
+type ListsV4Resource struct {
+	client *Client
+}
+
+// ListV4Creator represents the creator of a list in TMDb.
+type ListV4Creator struct {
+	AvatarPath   *string `json:"avatar_path"`
+	GravatarHash string  `json:"gravatar_hash"`
+	ID           string  `json:"id"`
+	Name         string  `json:"name"`
+	Username     string  `json:"username"`
+}
+
+// ListV4 represents a v4 list in TMDb.
+type ListV4 struct {
+	Pagination
+	AverageRating float64         `json:"average_rating"`
+	BackdropPath  *string         `json:"backdrop_path"`
+	CreatedBy     ListV4Creator   `json:"created_by"`
+	Description   string          `json:"description"`
+	ID            int             `json:"id"`
+	ISO31661      string          `json:"iso_3166_1"`
+	ISO6391       string          `json:"iso_639_1"`
+	Items         MoviesOrTVShows `json:"results"`
+	Name          string          `json:"name"`
+	PosterPath    *string         `json:"poster_path"`
+	Public        bool            `json:"public"`
+	Revenue       int64           `json:"revenue"`
+	Runtime       int             `json:"runtime"`
+	SortBy        string          `json:"sort_by"`
+
+	// Comments of the items, indexed by "{media_type}:{media_id}", e.g. "movie:550".
+	Comments map[string]*string `json:"comments"`
+
+	// Object ids of the items, indexed by "{media_type}:{media_id}", e.g. "movie:550".
+	ObjectIDs map[string]string `json:"object_ids"`
+}
+
+// ListV4Options represents the available options for the request.
+type ListV4Options struct {
+	// Pass a ISO 639-1 value to display translated data for the fields that support it.
+	// minLength: 2
+	// pattern: ([a-z]{2})-([A-Z]{2})
+	// default: en-US
+	// If the provided language is wrong, it is ignored.
+	Language string `url:"language,omitempty" json:"language,omitempty"`
+
+	// Specify which page to query.
+	Page *int `url:"page,omitempty" json:"page,omitempty"`
+
+	// Sort the results.
+	// Allowed Values: original_order.asc, original_order.desc, vote_average.asc, vote_average.desc,
+	// primary_release_date.asc, primary_release_date.desc, title.asc, title.desc
+	SortBy ListSort `url:"sort_by,omitempty" json:"sort_by,omitempty"`
+}
+
+// GetList retrieves the details of a list.
+// Private lists can only be accessed by their owners, see WithAccessToken.
+func (lr *ListsV4Resource) GetList(listID int, opt *ListV4Options, options ...RequestOptionFn) (*ListV4, *http.Response, error) {
+	path := lr.client.urlV4(fmt.Sprintf("/list/%d", listID))
+	var list ListV4
+	resp, err := lr.client.get(path, &list, WithQueryParams(opt), withOptions(options...))
+	return &list, resp, errors.Wrap(err, "failed to get list")
+}
+
+// CreateListV4 represents a v4 list to be created in TMDb.
+type CreateListV4 struct {
+	Name        string `json:"name"`
+	ISO6391     string `json:"iso_639_1"`
+	Description string `json:"description,omitempty"`
+	Public      *bool  `json:"public,omitempty"`
+	ISO31661    string `json:"iso_3166_1,omitempty"`
+}
+
+// Validate checks the list fields.
+func (list CreateListV4) Validate() error {
+	errs := checkFields(list)
+	errs.checkRequired("name", list.Name)
+	errs.checkRequired("iso_639_1", list.ISO6391)
+	return errs.err()
+}
+
+// CreateListV4Response represents the response for creating a v4 list in TMDb.
+type CreateListV4Response struct {
+	ID            int    `json:"id"`
+	StatusCode    int    `json:"status_code"`
+	StatusMessage string `json:"status_message"`
+	Success       bool   `json:"success"`
+}
+
+// CreateList creates a list.
+func (lr *ListsV4Resource) CreateList(accessToken string, list CreateListV4, options ...RequestOptionFn) (*CreateListV4Response, *http.Response, error) {
+	path := lr.client.urlV4("/list")
+	var response CreateListV4Response
+	resp, err := lr.client.post(path, &response, WithBody(list), WithAccessToken(accessToken), withOptions(options...))
+	return &response, resp, errors.Wrap(err, "failed to create list")
+}
+
+// UpdateListV4 represents the fields of a v4 list to be updated in TMDb.
+type UpdateListV4 struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Public      *bool  `json:"public,omitempty"`
+
+	// Allowed Values: original_order.asc, original_order.desc, vote_average.asc, vote_average.desc,
+	// primary_release_date.asc, primary_release_date.desc, title.asc, title.desc
+	SortBy ListSort `json:"sort_by,omitempty"`
+}
+
+// Validate checks the list fields.
+func (list UpdateListV4) Validate() error {
+	return validateFields(list)
+}
+
+// UpdateListV4Response represents the response for updating a v4 list.
+type UpdateListV4Response statusResponse
+
+// UpdateList updates the details of a list.
+func (lr *ListsV4Resource) UpdateList(accessToken string, listID int, list UpdateListV4, options ...RequestOptionFn) (*UpdateListV4Response, *http.Response, error) {
+	path := lr.client.urlV4(fmt.Sprintf("/list/%d", listID))
+	var response UpdateListV4Response
+	resp, err := lr.client.put(path, &response, WithBody(list), WithAccessToken(accessToken), withOptions(options...))
+	return &response, resp, errors.Wrap(err, "failed to update list")
+}
+
+// ClearListV4Response represents the response for clearing all items from a v4 list.
+type ClearListV4Response struct {
+	ID            int    `json:"id"`
+	ItemsDeleted  int    `json:"items_deleted"`
+	StatusCode    int    `json:"status_code"`
+	StatusMessage string `json:"status_message"`
+	Success       bool   `json:"success"`
+}
+
+// Clear clears all of the items from a list.
+func (lr *ListsV4Resource) Clear(accessToken string, listID int, options ...RequestOptionFn) (*ClearListV4Response, *http.Response, error) {
+	path := lr.client.urlV4(fmt.Sprintf("/list/%d/clear", listID))
+	var response ClearListV4Response
+	resp, err := lr.client.get(path, &response, WithAccessToken(accessToken), withOptions(options...))
+	return &response, resp, errors.Wrap(err, "failed to clear list")
+}
+
+// DeleteListV4Response represents the response for deleting a v4 list.
+type DeleteListV4Response statusResponse
+
+// Delete deletes a list.
+func (lr *ListsV4Resource) Delete(accessToken string, listID int, options ...RequestOptionFn) (*DeleteListV4Response, *http.Response, error) {
+	path := lr.client.urlV4(fmt.Sprintf("/list/%d", listID))
+	var response DeleteListV4Response
+	resp, err := lr.client.delete(path, &response, WithAccessToken(accessToken), withOptions(options...))
+	return &response, resp, errors.Wrap(err, "failed to delete list")
+}
+
+// ListItemV4 represents an item of a v4 list in TMDb.
+type ListItemV4 struct {
+	// Allowed Values: movie, tv
+	MediaType string `json:"media_type"`
+	MediaID   int    `json:"media_id"`
+
+	// Only used when updating items.
+	Comment string `json:"comment,omitempty"`
+}
+
+// listItemsV4 represents the body of bulk item requests.
+type listItemsV4 struct {
+	Items []ListItemV4 `json:"items"`
+}
+
+// Validate checks the items.
+func (items listItemsV4) Validate() error {
+	var errs fieldErrors
+	if len(items.Items) == 0 {
+		errs.add("items", len(items.Items), "at least one item is required")
+	}
+	for i, item := range items.Items {
+		errs.checkMediaType(fmt.Sprintf("items[%d].media_type", i), item.MediaType)
+		if item.MediaID <= 0 {
+			errs.add(fmt.Sprintf("items[%d].media_id", i), item.MediaID, "must be positive")
+		}
+	}
+	return errs.err()
+}
+
+// ListItemV4Result represents the result of a bulk item request for a single item.
+type ListItemV4Result struct {
+	MediaType string `json:"media_type"`
+	MediaID   int    `json:"media_id"`
+	Success   bool   `json:"success"`
+}
+
+// ListItemsV4Response represents the response for bulk item requests.
+type ListItemsV4Response struct {
+	Results       []ListItemV4Result `json:"results"`
+	StatusCode    int                `json:"status_code"`
+	StatusMessage string             `json:"status_message"`
+	Success       bool               `json:"success"`
+}
+
+// AddItems adds movies and tv shows to a list.
+func (lr *ListsV4Resource) AddItems(accessToken string, listID int, items []ListItemV4, options ...RequestOptionFn) (*ListItemsV4Response, *http.Response, error) {
+	path := lr.client.urlV4(fmt.Sprintf("/list/%d/items", listID))
+	var response ListItemsV4Response
+	resp, err := lr.client.post(path, &response, WithBody(listItemsV4{items}), WithAccessToken(accessToken), withOptions(options...))
+	return &response, resp, errors.Wrap(err, "failed to add items")
+}
+
+// UpdateItems updates the comments of items in a list.
+func (lr *ListsV4Resource) UpdateItems(accessToken string, listID int, items []ListItemV4, options ...RequestOptionFn) (*ListItemsV4Response, *http.Response, error) {
+	path := lr.client.urlV4(fmt.Sprintf("/list/%d/items", listID))
+	var response ListItemsV4Response
+	resp, err := lr.client.put(path, &response, WithBody(listItemsV4{items}), WithAccessToken(accessToken), withOptions(options...))
+	return &response, resp, errors.Wrap(err, "failed to update items")
+}
+
+// RemoveItems removes movies and tv shows from a list.
+func (lr *ListsV4Resource) RemoveItems(accessToken string, listID int, items []ListItemV4, options ...RequestOptionFn) (*ListItemsV4Response, *http.Response, error) {
+	path := lr.client.urlV4(fmt.Sprintf("/list/%d/items", listID))
+	var response ListItemsV4Response
+	resp, err := lr.client.delete(path, &response, WithBody(listItemsV4{items}), WithAccessToken(accessToken), withOptions(options...))
+	return &response, resp, errors.Wrap(err, "failed to remove items")
+}
+
+// ItemStatusV4 represents the status of an item in a v4 list in TMDb.
+type ItemStatusV4 struct {
+	ID            int    `json:"id"`
+	MediaID       int    `json:"media_id"`
+	MediaType     string `json:"media_type"`
+	StatusCode    int    `json:"status_code"`
+	StatusMessage string `json:"status_message"`
+	Success       bool   `json:"success"`
+}
+
+// GetItemStatus checks if a movie or tv show has already been added to the list.
+// Allowed mediaType: movie, tv
+func (lr *ListsV4Resource) GetItemStatus(accessToken string, listID int, mediaType string, mediaID int, options ...RequestOptionFn) (*ItemStatusV4, *http.Response, error) {
+	path := lr.client.urlV4(fmt.Sprintf("/list/%d/item_status", listID))
+	var status ItemStatusV4
+	resp, err := lr.client.get(path, &status, WithQueryParam("media_type", mediaType), WithQueryParam("media_id", fmt.Sprint(mediaID)), WithAccessToken(accessToken), withOptions(options...))
+	return &status, resp, errors.Wrap(err, "failed to get item status")
+}
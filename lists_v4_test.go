@@ -0,0 +1,169 @@
+package tmdb_test
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/mdvalv/go-tmdb"
+	"github.com/mdvalv/go-tmdb/tmdbtest"
+)
+
+func TestListsV4URL(t *testing.T) {
+	tests := []struct {
+		name    string
+		baseURL string
+		wantURL string
+	}{
+		{name: "default", wantURL: "https://api.themoviedb.org/4/list/1"},
+		{name: "proxy with version", baseURL: "https://proxy.example.com/tmdb/3", wantURL: "https://proxy.example.com/tmdb/4/list/1"},
+		{name: "proxy without version", baseURL: "http://localhost:8080", wantURL: "http://localhost:8080/4/list/1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var url string
+			options := []tmdb.ClientOption{tmdb.WithTransport(roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				url = r.URL.Scheme + "://" + r.URL.Host + r.URL.Path
+				return jsonResponse(r, `{"id": 1}`), nil
+			}))}
+			if tt.baseURL != "" {
+				options = append(options, tmdb.WithBaseURL(tt.baseURL))
+			}
+			client, err := tmdb.NewClient(tmdbtest.ReadAccessToken, options...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, _, err := client.ListsV4.GetList(1, nil); err != nil {
+				t.Fatal(err)
+			}
+			if url != tt.wantURL {
+				t.Errorf("got url %s, want %s", url, tt.wantURL)
+			}
+		})
+	}
+}
+
+func TestListsV4Requests(t *testing.T) {
+	items := []tmdb.ListItemV4{{MediaType: "movie", MediaID: tmdbtest.MovieID}}
+	tests := []struct {
+		name       string
+		do         func(lists tmdb.ListsV4Service) error
+		wantMethod string
+		wantPath   string
+		wantQuery  string
+		wantBody   string
+	}{
+		{
+			name: "create list",
+			do: func(lists tmdb.ListsV4Service) error {
+				_, _, err := lists.CreateList(tmdbtest.AccessToken, tmdb.CreateListV4{Name: "Favorites", ISO6391: "en"})
+				return err
+			},
+			wantMethod: http.MethodPost,
+			wantPath:   "/4/list",
+			wantBody:   `{"name":"Favorites","iso_639_1":"en"}`,
+		},
+		{
+			name: "update list",
+			do: func(lists tmdb.ListsV4Service) error {
+				_, _, err := lists.UpdateList(tmdbtest.AccessToken, 1, tmdb.UpdateListV4{Description: "Best movies"})
+				return err
+			},
+			wantMethod: http.MethodPut,
+			wantPath:   "/4/list/1",
+			wantBody:   `{"description":"Best movies"}`,
+		},
+		{
+			name: "clear list",
+			do: func(lists tmdb.ListsV4Service) error {
+				_, _, err := lists.Clear(tmdbtest.AccessToken, 1)
+				return err
+			},
+			wantMethod: http.MethodGet,
+			wantPath:   "/4/list/1/clear",
+		},
+		{
+			name: "delete list",
+			do: func(lists tmdb.ListsV4Service) error {
+				_, _, err := lists.Delete(tmdbtest.AccessToken, 1)
+				return err
+			},
+			wantMethod: http.MethodDelete,
+			wantPath:   "/4/list/1",
+		},
+		{
+			name: "add items",
+			do: func(lists tmdb.ListsV4Service) error {
+				_, _, err := lists.AddItems(tmdbtest.AccessToken, 1, items)
+				return err
+			},
+			wantMethod: http.MethodPost,
+			wantPath:   "/4/list/1/items",
+			wantBody:   `{"items":[{"media_type":"movie","media_id":550}]}`,
+		},
+		{
+			name: "update items",
+			do: func(lists tmdb.ListsV4Service) error {
+				_, _, err := lists.UpdateItems(tmdbtest.AccessToken, 1, []tmdb.ListItemV4{{MediaType: "tv", MediaID: tmdbtest.TVShowID, Comment: "Winter is coming"}})
+				return err
+			},
+			wantMethod: http.MethodPut,
+			wantPath:   "/4/list/1/items",
+			wantBody:   `{"items":[{"media_type":"tv","media_id":1399,"comment":"Winter is coming"}]}`,
+		},
+		{
+			name: "remove items",
+			do: func(lists tmdb.ListsV4Service) error {
+				_, _, err := lists.RemoveItems(tmdbtest.AccessToken, 1, items)
+				return err
+			},
+			wantMethod: http.MethodDelete,
+			wantPath:   "/4/list/1/items",
+			wantBody:   `{"items":[{"media_type":"movie","media_id":550}]}`,
+		},
+		{
+			name: "item status",
+			do: func(lists tmdb.ListsV4Service) error {
+				_, _, err := lists.GetItemStatus(tmdbtest.AccessToken, 1, "movie", tmdbtest.MovieID)
+				return err
+			},
+			wantMethod: http.MethodGet,
+			wantPath:   "/4/list/1/item_status",
+			wantQuery:  "media_id=550&media_type=movie",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var request *http.Request
+			var body []byte
+			transport := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				request = r
+				if r.Body != nil {
+					body, _ = io.ReadAll(r.Body)
+				}
+				return jsonResponse(r, `{"success": true}`), nil
+			})
+			client, err := tmdb.NewClient(tmdbtest.ReadAccessToken, tmdb.WithTransport(transport))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tt.do(client.ListsV4); err != nil {
+				t.Fatal(err)
+			}
+
+			if request.Method != tt.wantMethod || request.URL.Path != tt.wantPath {
+				t.Errorf("got %s %s, want %s %s", request.Method, request.URL.Path, tt.wantMethod, tt.wantPath)
+			}
+			if got := request.URL.RawQuery; got != tt.wantQuery {
+				t.Errorf("got query %q, want %q", got, tt.wantQuery)
+			}
+			if got := string(body); got != tt.wantBody {
+				t.Errorf("got body %s, want %s", got, tt.wantBody)
+			}
+			// The user access token takes precedence over the read access token of the client.
+			if got := request.Header.Get("Authorization"); got != "Bearer "+tmdbtest.AccessToken {
+				t.Errorf("got authorization %q, want the access token", got)
+			}
+		})
+	}
+}
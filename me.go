@@ -0,0 +1,264 @@
+package tmdb
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// Me represents the account of a session, managing its favorites, watchlist, ratings and lists
+// without passing the account ID and the session ID to every request.
+// The context of the requests may be nil, in which case context.Background() is used.
+//
+// Example:
+//
+//	me := client.WithSession(sessionID).Me()
+//	movies, _, err := me.Favorites().Movies(ctx, nil)
+//	_, _, err = me.Watchlist().Add(ctx, tmdb.MediaTypeMovie, 550)
+//	_, _, err = me.Rate(ctx, tmdb.RateableMovie(550), 8.5)
+type Me struct {
+	session *SessionClient
+}
+
+// Me returns the account of the session.
+// The account ID is retrieved with the first request needing it, and reused afterwards.
+func (sc *SessionClient) Me() *Me {
+	return &Me{session: sc}
+}
+
+// AccountID returns the ID of the account of the session, retrieving it only once.
+// Failures are not kept, so that the account ID is retrieved again by the next call.
+// Guest sessions have no account and fail with ErrGuestSession.
+func (m *Me) AccountID(ctx context.Context) (int, error) {
+	sc := m.session
+	sc.mu.Lock()
+	accountID := sc.accountID
+	sc.mu.Unlock()
+	if accountID != 0 {
+		return accountID, nil
+	}
+
+	// The lock is not held during the request, concurrent first calls each retrieving the same account ID.
+	account, _, err := sc.GetAccount(WithContext(contextOrBackground(ctx)))
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get account id")
+	}
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	sc.accountID = account.ID
+	return sc.accountID, nil
+}
+
+// account returns the account ID and the session ID of user sessions.
+func (m *Me) account(ctx context.Context) (int, string, error) {
+	accountID, err := m.AccountID(ctx)
+	if err != nil {
+		return 0, "", err
+	}
+	return accountID, m.session.session.SessionID, nil
+}
+
+// contextOrBackground returns the context, context.Background() if it is nil.
+func contextOrBackground(ctx context.Context) context.Context {
+	if ctx == nil {
+		return context.Background()
+	}
+	return ctx
+}
+
+// withContext returns the request options followed by the context, so that the context takes precedence.
+func withContext(ctx context.Context, options []RequestOptionFn) []RequestOptionFn {
+	return append(append([]RequestOptionFn{}, options...), WithContext(contextOrBackground(ctx)))
+}
+
+// Favorites returns the favorites of the account.
+func (m *Me) Favorites() *AccountFavorites {
+	return &AccountFavorites{me: m}
+}
+
+// Watchlist returns the watchlist of the account.
+func (m *Me) Watchlist() *AccountWatchlist {
+	return &AccountWatchlist{me: m}
+}
+
+// Rateable identifies a movie, a tv show or a tv episode to rate, see Me.Rate.
+type Rateable struct {
+	mediaType     string
+	id            int
+	seasonNumber  int
+	episodeNumber int
+}
+
+// RateableMovie identifies a movie to rate.
+func RateableMovie(movieID int) Rateable {
+	return Rateable{mediaType: MediaTypeMovie, id: movieID}
+}
+
+// RateableTVShow identifies a tv show to rate.
+func RateableTVShow(tvID int) Rateable {
+	return Rateable{mediaType: MediaTypeTV, id: tvID}
+}
+
+// RateableTVEpisode identifies a tv episode to rate.
+func RateableTVEpisode(tvID, seasonNumber, episodeNumber int) Rateable {
+	return Rateable{mediaType: mediaTypeTVEpisode, id: tvID, seasonNumber: seasonNumber, episodeNumber: episodeNumber}
+}
+
+// mediaTypeTVEpisode is the media type of the rateable tv episodes.
+const mediaTypeTVEpisode = "tv_episode"
+
+// Rate rates a movie, a tv show or a tv episode, with a user or a guest session.
+func (m *Me) Rate(ctx context.Context, item Rateable, rating float64, options ...RequestOptionFn) (*RateResponse, *http.Response, error) {
+	client, auth := m.session.client, m.session.session.Auth()
+	switch item.mediaType {
+	case MediaTypeMovie:
+		return client.Movies.Rate(item.id, rating, auth, withContext(ctx, options)...)
+	case MediaTypeTV:
+		return client.TV.Rate(item.id, rating, auth, withContext(ctx, options)...)
+	case mediaTypeTVEpisode:
+		return client.TVEpisodes.Rate(item.id, item.seasonNumber, item.episodeNumber, rating, auth, withContext(ctx, options)...)
+	}
+	return nil, nil, errors.New("failed to rate: missing rateable item")
+}
+
+// DeleteRating removes the rating of a movie, a tv show or a tv episode, with a user or a guest session.
+func (m *Me) DeleteRating(ctx context.Context, item Rateable, options ...RequestOptionFn) (*DeleteRatingResponse, *http.Response, error) {
+	client, auth := m.session.client, m.session.session.Auth()
+	switch item.mediaType {
+	case MediaTypeMovie:
+		return client.Movies.DeleteRating(item.id, auth, withContext(ctx, options)...)
+	case MediaTypeTV:
+		return client.TV.DeleteRating(item.id, auth, withContext(ctx, options)...)
+	case mediaTypeTVEpisode:
+		return client.TVEpisodes.DeleteRating(item.id, item.seasonNumber, item.episodeNumber, auth, withContext(ctx, options)...)
+	}
+	return nil, nil, errors.New("failed to delete rating: missing rateable item")
+}
+
+// RatedMovies retrieves the movies rated with the session, for user and guest sessions alike.
+func (m *Me) RatedMovies(ctx context.Context, opt *AccountOptions, options ...RequestOptionFn) (*RatedMovies, *http.Response, error) {
+	if m.session.session.IsGuest() {
+		return m.session.client.GuestSession.GetRatedMovies(m.session.session.GuestSessionID, (*GuestSessionOptions)(opt), withContext(ctx, options)...)
+	}
+	accountID, sessionID, err := m.account(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get rated movies")
+	}
+	return m.session.client.Account.GetRatedMovies(accountID, sessionID, opt, withContext(ctx, options)...)
+}
+
+// RatedTVShows retrieves the tv shows rated with the session, for user and guest sessions alike.
+func (m *Me) RatedTVShows(ctx context.Context, opt *AccountOptions, options ...RequestOptionFn) (*RatedTVShows, *http.Response, error) {
+	if m.session.session.IsGuest() {
+		return m.session.client.GuestSession.GetRatedTVShows(m.session.session.GuestSessionID, (*GuestSessionOptions)(opt), withContext(ctx, options)...)
+	}
+	accountID, sessionID, err := m.account(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get rated tv shows")
+	}
+	return m.session.client.Account.GetRatedTVShows(accountID, sessionID, opt, withContext(ctx, options)...)
+}
+
+// RatedTVEpisodes retrieves the tv episodes rated with the session, for user and guest sessions alike.
+func (m *Me) RatedTVEpisodes(ctx context.Context, opt *AccountOptions, options ...RequestOptionFn) (*RatedTVEpisodes, *http.Response, error) {
+	if m.session.session.IsGuest() {
+		return m.session.client.GuestSession.GetRatedTVEpisodes(m.session.session.GuestSessionID, (*GuestSessionOptions)(opt), withContext(ctx, options)...)
+	}
+	accountID, sessionID, err := m.account(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get rated tv episodes")
+	}
+	return m.session.client.Account.GetRatedTVEpisodes(accountID, sessionID, opt, withContext(ctx, options)...)
+}
+
+// CreatedLists retrieves the lists created by the account.
+func (m *Me) CreatedLists(ctx context.Context, opt *AccountListsOptions, options ...RequestOptionFn) (*CreatedLists, *http.Response, error) {
+	accountID, sessionID, err := m.account(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get created lists")
+	}
+	return m.session.client.Account.GetCreatedLists(accountID, sessionID, opt, withContext(ctx, options)...)
+}
+
+// AccountFavorites represents the favorite movies and tv shows of an account, see Me.Favorites.
+type AccountFavorites struct {
+	me *Me
+}
+
+// Movies retrieves the favorite movies.
+func (af *AccountFavorites) Movies(ctx context.Context, opt *AccountOptions, options ...RequestOptionFn) (*FavoriteMovies, *http.Response, error) {
+	accountID, sessionID, err := af.me.account(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get favorite movies")
+	}
+	return af.me.session.client.Account.GetFavoriteMovies(accountID, sessionID, opt, withContext(ctx, options)...)
+}
+
+// TVShows retrieves the favorite tv shows.
+func (af *AccountFavorites) TVShows(ctx context.Context, opt *AccountOptions, options ...RequestOptionFn) (*FavoriteTVShows, *http.Response, error) {
+	accountID, sessionID, err := af.me.account(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get favorite tv shows")
+	}
+	return af.me.session.client.Account.GetFavoriteTVShows(accountID, sessionID, opt, withContext(ctx, options)...)
+}
+
+// Add adds a movie or a tv show to the favorites, mediaType being either MediaTypeMovie or MediaTypeTV.
+func (af *AccountFavorites) Add(ctx context.Context, mediaType string, mediaID int, options ...RequestOptionFn) (*FavoriteResponse, *http.Response, error) {
+	return af.mark(ctx, Favorite{MediaID: mediaID, MediaType: mediaType, Favorite: true}, options...)
+}
+
+// Remove removes a movie or a tv show from the favorites, mediaType being either MediaTypeMovie or MediaTypeTV.
+func (af *AccountFavorites) Remove(ctx context.Context, mediaType string, mediaID int, options ...RequestOptionFn) (*FavoriteResponse, *http.Response, error) {
+	return af.mark(ctx, Favorite{MediaID: mediaID, MediaType: mediaType, Favorite: false}, options...)
+}
+
+func (af *AccountFavorites) mark(ctx context.Context, favorite Favorite, options ...RequestOptionFn) (*FavoriteResponse, *http.Response, error) {
+	accountID, sessionID, err := af.me.account(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to mark as favorite")
+	}
+	return af.me.session.client.Account.Favorite(accountID, sessionID, favorite, withContext(ctx, options)...)
+}
+
+// AccountWatchlist represents the movies and tv shows in the watchlist of an account, see Me.Watchlist.
+type AccountWatchlist struct {
+	me *Me
+}
+
+// Movies retrieves the movies in the watchlist.
+func (aw *AccountWatchlist) Movies(ctx context.Context, opt *AccountOptions, options ...RequestOptionFn) (*WatchlistMovies, *http.Response, error) {
+	accountID, sessionID, err := aw.me.account(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get movies in watchlist")
+	}
+	return aw.me.session.client.Account.GetWatchlistMovies(accountID, sessionID, opt, withContext(ctx, options)...)
+}
+
+// TVShows retrieves the tv shows in the watchlist.
+func (aw *AccountWatchlist) TVShows(ctx context.Context, opt *AccountOptions, options ...RequestOptionFn) (*WatchlistTVShows, *http.Response, error) {
+	accountID, sessionID, err := aw.me.account(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get tv shows in watchlist")
+	}
+	return aw.me.session.client.Account.GetWatchlistTVShows(accountID, sessionID, opt, withContext(ctx, options)...)
+}
+
+// Add adds a movie or a tv show to the watchlist, mediaType being either MediaTypeMovie or MediaTypeTV.
+func (aw *AccountWatchlist) Add(ctx context.Context, mediaType string, mediaID int, options ...RequestOptionFn) (*WatchlistResponse, *http.Response, error) {
+	return aw.mark(ctx, Watchlist{MediaID: mediaID, MediaType: mediaType, Watchlist: true}, options...)
+}
+
+// Remove removes a movie or a tv show from the watchlist, mediaType being either MediaTypeMovie or MediaTypeTV.
+func (aw *AccountWatchlist) Remove(ctx context.Context, mediaType string, mediaID int, options ...RequestOptionFn) (*WatchlistResponse, *http.Response, error) {
+	return aw.mark(ctx, Watchlist{MediaID: mediaID, MediaType: mediaType, Watchlist: false}, options...)
+}
+
+func (aw *AccountWatchlist) mark(ctx context.Context, watchlist Watchlist, options ...RequestOptionFn) (*WatchlistResponse, *http.Response, error) {
+	accountID, sessionID, err := aw.me.account(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to update watchlist")
+	}
+	return aw.me.session.client.Account.Watchlist(accountID, sessionID, watchlist, withContext(ctx, options)...)
+}
@@ -0,0 +1,194 @@
+package tmdb_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/mdvalv/go-tmdb"
+	"github.com/mdvalv/go-tmdb/tmdbtest"
+)
+
+// accountRequests returns the account requests received by the server.
+func accountRequests(server *tmdbtest.Server) []tmdbtest.Request {
+	var requests []tmdbtest.Request
+	for _, request := range server.Requests() {
+		if request.Path == "/3/account" {
+			requests = append(requests, request)
+		}
+	}
+	return requests
+}
+
+func TestMeAccountID(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	tests := []struct {
+		name         string
+		fail         bool
+		ctx          context.Context
+		wantErr      bool
+		wantRequests int
+	}{
+		{name: "retrieved once", ctx: context.Background(), wantRequests: 1},
+		{name: "nil context", wantRequests: 1},
+		{name: "failures are not kept", ctx: context.Background(), fail: true, wantErr: true, wantRequests: 2},
+		{name: "context is forwarded", ctx: canceled, wantErr: true, wantRequests: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := tmdbtest.NewServer()
+			defer server.Close()
+			if tt.fail {
+				server.Fail(http.MethodGet, "/3/account", http.StatusNotFound)
+			}
+			client, err := server.Client()
+			if err != nil {
+				t.Fatal(err)
+			}
+			me := client.WithSession(tmdbtest.SessionID).Me()
+
+			_, err = me.AccountID(tt.ctx)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %t", err, tt.wantErr)
+			}
+			server.Recover(http.MethodGet, "/3/account")
+			for i := 0; i < 2; i++ {
+				accountID, err := me.AccountID(context.Background())
+				if err != nil {
+					t.Fatal(err)
+				}
+				if accountID != tmdbtest.AccountID {
+					t.Errorf("got account id %d, want %d", accountID, tmdbtest.AccountID)
+				}
+			}
+
+			requests := accountRequests(server)
+			if len(requests) != tt.wantRequests {
+				t.Fatalf("got %d account requests, want %d", len(requests), tt.wantRequests)
+			}
+			for _, request := range requests {
+				if request.Query.Get("session_id") != tmdbtest.SessionID {
+					t.Errorf("got session id %q", request.Query.Get("session_id"))
+				}
+			}
+		})
+	}
+}
+
+func TestMeAccountIDConcurrent(t *testing.T) {
+	server := tmdbtest.NewServer()
+	defer server.Close()
+	client, err := server.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	me := client.WithSession(tmdbtest.SessionID).Me()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if accountID, err := me.AccountID(context.Background()); err != nil || accountID != tmdbtest.AccountID {
+				t.Errorf("got account id %d and error %v", accountID, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	requests := len(accountRequests(server))
+	if _, err := me.AccountID(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(accountRequests(server)); got != requests {
+		t.Errorf("got %d more account requests once retrieved", got-requests)
+	}
+}
+
+func TestMeGuestSession(t *testing.T) {
+	server := tmdbtest.NewServer()
+	defer server.Close()
+	client, err := server.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.WithGuestSession(tmdbtest.GuestSessionID).Me().AccountID(context.Background()); err == nil {
+		t.Error("got no error for a guest session")
+	}
+	if got := len(server.Requests()); got != 0 {
+		t.Errorf("got %d requests for a guest session", got)
+	}
+}
+
+func TestMeRequests(t *testing.T) {
+	tests := []struct {
+		name      string
+		do        func(me *tmdb.Me) error
+		wantPath  string
+		wantQuery string
+	}{
+		{
+			name: "favorite movies",
+			do: func(me *tmdb.Me) error {
+				_, _, err := me.Favorites().Movies(context.Background(), nil, tmdb.WithPage(2))
+				return err
+			},
+			wantPath:  "/3/account/1/favorite/movies",
+			wantQuery: "page=2",
+		},
+		{
+			name: "tv shows in watchlist",
+			do: func(me *tmdb.Me) error {
+				_, _, err := me.Watchlist().TVShows(context.Background(), &tmdb.AccountOptions{Language: "pt-BR"})
+				return err
+			},
+			wantPath:  "/3/account/1/watchlist/tv",
+			wantQuery: "language=pt-BR",
+		},
+		{
+			name: "created lists",
+			do: func(me *tmdb.Me) error {
+				_, _, err := me.CreatedLists(context.Background(), nil)
+				return err
+			},
+			wantPath: "/3/account/1/lists",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := tmdbtest.NewServer()
+			defer server.Close()
+			server.HandleFunc(http.MethodGet, tt.wantPath, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"page": 1, "results": []}`))
+			})
+			client, err := server.Client()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tt.do(client.WithSession(tmdbtest.SessionID).Me()); err != nil {
+				t.Fatal(err)
+			}
+
+			requests := server.Requests()
+			if len(requests) != 2 {
+				t.Fatalf("got %d requests, want the account and the %s requests", len(requests), tt.name)
+			}
+			// The options of the request are not used to retrieve the account ID.
+			if requests[0].Path != "/3/account" || requests[0].Query.Has("page") || requests[0].Query.Has("language") {
+				t.Errorf("got account request %s?%s", requests[0].Path, requests[0].Query.Encode())
+			}
+			request := requests[1]
+			if request.Path != tt.wantPath {
+				t.Errorf("got path %s, want %s", request.Path, tt.wantPath)
+			}
+			request.Query.Del("api_key")
+			request.Query.Del("session_id")
+			if got := request.Query.Encode(); got != tt.wantQuery {
+				t.Errorf("got query %q, want %q", got, tt.wantQuery)
+			}
+		})
+	}
+}
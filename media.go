@@ -0,0 +1,226 @@
+package tmdb
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// Media types in TMDb.
+const (
+	MediaTypeMovie  = "movie"
+	MediaTypeTV     = "tv"
+	MediaTypePerson = "person"
+)
+
+// MovieOrTV can be either a *Movie or a *TVShow in TMDb.
+// Use a type switch to access the concrete type.
+type MovieOrTV interface {
+	GetMediaType() string
+	isMovieOrTV()
+}
+
+// MultiResult can be either a *Movie, a *TVShow or a *Person in TMDb.
+// Use a type switch to access the concrete type.
+type MultiResult interface {
+	GetMediaType() string
+	isMultiResult()
+}
+
+// MediaCredit can be either a *MovieCredit or a *TVShowCredit in TMDb.
+// Use a type switch to access the concrete type.
+type MediaCredit interface {
+	GetMediaType() string
+	isMediaCredit()
+}
+
+// CombinedCreditsCast can be either a *CombinedCreditsMovieCast or a *CombinedCreditsTVShowCast in TMDb.
+// Use a type switch to access the concrete type.
+type CombinedCreditsCast interface {
+	GetMediaType() string
+	isCombinedCreditsCast()
+}
+
+// CombinedCreditsCrew can be either a *CombinedCreditsMovieCrew or a *CombinedCreditsTVShowCrew in TMDb.
+// Use a type switch to access the concrete type.
+type CombinedCreditsCrew interface {
+	GetMediaType() string
+	isCombinedCreditsCrew()
+}
+
+// MoviesOrTVShows represents a list of movies and tv shows in TMDb.
+type MoviesOrTVShows []MovieOrTV
+
+// UnmarshalJSON decodes each item according to its media type.
+func (m *MoviesOrTVShows) UnmarshalJSON(data []byte) error {
+	items, err := movieOrTVDecoder.decodeList(data)
+	*m = items
+	return err
+}
+
+// MultiResults represents a list of movies, tv shows and people in TMDb.
+type MultiResults []MultiResult
+
+// UnmarshalJSON decodes each item according to its media type.
+func (m *MultiResults) UnmarshalJSON(data []byte) error {
+	items, err := multiResultDecoder.decodeList(data)
+	*m = items
+	return err
+}
+
+// mediaDecoder decodes JSON objects into the type registered for their media type.
+type mediaDecoder[T any] map[string]func() T
+
+var (
+	movieOrTVDecoder = mediaDecoder[MovieOrTV]{
+		MediaTypeMovie: func() MovieOrTV { return &Movie{} },
+		MediaTypeTV:    func() MovieOrTV { return &TVShow{} },
+	}
+	multiResultDecoder = mediaDecoder[MultiResult]{
+		MediaTypeMovie:  func() MultiResult { return &Movie{} },
+		MediaTypeTV:     func() MultiResult { return &TVShow{} },
+		MediaTypePerson: func() MultiResult { return &Person{} },
+	}
+	mediaCreditDecoder = mediaDecoder[MediaCredit]{
+		MediaTypeMovie: func() MediaCredit { return &MovieCredit{} },
+		MediaTypeTV:    func() MediaCredit { return &TVShowCredit{} },
+	}
+	combinedCreditsCastDecoder = mediaDecoder[CombinedCreditsCast]{
+		MediaTypeMovie: func() CombinedCreditsCast { return &CombinedCreditsMovieCast{} },
+		MediaTypeTV:    func() CombinedCreditsCast { return &CombinedCreditsTVShowCast{} },
+	}
+	combinedCreditsCrewDecoder = mediaDecoder[CombinedCreditsCrew]{
+		MediaTypeMovie: func() CombinedCreditsCrew { return &CombinedCreditsMovieCrew{} },
+		MediaTypeTV:    func() CombinedCreditsCrew { return &CombinedCreditsTVShowCrew{} },
+	}
+)
+
+// decode decodes a JSON object. Null objects are decoded as the zero value.
+func (d mediaDecoder[T]) decode(data []byte) (T, error) {
+	return d.decodeAs(data, "")
+}
+
+// decodeAs decodes a JSON object whose media type is known from its parent object.
+// The media type is retrieved from the object itself when empty.
+func (d mediaDecoder[T]) decodeAs(data []byte, mediaType string) (T, error) {
+	var value T
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		return value, nil
+	}
+	if mediaType == "" {
+		var err error
+		if mediaType, err = getMediaType(data); err != nil {
+			return value, err
+		}
+	}
+	newValue, ok := d[mediaType]
+	if !ok {
+		return value, &unsupportedMediaTypeError{mediaType: mediaType}
+	}
+	value = newValue()
+	return value, errors.Wrapf(json.Unmarshal(data, value), "failed to decode %s", mediaType)
+}
+
+// unsupportedMediaTypeError is returned when decoding an object whose media type has no registered type.
+type unsupportedMediaTypeError struct {
+	mediaType string
+}
+
+// Error returns the error message.
+func (e *unsupportedMediaTypeError) Error() string {
+	return fmt.Sprintf("unsupported media type %q", e.mediaType)
+}
+
+// decodeList decodes a JSON array of objects.
+// Objects of unsupported media types, such as ones added to TMDb after this client, are skipped
+// so that they do not prevent decoding the others.
+func (d mediaDecoder[T]) decodeList(data []byte) ([]T, error) {
+	var raws []json.RawMessage
+	if err := json.Unmarshal(data, &raws); err != nil {
+		return nil, err
+	}
+	if raws == nil {
+		return nil, nil
+	}
+	items := make([]T, 0, len(raws))
+	for _, raw := range raws {
+		item, err := d.decode(raw)
+		var unsupported *unsupportedMediaTypeError
+		if errors.As(err, &unsupported) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// getMediaType retrieves the media type of a JSON object.
+// Some endpoints leave the media type out, in which case it is inferred from the object fields.
+func getMediaType(data []byte) (string, error) {
+	var fields struct {
+		MediaType          string           `json:"media_type"`
+		FirstAirDate       *string          `json:"first_air_date"`
+		KnownFor           *json.RawMessage `json:"known_for"`
+		KnownForDepartment *string          `json:"known_for_department"`
+		Name               *string          `json:"name"`
+		Title              *string          `json:"title"`
+	}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return "", errors.Wrap(err, "failed to decode media type")
+	}
+	switch {
+	case fields.MediaType != "":
+		return fields.MediaType, nil
+	case fields.KnownFor != nil || fields.KnownForDepartment != nil:
+		return MediaTypePerson, nil
+	case fields.Title != nil:
+		return MediaTypeMovie, nil
+	case fields.FirstAirDate != nil || fields.Name != nil:
+		return MediaTypeTV, nil
+	}
+	return "", errors.New("missing media type")
+}
+
+// GetMediaType retrieves the media type of the movie.
+func (m *Movie) GetMediaType() string { return MediaTypeMovie }
+
+// GetMediaType retrieves the media type of the tv show.
+func (t *TVShow) GetMediaType() string { return MediaTypeTV }
+
+// GetMediaType retrieves the media type of the person.
+func (p *Person) GetMediaType() string { return MediaTypePerson }
+
+// GetMediaType retrieves the media type of the movie credit.
+func (mc *MovieCredit) GetMediaType() string { return MediaTypeMovie }
+
+// GetMediaType retrieves the media type of the tv show credit.
+func (tc *TVShowCredit) GetMediaType() string { return MediaTypeTV }
+
+// GetMediaType retrieves the media type of the movie cast.
+func (cc *CombinedCreditsMovieCast) GetMediaType() string { return MediaTypeMovie }
+
+// GetMediaType retrieves the media type of the tv show cast.
+func (cc *CombinedCreditsTVShowCast) GetMediaType() string { return MediaTypeTV }
+
+// GetMediaType retrieves the media type of the movie crew.
+func (cc *CombinedCreditsMovieCrew) GetMediaType() string { return MediaTypeMovie }
+
+// GetMediaType retrieves the media type of the tv show crew.
+func (cc *CombinedCreditsTVShowCrew) GetMediaType() string { return MediaTypeTV }
+
+func (m *Movie) isMovieOrTV()                                {}
+func (t *TVShow) isMovieOrTV()                               {}
+func (m *Movie) isMultiResult()                              {}
+func (t *TVShow) isMultiResult()                             {}
+func (p *Person) isMultiResult()                             {}
+func (mc *MovieCredit) isMediaCredit()                       {}
+func (tc *TVShowCredit) isMediaCredit()                      {}
+func (cc *CombinedCreditsMovieCast) isCombinedCreditsCast()  {}
+func (cc *CombinedCreditsTVShowCast) isCombinedCreditsCast() {}
+func (cc *CombinedCreditsMovieCrew) isCombinedCreditsCrew()  {}
+func (cc *CombinedCreditsTVShowCrew) isCombinedCreditsCrew() {}
@@ -0,0 +1,119 @@
+package tmdb_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/mdvalv/go-tmdb"
+	"github.com/mdvalv/go-tmdb/tmdbtest"
+)
+
+// mediaTypes returns the concrete types of the results.
+func mediaTypes(results tmdb.MultiResults) string {
+	types := make([]string, len(results))
+	for i, result := range results {
+		types[i] = fmt.Sprintf("%T", result)
+	}
+	return fmt.Sprint(types)
+}
+
+func TestMultiResults(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    string
+		wantNil bool
+		wantErr bool
+	}{
+		{
+			name: "media types",
+			data: `[{"media_type": "movie", "id": 1}, {"media_type": "tv", "id": 2}, {"media_type": "person", "id": 3}]`,
+			want: "[*tmdb.Movie *tmdb.TVShow *tmdb.Person]",
+		},
+		{
+			name: "inferred media types",
+			data: `[{"title": "Fight Club"}, {"name": "Game of Thrones", "first_air_date": "2011-04-17"}, {"name": "Brad Pitt", "known_for_department": "Acting"}]`,
+			want: "[*tmdb.Movie *tmdb.TVShow *tmdb.Person]",
+		},
+		{
+			name: "unsupported media types are skipped",
+			data: `[{"media_type": "collection", "id": 1}, {"media_type": "movie", "id": 2}]`,
+			want: "[*tmdb.Movie]",
+		},
+		{name: "empty", data: `[]`, want: "[]"},
+		{name: "null", data: `null`, want: "[]", wantNil: true},
+		{name: "missing media type", data: `[{"id": 1}]`, wantErr: true},
+		{name: "invalid item", data: `[{"media_type": "movie", "id": "1"}]`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var results tmdb.MultiResults
+			err := json.Unmarshal([]byte(tt.data), &results)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %t", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := mediaTypes(results); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+			if (results == nil) != tt.wantNil {
+				t.Errorf("got nil %t, want nil %t", results == nil, tt.wantNil)
+			}
+		})
+	}
+}
+
+func TestMoviesOrTVShows(t *testing.T) {
+	var results tmdb.MoviesOrTVShows
+	data := `[{"media_type": "tv", "id": 1}, {"media_type": "person", "id": 2}, {"media_type": "movie", "id": 3}]`
+	if err := json.Unmarshal([]byte(data), &results); err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want the person skipped", len(results))
+	}
+	if _, ok := results[0].(*tmdb.TVShow); !ok {
+		t.Errorf("got %T, want *tmdb.TVShow", results[0])
+	}
+	if movie, ok := results[1].(*tmdb.Movie); !ok || movie.ID != 3 {
+		t.Errorf("got %+v, want movie 3", results[1])
+	}
+}
+
+func TestSearchMulti(t *testing.T) {
+	server := tmdbtest.NewServer()
+	defer server.Close()
+	client, err := server.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, _, err := client.Search.Multi("fight", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results.Results) == 0 {
+		t.Fatal("got no results")
+	}
+	for _, result := range results.Results {
+		switch result := result.(type) {
+		case *tmdb.Movie:
+			if result.GetMediaType() != tmdb.MediaTypeMovie {
+				t.Errorf("got media type %s for a movie", result.GetMediaType())
+			}
+		case *tmdb.TVShow:
+			if result.GetMediaType() != tmdb.MediaTypeTV {
+				t.Errorf("got media type %s for a tv show", result.GetMediaType())
+			}
+		case *tmdb.Person:
+			if result.GetMediaType() != tmdb.MediaTypePerson {
+				t.Errorf("got media type %s for a person", result.GetMediaType())
+			}
+		default:
+			t.Errorf("unexpected result %T", result)
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package tmdb
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"net/http"
 
@@ -24,7 +26,7 @@ type Movie struct {
 	Overview         string  `json:"overview"`
 	Popularity       float64 `json:"popularity"`
 	PosterPath       *string `json:"poster_path"`
-	ReleaseDate      string  `json:"release_date"`
+	ReleaseDate      Date    `json:"release_date"`
 	Title            string  `json:"title"`
 	Video            bool    `json:"video"`
 	VoteAverage      float64 `json:"vote_average"`
@@ -42,7 +44,7 @@ type MovieResult struct {
 	Overview         string  `json:"overview"`
 	Popularity       float64 `json:"popularity"`
 	PosterPath       *string `json:"poster_path"`
-	ReleaseDate      string  `json:"release_date"`
+	ReleaseDate      Date    `json:"release_date"`
 	Title            string  `json:"title"`
 	Video            bool    `json:"video"`
 	VoteAverage      float64 `json:"vote_average"`
@@ -51,7 +53,7 @@ type MovieResult struct {
 
 // paginatedMovies represents movies in TMDb.
 type paginatedMovies struct {
-	pagination
+	Pagination
 	Movies []MovieResult `json:"results"`
 }
 
@@ -73,7 +75,7 @@ type MovieDetails struct {
 	PosterPath          *string              `json:"poster_path"`
 	ProductionCompanies []ProductionCompany  `json:"production_companies"`
 	ProductionCountries []ProductionCountry  `json:"production_countries"`
-	ReleaseDate         string               `json:"release_date"`
+	ReleaseDate         Date                 `json:"release_date"`
 	Revenue             int                  `json:"revenue"`
 	Runtime             int                  `json:"runtime"`
 	SpokenLanguages     []SpokenLanguage     `json:"spoken_languages"`
@@ -109,24 +111,25 @@ type MovieDetailsOptions struct {
 	// If the provided language is wrong, it is ignored.
 	Language string `url:"language,omitempty" json:"language,omitempty"`
 
-	AppendToResponse string `url:"append_to_response,omitempty" json:"append_to_response,omitempty"`
+	// Sub-resources to append to the response, example: MovieAppendImages | MovieAppendVideos
+	AppendToResponse MovieAppend `url:"append_to_response,omitempty" json:"append_to_response,omitempty"`
 }
 
 // GetMovie retrieves the primary information about a movie.
-func (mr *MoviesResource) GetMovie(movieID int, opt *MovieDetailsOptions) (*MovieDetails, *http.Response, error) {
+func (mr *MoviesResource) GetMovie(movieID int, opt *MovieDetailsOptions, options ...RequestOptionFn) (*MovieDetails, *http.Response, error) {
 	path := fmt.Sprintf("/movie/%d", movieID)
 	var movie MovieDetails
-	resp, err := mr.client.get(path, &movie, WithQueryParams(opt))
+	resp, err := mr.client.get(path, &movie, WithQueryParams(opt), withOptions(options...))
 	return &movie, resp, errors.Wrap(err, "failed to get movie")
 }
 
 // GetMoviesChanges retrieves a list of all of the movie ids that have been changed in the past 24 hours.
 // Query it for up to 14 days worth of changed IDs at a time with the start_date and end_date query parameters.
 // 100 items are returned per page.
-func (mr *MoviesResource) GetMoviesChanges(opt *ChangesOptions) (*MediaChanges, *http.Response, error) {
+func (mr *MoviesResource) GetMoviesChanges(opt *ChangesOptions, options ...RequestOptionFn) (*MediaChanges, *http.Response, error) {
 	path := "/movie/changes"
 	var changes MediaChanges
-	resp, err := mr.client.get(path, &changes, WithQueryParams(opt))
+	resp, err := mr.client.get(path, &changes, WithQueryParams(opt), withOptions(options...))
 	return &changes, resp, errors.Wrap(err, "failed to get movies changes")
 }
 
@@ -177,7 +180,7 @@ type LatestMovie struct {
 	PosterPath          *string              `json:"poster_path"`
 	ProductionCompanies []ProductionCompany  `json:"production_companies"`
 	ProductionCountries []ProductionCountry  `json:"production_countries"`
-	ReleaseDate         string               `json:"release_date"`
+	ReleaseDate         Date                 `json:"release_date"`
 	Revenue             int                  `json:"revenue"`
 	Runtime             int                  `json:"runtime"`
 	SpokenLanguages     []SpokenLanguage     `json:"spoken_languages"`
@@ -193,10 +196,10 @@ type LatestMovie struct {
 type LatestOptions languageOptions
 
 // GetLatest retrieves the most newly created movie. This is a live response and will continuously change.
-func (mr *MoviesResource) GetLatest(opt *LatestOptions) (*LatestMovie, *http.Response, error) {
+func (mr *MoviesResource) GetLatest(opt *LatestOptions, options ...RequestOptionFn) (*LatestMovie, *http.Response, error) {
 	path := "/movie/latest"
 	var latest LatestMovie
-	resp, err := mr.client.get(path, &latest, WithQueryParams(opt))
+	resp, err := mr.client.get(path, &latest, WithQueryParams(opt), withOptions(options...))
 	return &latest, resp, errors.Wrap(err, "failed to get latest movie")
 }
 
@@ -224,7 +227,7 @@ type DateRange struct {
 
 // NowPlayingMovies represents the now playing movies in TMDb.
 type NowPlayingMovies struct {
-	pagination
+	Pagination
 	Movies []MovieResult `json:"results"`
 	Dates  DateRange     `json:"dates"`
 }
@@ -234,10 +237,10 @@ type NowPlayingMovies struct {
 // 2 or 3 within the specified date range.
 // Optionally specify a region parameter which will narrow the search to only look for
 // theatrical release dates within the specified country.
-func (mr *MoviesResource) GetNowPlaying(opt *NowPlayingMoviesOptions) (*NowPlayingMovies, *http.Response, error) {
+func (mr *MoviesResource) GetNowPlaying(opt *NowPlayingMoviesOptions, options ...RequestOptionFn) (*NowPlayingMovies, *http.Response, error) {
 	path := "/movie/now_playing"
 	var movies NowPlayingMovies
-	resp, err := mr.client.get(path, &movies, WithQueryParams(opt))
+	resp, err := mr.client.get(path, &movies, WithQueryParams(opt), withOptions(options...))
 	return &movies, resp, errors.Wrap(err, "failed to get movies playing now")
 }
 
@@ -261,10 +264,10 @@ type PopularMoviesOptions struct {
 type PopularMovies paginatedMovies
 
 // GetPopular retrieves a list of the current popular movies on TMDB. This list updates daily.
-func (mr *MoviesResource) GetPopular(opt *PopularMoviesOptions) (*PopularMovies, *http.Response, error) {
+func (mr *MoviesResource) GetPopular(opt *PopularMoviesOptions, options ...RequestOptionFn) (*PopularMovies, *http.Response, error) {
 	path := "/movie/popular"
 	var movies PopularMovies
-	resp, err := mr.client.get(path, &movies, WithQueryParams(opt))
+	resp, err := mr.client.get(path, &movies, WithQueryParams(opt), withOptions(options...))
 	return &movies, resp, errors.Wrap(err, "failed to get popular movies")
 }
 
@@ -288,10 +291,10 @@ type TopRatedMoviesOptions struct {
 type TopRatedMovies paginatedMovies
 
 // GetTopRated retrieves the top rated movies on TMDB.
-func (mr *MoviesResource) GetTopRated(opt *TopRatedMoviesOptions) (*TopRatedMovies, *http.Response, error) {
+func (mr *MoviesResource) GetTopRated(opt *TopRatedMoviesOptions, options ...RequestOptionFn) (*TopRatedMovies, *http.Response, error) {
 	path := "/movie/top_rated"
 	var movies TopRatedMovies
-	resp, err := mr.client.get(path, &movies, WithQueryParams(opt))
+	resp, err := mr.client.get(path, &movies, WithQueryParams(opt), withOptions(options...))
 	return &movies, resp, errors.Wrap(err, "failed to get top rated movies")
 }
 
@@ -313,7 +316,7 @@ type UpcomingMoviesOptions struct {
 
 // UpcomingMovies represents the upcoming movies in TMDb.
 type UpcomingMovies struct {
-	pagination
+	Pagination
 	Movies []MovieResult `json:"results"`
 	Dates  DateRange     `json:"dates"`
 }
@@ -322,29 +325,83 @@ type UpcomingMovies struct {
 // This is a release type query that looks for all movies that have a release type of 2 or 3 within the specified date range.
 // Optionally specify a region parameter which will narrow the search to only look for theatrical release dates
 // within the specified country.
-func (mr *MoviesResource) GetUpcoming(opt *UpcomingMoviesOptions) (*UpcomingMovies, *http.Response, error) {
+func (mr *MoviesResource) GetUpcoming(opt *UpcomingMoviesOptions, options ...RequestOptionFn) (*UpcomingMovies, *http.Response, error) {
 	path := "/movie/upcoming"
 	var movies UpcomingMovies
-	resp, err := mr.client.get(path, &movies, WithQueryParams(opt))
+	resp, err := mr.client.get(path, &movies, WithQueryParams(opt), withOptions(options...))
 	return &movies, resp, errors.Wrap(err, "failed to get upcoming movies")
 }
 
+// UserRating represents the rating given by a user in TMDb.
+// TMDb sends false when there is no rating, and {"value": 7.5} otherwise.
+type UserRating struct {
+	rated bool
+	value float64
+}
+
+// NewUserRating returns a rating with the given value.
+func NewUserRating(value float64) UserRating {
+	return UserRating{rated: true, value: value}
+}
+
+// IsRated checks whether the user rated the media.
+func (r UserRating) IsRated() bool {
+	return r.rated
+}
+
+// Value retrieves the rating value, zero when the user did not rate the media.
+func (r UserRating) Value() float64 {
+	return r.value
+}
+
+// UnmarshalJSON decodes either false, a rating object or a bare rating value.
+func (r *UserRating) UnmarshalJSON(data []byte) error {
+	*r = UserRating{}
+	switch string(bytes.TrimSpace(data)) {
+	case "null", "false":
+		return nil
+	}
+	var value float64
+	if err := json.Unmarshal(data, &value); err == nil {
+		*r = NewUserRating(value)
+		return nil
+	}
+	var rating struct {
+		Value float64 `json:"value"`
+	}
+	if err := json.Unmarshal(data, &rating); err != nil {
+		return errors.Wrap(err, "failed to decode rating")
+	}
+	*r = NewUserRating(rating.Value)
+	return nil
+}
+
+// MarshalJSON encodes the rating the same way TMDb does.
+func (r UserRating) MarshalJSON() ([]byte, error) {
+	if !r.rated {
+		return []byte("false"), nil
+	}
+	return json.Marshal(struct {
+		Value float64 `json:"value"`
+	}{r.value})
+}
+
 // AccountStates represents account states in TMDb.
 type AccountStates struct {
-	ID        int         `json:"id"`
-	Favorite  bool        `json:"favorite"`
-	Rated     interface{} `json:"rated"`
-	Watchlist bool        `json:"watchlist"`
+	ID        int        `json:"id"`
+	Favorite  bool       `json:"favorite"`
+	Rated     UserRating `json:"rated"`
+	Watchlist bool       `json:"watchlist"`
 }
 
 // GetAccountStates retrieves the following account states for a session:
 // - Movie rating
 // - If it belongs to the watchlist
 // - If it belongs to the favorite list
-func (mr *MoviesResource) GetAccountStates(movieID int, sessionID string) (*AccountStates, *http.Response, error) {
+func (mr *MoviesResource) GetAccountStates(movieID int, sessionID string, options ...RequestOptionFn) (*AccountStates, *http.Response, error) {
 	path := fmt.Sprintf("/movie/%d/account_states", movieID)
 	var states AccountStates
-	resp, err := mr.client.get(path, &states, WithSessionID(sessionID))
+	resp, err := mr.client.get(path, &states, WithSessionID(sessionID), withOptions(options...))
 	return &states, resp, errors.Wrap(err, "failed to get account states")
 }
 
@@ -354,6 +411,27 @@ type Auth struct {
 	GuestSessionID string `url:"guest_session_id,omitempty" json:"guest_session_id,omitempty"`
 }
 
+// Validate checks that exactly one of the session ID and the guest session ID is set.
+func (a Auth) Validate() error {
+	var errs fieldErrors
+	if (a.SessionID == "") == (a.GuestSessionID == "") {
+		errs.add("session_id", a.SessionID, "exactly one of session_id and guest_session_id is required")
+	}
+	return errs.err()
+}
+
+// ratingValue represents the body of rate requests.
+type ratingValue struct {
+	Value float64 `json:"value"`
+}
+
+// Validate checks the rating value.
+func (r ratingValue) Validate() error {
+	var errs fieldErrors
+	errs.checkRatingValue("value", r.Value)
+	return errs.err()
+}
+
 // RateResponse represents a rate response in TMDb.
 type RateResponse struct {
 	StatusCode    int    `json:"status_code"`
@@ -363,10 +441,10 @@ type RateResponse struct {
 
 // Rate rates a movie.
 // A valid session or guest session ID is required.
-func (mr *MoviesResource) Rate(movieID int, rating float64, sessionID Auth) (*RateResponse, *http.Response, error) {
+func (mr *MoviesResource) Rate(movieID int, rating float64, sessionID Auth, options ...RequestOptionFn) (*RateResponse, *http.Response, error) {
 	path := fmt.Sprintf("/movie/%d/rating", movieID)
 	var response RateResponse
-	resp, err := mr.client.post(path, &response, WithBody(map[string]float64{"value": rating}), WithQueryParams(sessionID))
+	resp, err := mr.client.post(path, &response, WithBody(ratingValue{rating}), WithQueryParams(sessionID), withOptions(options...))
 	return &response, resp, errors.Wrap(err, "failed to rate movie")
 }
 
@@ -379,10 +457,10 @@ type DeleteRatingResponse struct {
 
 // DeleteRating removes a rating for a movie.
 // A valid session or guest session ID is required.
-func (mr *MoviesResource) DeleteRating(movieID int, sessionID Auth) (*DeleteRatingResponse, *http.Response, error) {
+func (mr *MoviesResource) DeleteRating(movieID int, sessionID Auth, options ...RequestOptionFn) (*DeleteRatingResponse, *http.Response, error) {
 	path := fmt.Sprintf("/movie/%d/rating", movieID)
 	var response DeleteRatingResponse
-	resp, err := mr.client.delete(path, &response, WithQueryParams(sessionID))
+	resp, err := mr.client.delete(path, &response, WithQueryParams(sessionID), withOptions(options...))
 	return &response, resp, errors.Wrap(err, "failed to delete movie rating")
 }
 
@@ -405,19 +483,19 @@ type MovieAlternativeTitlesOptions struct {
 }
 
 // GetAlternativeTitles retrieves all of the alternative titles for a movie.
-func (mr *MoviesResource) GetAlternativeTitles(movieID int, opt *MovieAlternativeTitlesOptions) (*AlternativeMovieTitles, *http.Response, error) {
+func (mr *MoviesResource) GetAlternativeTitles(movieID int, opt *MovieAlternativeTitlesOptions, options ...RequestOptionFn) (*AlternativeMovieTitles, *http.Response, error) {
 	path := fmt.Sprintf("/movie/%d/alternative_titles", movieID)
 	var titles AlternativeMovieTitles
-	resp, err := mr.client.get(path, &titles, WithQueryParams(opt))
+	resp, err := mr.client.get(path, &titles, WithQueryParams(opt), withOptions(options...))
 	return &titles, resp, errors.Wrap(err, "failed to get alternative titles")
 }
 
 // GetChanges retrieves the changes for a movie. By default only the last 24 hours are returned.
 // Query up to 14 days in a single query by using the `start_date` and `end_date` query parameters.
-func (mr *MoviesResource) GetChanges(movieID int, opt *ChangesOptions) (*Changes, *http.Response, error) {
+func (mr *MoviesResource) GetChanges(movieID int, opt *ChangesOptions, options ...RequestOptionFn) (*Changes, *http.Response, error) {
 	path := fmt.Sprintf("/movie/%d/changes", movieID)
 	var changes Changes
-	resp, err := mr.client.get(path, &changes, WithQueryParams(opt))
+	resp, err := mr.client.get(path, &changes, WithQueryParams(opt), withOptions(options...))
 	return &changes, resp, errors.Wrap(err, "failed to get movie changes")
 }
 
@@ -460,10 +538,10 @@ type MovieCredits struct {
 }
 
 // GetCredits retrieves the cast and crew for a movie.
-func (mr *MoviesResource) GetCredits(movieID int, opt *CreditsOptions) (*MovieCredits, *http.Response, error) {
+func (mr *MoviesResource) GetCredits(movieID int, opt *CreditsOptions, options ...RequestOptionFn) (*MovieCredits, *http.Response, error) {
 	path := fmt.Sprintf("/movie/%d/credits", movieID)
 	var credits MovieCredits
-	resp, err := mr.client.get(path, &credits, WithQueryParams(opt))
+	resp, err := mr.client.get(path, &credits, WithQueryParams(opt), withOptions(options...))
 	return &credits, resp, errors.Wrap(err, "failed to get movie credits")
 }
 
@@ -477,10 +555,10 @@ type MovieExternalIDs struct {
 }
 
 // GetExternalIDs retrieves the external ids for a movie.
-func (mr *MoviesResource) GetExternalIDs(movieID int) (*MovieExternalIDs, *http.Response, error) {
+func (mr *MoviesResource) GetExternalIDs(movieID int, options ...RequestOptionFn) (*MovieExternalIDs, *http.Response, error) {
 	path := fmt.Sprintf("/movie/%d/external_ids", movieID)
 	var ids MovieExternalIDs
-	resp, err := mr.client.get(path, &ids)
+	resp, err := mr.client.get(path, &ids, options...)
 	return &ids, resp, errors.Wrap(err, "failed to get movie external ids")
 }
 
@@ -511,10 +589,10 @@ type ImagesOptions struct {
 // Querying images with a language parameter will filter the results.
 // To include a fallback language (especially useful for backdrops), use the include_image_language parameter.
 // This should be a comma separated value like so: include_image_language=en,null.
-func (mr *MoviesResource) GetImages(movieID int, opt *ImagesOptions) (*Images, *http.Response, error) {
+func (mr *MoviesResource) GetImages(movieID int, opt *ImagesOptions, options ...RequestOptionFn) (*Images, *http.Response, error) {
 	path := fmt.Sprintf("/movie/%d/images", movieID)
 	var images Images
-	resp, err := mr.client.get(path, &images, WithQueryParams(opt))
+	resp, err := mr.client.get(path, &images, WithQueryParams(opt), withOptions(options...))
 	return &images, resp, errors.Wrap(err, "failed to get movie images")
 }
 
@@ -525,10 +603,10 @@ type MovieKeywords struct {
 }
 
 // GetKeywords retrieves the keywords that have been added to a movie.
-func (mr *MoviesResource) GetKeywords(movieID int) (*MovieKeywords, *http.Response, error) {
+func (mr *MoviesResource) GetKeywords(movieID int, options ...RequestOptionFn) (*MovieKeywords, *http.Response, error) {
 	path := fmt.Sprintf("/movie/%d/keywords", movieID)
 	var keywords MovieKeywords
-	resp, err := mr.client.get(path, &keywords)
+	resp, err := mr.client.get(path, &keywords, options...)
 	return &keywords, resp, errors.Wrap(err, "failed to get movie keywords")
 }
 
@@ -546,7 +624,7 @@ type MovieList struct {
 
 // MovieLists represents movie lists in TMDb.
 type MovieLists struct {
-	pagination
+	Pagination
 	Lists []MovieList `json:"results"`
 }
 
@@ -554,34 +632,34 @@ type MovieLists struct {
 type MoviesOptions languagePageOptions
 
 // GetLists retrieves 
-func (mr *MoviesResource) GetLists(movieID int, opt *MoviesOptions) (*MovieLists, *http.Response, error) {
+func (mr *MoviesResource) GetLists(movieID int, opt *MoviesOptions, options ...RequestOptionFn) (*MovieLists, *http.Response, error) {
 	path := fmt.Sprintf("/movie/%d/lists", movieID)
 	var lists MovieLists
-	resp, err := mr.client.get(path, &lists, WithQueryParams(opt))
+	resp, err := mr.client.get(path, &lists, WithQueryParams(opt), withOptions(options...))
 	return &lists, resp, errors.Wrap(err, "failed to get movie lists")
 }
 
 // RecommendedMovies represents recommended movies in TMDb.
 type RecommendedMovies struct {
-	pagination
+	Pagination
 	Movies []Movie `json:"results"`
 }
 
 // GetRecommendations retrieves a list of recommended movies for a movie.
-func (mr *MoviesResource) GetRecommendations(movieID int, opt *MoviesOptions) (*RecommendedMovies, *http.Response, error) {
+func (mr *MoviesResource) GetRecommendations(movieID int, opt *MoviesOptions, options ...RequestOptionFn) (*RecommendedMovies, *http.Response, error) {
 	path := fmt.Sprintf("/movie/%d/recommendations", movieID)
 	var movies RecommendedMovies
-	resp, err := mr.client.get(path, &movies, WithQueryParams(opt))
+	resp, err := mr.client.get(path, &movies, WithQueryParams(opt), withOptions(options...))
 	return &movies, resp, errors.Wrap(err, "failed to get movie recommendations")
 }
 
 // MovieReleaseDate represents movie release date in TMDb.
 type MovieReleaseDate struct {
-	Certification string  `json:"certification"`
-	ISO6391       *string `json:"iso_639_1"`
-	ReleaseDate   string  `json:"release_date"`
-	Type          int     `json:"type"`
-	Note          string  `json:"note"`
+	Certification string      `json:"certification"`
+	ISO6391       *string     `json:"iso_639_1"`
+	ReleaseDate   Timestamp   `json:"release_date"`
+	Type          ReleaseType `json:"type"`
+	Note          string      `json:"note"`
 }
 
 // MovieRelease represents a movie release in TMDb.
@@ -604,25 +682,25 @@ type MovieReleaseDates struct {
 // 4. Digital
 // 5. Physical
 // 6. TV
-func (mr *MoviesResource) GetReleaseDates(movieID int) (*MovieReleaseDates, *http.Response, error) {
+func (mr *MoviesResource) GetReleaseDates(movieID int, options ...RequestOptionFn) (*MovieReleaseDates, *http.Response, error) {
 	path := fmt.Sprintf("/movie/%d/release_dates", movieID)
 	var dates MovieReleaseDates
-	resp, err := mr.client.get(path, &dates)
+	resp, err := mr.client.get(path, &dates, options...)
 	return &dates, resp, errors.Wrap(err, "failed to get movie release dates")
 }
 
 // MovieReviews represents movie reviews in TMDb.
 type MovieReviews struct {
-	pagination
+	Pagination
 	ID      *int     `json:"id"`
 	Reviews []Review `json:"results"`
 }
 
 // GetReviews retrieves the user reviews for a movie.
-func (mr *MoviesResource) GetReviews(movieID int, opt *MoviesOptions) (*MovieReviews, *http.Response, error) {
+func (mr *MoviesResource) GetReviews(movieID int, opt *MoviesOptions, options ...RequestOptionFn) (*MovieReviews, *http.Response, error) {
 	path := fmt.Sprintf("/movie/%d/reviews", movieID)
 	var reviews MovieReviews
-	resp, err := mr.client.get(path, &reviews, WithQueryParams(opt))
+	resp, err := mr.client.get(path, &reviews, WithQueryParams(opt), withOptions(options...))
 	return &reviews, resp, errors.Wrap(err, "failed to get movie reviews")
 }
 
@@ -632,10 +710,10 @@ type SimilarMovies paginatedMovies
 // GetSimilar retrieves a list of similar movies.
 // This is not the same as the "Recommendation" system on the website.
 // These items are assembled by looking at keywords and genres.
-func (mr *MoviesResource) GetSimilar(movieID int, opt *MoviesOptions) (*SimilarMovies, *http.Response, error) {
+func (mr *MoviesResource) GetSimilar(movieID int, opt *MoviesOptions, options ...RequestOptionFn) (*SimilarMovies, *http.Response, error) {
 	path := fmt.Sprintf("/movie/%d/similar", movieID)
 	var movies SimilarMovies
-	resp, err := mr.client.get(path, &movies, WithQueryParams(opt))
+	resp, err := mr.client.get(path, &movies, WithQueryParams(opt), withOptions(options...))
 	return &movies, resp, errors.Wrap(err, "failed to get similar movies")
 }
 
@@ -664,10 +742,10 @@ type MovieTranslation struct {
 }
 
 // GetTranslations retrieves a list of translations that have been created for a movie.
-func (mr *MoviesResource) GetTranslations(movieID int) (*MovieTranslations, *http.Response, error) {
+func (mr *MoviesResource) GetTranslations(movieID int, options ...RequestOptionFn) (*MovieTranslations, *http.Response, error) {
 	path := fmt.Sprintf("/movie/%d/translations", movieID)
 	var translations MovieTranslations
-	resp, err := mr.client.get(path, &translations)
+	resp, err := mr.client.get(path, &translations, options...)
 	return &translations, resp, errors.Wrap(err, "failed to get movie translations")
 }
 
@@ -685,16 +763,16 @@ type VideosOptions struct {
 
 // Video represents a video in TMDb.
 type Video struct {
-	ID          string `json:"id"`
-	ISO31661    string `json:"iso_3166_1"`
-	ISO6391     string `json:"iso_639_1"`
-	Key         string `json:"key"`
-	Name        string `json:"name"`
-	Official    bool   `json:"official"`
-	PublishedAt string `json:"published_at"`
-	Site        string `json:"site"`
-	Size        int    `json:"size"`
-	Type        string `json:"type"`
+	ID          string    `json:"id"`
+	ISO31661    string    `json:"iso_3166_1"`
+	ISO6391     string    `json:"iso_639_1"`
+	Key         string    `json:"key"`
+	Name        string    `json:"name"`
+	Official    bool      `json:"official"`
+	PublishedAt Timestamp `json:"published_at"`
+	Site        string    `json:"site"`
+	Size        int       `json:"size"`
+	Type        string    `json:"type"`
 }
 
 // Videos represents videos in TMDb.
@@ -704,15 +782,15 @@ type Videos struct {
 }
 
 // GetVideos retrieves the videos that have been added to a movie.
-func (mr *MoviesResource) GetVideos(movieID int, opt *VideosOptions) (*Videos, *http.Response, error) {
+func (mr *MoviesResource) GetVideos(movieID int, opt *VideosOptions, options ...RequestOptionFn) (*Videos, *http.Response, error) {
 	path := fmt.Sprintf("/movie/%d/videos", movieID)
 	var videos Videos
-	resp, err := mr.client.get(path, &videos, WithQueryParams(opt))
+	resp, err := mr.client.get(path, &videos, WithQueryParams(opt), withOptions(options...))
 	return &videos, resp, errors.Wrap(err, "failed to get movie videos")
 }
 
-// Providers represents providers in TMDb.
-type Providers map[string]interface{}
+// Providers represents the providers of a movie or tv show in TMDb, indexed by ISO 3166-1 region code.
+type Providers map[string]RegionProviders
 
 // WatchProviders represents watch providers in TMDb.
 type WatchProviders struct {
@@ -726,9 +804,9 @@ type WatchProviders struct {
 // Link to the provided TMDB URL to help support TMDB and provide the actual deep links to the content.
 // Please note: In order to use this data it's REQUIRED to attribute the source of the data as JustWatch.
 // If any usage is found not complying with these terms the access to the API will be revoked.
-func (mr *MoviesResource) GetWatchProviders(movieID int) (*WatchProviders, *http.Response, error) {
+func (mr *MoviesResource) GetWatchProviders(movieID int, options ...RequestOptionFn) (*WatchProviders, *http.Response, error) {
 	path := fmt.Sprintf("/movie/%d/watch/providers", movieID)
 	var providers WatchProviders
-	resp, err := mr.client.get(path, &providers)
+	resp, err := mr.client.get(path, &providers, options...)
 	return &providers, resp, errors.Wrap(err, "failed to get movie watch providers")
 }
@@ -0,0 +1,67 @@
+package tmdb_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mdvalv/go-tmdb"
+)
+
+func TestUserRating(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		wantErr   bool
+		wantRated bool
+		wantValue float64
+		wantJSON  string
+	}{
+		{name: "not rated", data: `false`, wantJSON: `false`},
+		{name: "null", data: `null`, wantJSON: `false`},
+		{name: "rated", data: `{"value": 7.5}`, wantRated: true, wantValue: 7.5, wantJSON: `{"value":7.5}`},
+		{name: "rated with spaces", data: ` {"value":10} `, wantRated: true, wantValue: 10, wantJSON: `{"value":10}`},
+		{name: "true", data: `true`, wantErr: true},
+		{name: "bare value", data: `7.5`, wantRated: true, wantValue: 7.5, wantJSON: `{"value":7.5}`},
+		{name: "string", data: `"7.5"`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var states tmdb.AccountStates
+			err := json.Unmarshal([]byte(`{"id": 550, "rated": `+tt.data+`}`), &states)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %t", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if states.Rated.IsRated() != tt.wantRated || states.Rated.Value() != tt.wantValue {
+				t.Errorf("got rated %t with value %v, want rated %t with value %v",
+					states.Rated.IsRated(), states.Rated.Value(), tt.wantRated, tt.wantValue)
+			}
+
+			data, err := json.Marshal(states.Rated)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != tt.wantJSON {
+				t.Errorf("got %s, want %s", data, tt.wantJSON)
+			}
+		})
+	}
+}
+
+func TestUserRatingRoundTrip(t *testing.T) {
+	for _, rating := range []tmdb.UserRating{{}, tmdb.NewUserRating(0.5), tmdb.NewUserRating(8)} {
+		data, err := json.Marshal(rating)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got tmdb.UserRating
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatal(err)
+		}
+		if got != rating {
+			t.Errorf("got %+v after a round trip of %s, want %+v", got, data, rating)
+		}
+	}
+}
@@ -23,10 +23,10 @@ type Network struct {
 }
 
 // GetNetwork retrieves network details by id.
-func (nr *NetworksResource) GetNetwork(id int) (*Network, *http.Response, error) {
+func (nr *NetworksResource) GetNetwork(id int, options ...RequestOptionFn) (*Network, *http.Response, error) {
 	path := fmt.Sprintf("/network/%d", id)
 	var network Network
-	resp, err := nr.client.get(path, &network)
+	resp, err := nr.client.get(path, &network, options...)
 	return &network, resp, errors.Wrap(err, "failed to get network")
 }
 
@@ -43,10 +43,10 @@ type NetworkAlternativeNames struct {
 }
 
 // GetAlternativeNames retrieves the alternative names of a network.
-func (nr *NetworksResource) GetAlternativeNames(id int) (*NetworkAlternativeNames, *http.Response, error) {
+func (nr *NetworksResource) GetAlternativeNames(id int, options ...RequestOptionFn) (*NetworkAlternativeNames, *http.Response, error) {
 	path := fmt.Sprintf("/network/%d/alternative_names", id)
 	var names NetworkAlternativeNames
-	resp, err := nr.client.get(path, &names)
+	resp, err := nr.client.get(path, &names, options...)
 	return &names, resp, errors.Wrap(err, "failed to get network alternative names")
 }
 
@@ -58,9 +58,9 @@ type NetworkImages struct {
 
 // GetImages retrieves network logos by id.
 // There are two image formats that are supported for networks, PNG's and SVG's.
-func (nr *NetworksResource) GetImages(id int) (*NetworkImages, *http.Response, error) {
+func (nr *NetworksResource) GetImages(id int, options ...RequestOptionFn) (*NetworkImages, *http.Response, error) {
 	path := fmt.Sprintf("/network/%d/images", id)
 	var images NetworkImages
-	resp, err := nr.client.get(path, &images)
+	resp, err := nr.client.get(path, &images, options...)
 	return &images, resp, errors.Wrap(err, "failed to get network images")
 }
@@ -0,0 +1,107 @@
+package tmdb
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// MaxPages is the maximum page TMDb API allows to query.
+const MaxPages = 500
+
+// PageFetcher fetches a single page of a paginated endpoint.
+type PageFetcher[T Paginated] func(ctx context.Context, page int) (T, *http.Response, error)
+
+// Pager iterates over the pages of a paginated endpoint.
+// It stops after the last page, or after MaxPages, whichever comes first.
+//
+// Example:
+//
+//	pager := tmdb.NewPager(func(ctx context.Context, page int) (*tmdb.SearchMovies, *http.Response, error) {
+//		return client.Search.Movies("alien", nil, tmdb.WithContext(ctx), tmdb.WithPage(page))
+//	})
+//	for pager.Next(ctx) {
+//		for _, movie := range pager.Page().Movies {
+//			fmt.Println(movie.Title)
+//		}
+//	}
+//	if err := pager.Err(); err != nil {
+//		return err
+//	}
+type Pager[T Paginated] struct {
+	fetch    PageFetcher[T]
+	next     int
+	last     int
+	page     T
+	response *http.Response
+	err      error
+}
+
+// NewPager returns a pager starting at the first page.
+func NewPager[T Paginated](fetch PageFetcher[T]) *Pager[T] {
+	return NewPagerFrom(fetch, 1)
+}
+
+// NewPagerFrom returns a pager starting at the given page.
+func NewPagerFrom[T Paginated](fetch PageFetcher[T], page int) *Pager[T] {
+	if page < 1 {
+		page = 1
+	}
+	return &Pager[T]{
+		fetch: fetch,
+		next:  page,
+		last:  MaxPages,
+	}
+}
+
+// Next fetches the next page, returning false when there are no more pages or an error occurred.
+func (p *Pager[T]) Next(ctx context.Context) bool {
+	if p.err != nil || p.next > p.last {
+		return false
+	}
+	if err := ctx.Err(); err != nil {
+		p.err = err
+		return false
+	}
+
+	page, resp, err := p.fetch(ctx, p.next)
+	p.response = resp
+	if err != nil {
+		p.err = errors.Wrapf(err, "failed to fetch page %d", p.next)
+		return false
+	}
+
+	info := page.GetPagination()
+	if info.TotalPages < p.last {
+		p.last = info.TotalPages
+	}
+	p.page = page
+	p.next++
+	return true
+}
+
+// Page returns the last fetched page.
+func (p *Pager[T]) Page() T {
+	return p.page
+}
+
+// Response returns the HTTP response of the last fetched page.
+func (p *Pager[T]) Response() *http.Response {
+	return p.response
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// All fetches the remaining pages, up to maxPages pages (0 means no limit besides MaxPages).
+// When a page fails, the pages fetched so far are returned along with the error.
+func (p *Pager[T]) All(ctx context.Context, maxPages int) ([]T, error) {
+	var pages []T
+	for (maxPages <= 0 || len(pages) < maxPages) && p.Next(ctx) {
+		pages = append(pages, p.Page())
+	}
+	return pages, p.Err()
+}
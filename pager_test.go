@@ -0,0 +1,130 @@
+package tmdb_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/mdvalv/go-tmdb"
+	"github.com/mdvalv/go-tmdb/tmdbtest"
+)
+
+// handleSearchPages serves totalPages pages of movies, failing the page failAt if set.
+func handleSearchPages(server *tmdbtest.Server, totalPages, failAt int) {
+	server.HandleFunc(http.MethodGet, "/3/search/movie", func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		w.Header().Set("Content-Type", "application/json")
+		if page == failAt {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"status_code": 11, "status_message": "Internal error."}`))
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"page": %d, "total_pages": %d, "total_results": %d, "results": [{"id": %d}]}`,
+			page, totalPages, totalPages, page)
+	})
+}
+
+func TestPager(t *testing.T) {
+	tests := []struct {
+		name       string
+		totalPages int
+		failAt     int
+		from       int
+		maxPages   int
+		wantPages  []int
+		wantErr    bool
+	}{
+		{name: "all pages", totalPages: 3, from: 1, wantPages: []int{1, 2, 3}},
+		{name: "single page", totalPages: 1, from: 1, wantPages: []int{1}},
+		{name: "from a page", totalPages: 4, from: 3, wantPages: []int{3, 4}},
+		{name: "from page 0 starts at 1", totalPages: 2, from: 0, wantPages: []int{1, 2}},
+		{name: "max pages", totalPages: 5, from: 1, maxPages: 2, wantPages: []int{1, 2}},
+		{name: "stops at MaxPages", totalPages: 1000, from: tmdb.MaxPages - 1, wantPages: []int{tmdb.MaxPages - 1, tmdb.MaxPages}},
+		{name: "failing page", totalPages: 3, failAt: 2, from: 1, wantPages: []int{1}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := tmdbtest.NewServer()
+			defer server.Close()
+			handleSearchPages(server, tt.totalPages, tt.failAt)
+			client, err := server.Client()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			pager := tmdb.NewPagerFrom(func(ctx context.Context, page int) (*tmdb.SearchMovies, *http.Response, error) {
+				return client.Search.Movies("alien", nil, tmdb.WithContext(ctx), tmdb.WithPage(page))
+			}, tt.from)
+			pages, err := pager.All(context.Background(), tt.maxPages)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %t", err, tt.wantErr)
+			}
+			var got []int
+			for _, page := range pages {
+				got = append(got, page.Page)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.wantPages) {
+				t.Errorf("got pages %v, want %v", got, tt.wantPages)
+			}
+		})
+	}
+}
+
+func TestPagerNext(t *testing.T) {
+	server := tmdbtest.NewServer()
+	defer server.Close()
+	handleSearchPages(server, 2, 0)
+	client, err := server.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pager := tmdb.NewPager(func(ctx context.Context, page int) (*tmdb.SearchMovies, *http.Response, error) {
+		return client.Search.Movies("alien", nil, tmdb.WithContext(ctx), tmdb.WithPage(page))
+	})
+	ctx := context.Background()
+	for page := 1; page <= 2; page++ {
+		if !pager.Next(ctx) {
+			t.Fatalf("page %d: %v", page, pager.Err())
+		}
+		if got := pager.Page().Movies[0].ID; got != page {
+			t.Errorf("got movie %d, want %d", got, page)
+		}
+		if pager.Response() == nil || pager.Response().StatusCode != http.StatusOK {
+			t.Errorf("page %d: unexpected response %v", page, pager.Response())
+		}
+	}
+	if pager.Next(ctx) {
+		t.Error("got a page after the last one")
+	}
+	if pager.Err() != nil {
+		t.Errorf("unexpected error %v", pager.Err())
+	}
+}
+
+func TestPagerCanceledContext(t *testing.T) {
+	server := tmdbtest.NewServer()
+	defer server.Close()
+	handleSearchPages(server, 2, 0)
+	client, err := server.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pager := tmdb.NewPager(func(ctx context.Context, page int) (*tmdb.SearchMovies, *http.Response, error) {
+		return client.Search.Movies("alien", nil, tmdb.WithContext(ctx), tmdb.WithPage(page))
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if pager.Next(ctx) {
+		t.Error("got a page with a canceled context")
+	}
+	if pager.Err() != context.Canceled {
+		t.Errorf("got error %v, want %v", pager.Err(), context.Canceled)
+	}
+	if len(server.Requests()) != 0 {
+		t.Errorf("got %d requests with a canceled context", len(server.Requests()))
+	}
+}
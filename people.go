@@ -1,6 +1,7 @@
 package tmdb
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 
@@ -14,16 +15,16 @@ type PeopleResource struct {
 
 // Person represents a person in TMDb.
 type Person struct {
-	Adult              bool        `json:"adult"`
-	Gender             int         `json:"gender"`
-	ID                 int         `json:"id"`
-	KnownForDepartment string      `json:"known_for_department"`
-	MediaType          string      `json:"media_type"`
-	Name               string      `json:"name"`
-	OriginalName       string      `json:"original_name"`
-	Popularity         float64     `json:"popularity"`
-	ProfilePath        string      `json:"profile_path"`
-	KnownFor           []MovieOrTV `json:"known_for"`
+	Adult              bool            `json:"adult"`
+	Gender             int             `json:"gender"`
+	ID                 int             `json:"id"`
+	KnownForDepartment string          `json:"known_for_department"`
+	MediaType          string          `json:"media_type"`
+	Name               string          `json:"name"`
+	OriginalName       string          `json:"original_name"`
+	Popularity         float64         `json:"popularity"`
+	ProfilePath        string          `json:"profile_path"`
+	KnownFor           MoviesOrTVShows `json:"known_for"`
 }
 
 // PersonDetails represents person details in TMDb.
@@ -31,8 +32,8 @@ type PersonDetails struct {
 	Adult              bool     `json:"adult"`
 	AlsoKnownAs        []string `json:"also_known_as"`
 	Biography          *string  `json:"biography"`
-	Birthday           *string  `json:"birthday"`
-	Deathday           *string  `json:"deathday"`
+	Birthday           Date     `json:"birthday"`
+	Deathday           Date     `json:"deathday"`
 	Gender             int      `json:"gender"`
 	Homepage           *string  `json:"homepage"`
 	ID                 int      `json:"id"`
@@ -63,14 +64,15 @@ type PersonDetailsOptions struct {
 	// If the provided language is wrong, it is ignored.
 	Language string `url:"language,omitempty" json:"language,omitempty"`
 
-	AppendToResponse string `url:"append_to_response,omitempty" json:"append_to_response,omitempty"`
+	// Sub-resources to append to the response, example: PersonAppendImages | PersonAppendTaggedImages
+	AppendToResponse PersonAppend `url:"append_to_response,omitempty" json:"append_to_response,omitempty"`
 }
 
 // GetPerson retrieves the primary person details by id.
-func (pr *PeopleResource) GetPerson(personID int, opt *PersonDetailsOptions) (*PersonDetails, *http.Response, error) {
+func (pr *PeopleResource) GetPerson(personID int, opt *PersonDetailsOptions, options ...RequestOptionFn) (*PersonDetails, *http.Response, error) {
 	path := fmt.Sprintf("/person/%d", personID)
 	var person PersonDetails
-	resp, err := pr.client.get(path, &person, WithQueryParams(opt))
+	resp, err := pr.client.get(path, &person, WithQueryParams(opt), withOptions(options...))
 	return &person, resp, errors.Wrap(err, "failed to get person")
 }
 
@@ -81,10 +83,10 @@ type Profile struct {
 
 // GetChanges retrieves the changes for a person. By default only the last 24 hours are returned.
 // Query up to 14 days in a single query by using the start_date and end_date query parameters.
-func (pr *PeopleResource) GetChanges(personID int, opt *ChangesOptions) (*Changes, *http.Response, error) {
+func (pr *PeopleResource) GetChanges(personID int, opt *ChangesOptions, options ...RequestOptionFn) (*Changes, *http.Response, error) {
 	path := fmt.Sprintf("/person/%d/changes", personID)
 	var changes Changes
-	resp, err := pr.client.get(path, &changes, WithQueryParams(opt))
+	resp, err := pr.client.get(path, &changes, WithQueryParams(opt), withOptions(options...))
 	return &changes, resp, errors.Wrap(err, "failed to get changes")
 }
 
@@ -102,7 +104,7 @@ type MovieCastPerson struct {
 	Overview         string  `json:"overview"`
 	Popularity       float64 `json:"popularity"`
 	PosterPath       *string `json:"poster_path"`
-	ReleaseDate      string  `json:"release_date"`
+	ReleaseDate      Date    `json:"release_date"`
 	Title            string  `json:"title"`
 	Video            bool    `json:"video"`
 	VoteAverage      float64 `json:"vote_average"`
@@ -123,7 +125,7 @@ type MovieCrewPerson struct {
 	Overview         string  `json:"overview"`
 	Popularity       float64 `json:"popularity"`
 	PosterPath       *string `json:"poster_path"`
-	ReleaseDate      string  `json:"release_date"`
+	ReleaseDate      Date    `json:"release_date"`
 	Title            string  `json:"title"`
 	Video            bool    `json:"video"`
 	VoteAverage      float64 `json:"vote_average"`
@@ -138,10 +140,10 @@ type PersonMovieCredits struct {
 }
 
 // GetMovieCredits retrieves the movie credits for a person.
-func (pr *PeopleResource) GetMovieCredits(personID int, opt *CreditsOptions) (*PersonMovieCredits, *http.Response, error) {
+func (pr *PeopleResource) GetMovieCredits(personID int, opt *CreditsOptions, options ...RequestOptionFn) (*PersonMovieCredits, *http.Response, error) {
 	path := fmt.Sprintf("/person/%d/movie_credits", personID)
 	var credits PersonMovieCredits
-	resp, err := pr.client.get(path, &credits, WithQueryParams(opt))
+	resp, err := pr.client.get(path, &credits, WithQueryParams(opt), withOptions(options...))
 	return &credits, resp, errors.Wrap(err, "failed to get movie credits")
 }
 
@@ -152,7 +154,7 @@ type TVShowCastPerson struct {
 	Character        string   `json:"character"`
 	CreditID         string   `json:"credit_id"`
 	EpisodeCount     int      `json:"episode_count"`
-	FirstAirDate     string   `json:"first_air_date"`
+	FirstAirDate     Date     `json:"first_air_date"`
 	GenreIDs         []int    `json:"genre_ids"`
 	ID               int      `json:"id"`
 	Name             string   `json:"name"`
@@ -173,7 +175,7 @@ type TVShowCrewPerson struct {
 	CreditID         string   `json:"credit_id"`
 	Department       string   `json:"department"`
 	EpisodeCount     int      `json:"episode_count"`
-	FirstAirDate     string   `json:"first_air_date"`
+	FirstAirDate     Date     `json:"first_air_date"`
 	GenreIDs         []int    `json:"genre_ids"`
 	ID               int      `json:"id"`
 	Job              string   `json:"job"`
@@ -196,16 +198,13 @@ type PersonTVShowCredits struct {
 }
 
 // GetTVCredits retrieves the tv show credits for a person.
-func (pr *PeopleResource) GetTVCredits(personID int, opt *CreditsOptions) (*PersonTVShowCredits, *http.Response, error) {
+func (pr *PeopleResource) GetTVCredits(personID int, opt *CreditsOptions, options ...RequestOptionFn) (*PersonTVShowCredits, *http.Response, error) {
 	path := fmt.Sprintf("/person/%d/tv_credits", personID)
 	var credits PersonTVShowCredits
-	resp, err := pr.client.get(path, &credits, WithQueryParams(opt))
+	resp, err := pr.client.get(path, &credits, WithQueryParams(opt), withOptions(options...))
 	return &credits, resp, errors.Wrap(err, "failed to get tv show credits")
 }
 
-// CombinedCreditsCast represents a cast in TMDb.
-type CombinedCreditsCast map[string]interface{}
-
 // CombinedCreditsMovieCast represents a movie cast in TMDb.
 type CombinedCreditsMovieCast struct {
 	Adult            bool    `json:"adult"`
@@ -221,7 +220,7 @@ type CombinedCreditsMovieCast struct {
 	Overview         string  `json:"overview"`
 	Popularity       float64 `json:"popularity"`
 	PosterPath       *string `json:"poster_path"`
-	ReleaseDate      string  `json:"release_date"`
+	ReleaseDate      Date    `json:"release_date"`
 	Title            string  `json:"title"`
 	Video            bool    `json:"video"`
 	VoteAverage      float64 `json:"vote_average"`
@@ -235,7 +234,7 @@ type CombinedCreditsTVShowCast struct {
 	Character        string   `json:"character"`
 	CreditID         string   `json:"credit_id"`
 	EpisodeCount     int      `json:"episode_count"`
-	FirstAirDate     string   `json:"first_air_date"`
+	FirstAirDate     Date     `json:"first_air_date"`
 	GenreIDs         []int    `json:"genre_ids"`
 	ID               int      `json:"id"`
 	MediaType        string   `json:"media_type"`
@@ -250,9 +249,6 @@ type CombinedCreditsTVShowCast struct {
 	VoteCount        int      `json:"vote_count"`
 }
 
-// CombinedCreditsCrew represents a crew in TMDb.
-type CombinedCreditsCrew map[string]interface{}
-
 // CombinedCreditsMovieCrew represents a movie crew in TMDb.
 type CombinedCreditsMovieCrew struct {
 	Adult            bool    `json:"adult"`
@@ -268,7 +264,7 @@ type CombinedCreditsMovieCrew struct {
 	Overview         string  `json:"overview"`
 	Popularity       float64 `json:"popularity"`
 	PosterPath       *string `json:"poster_path"`
-	ReleaseDate      string  `json:"release_date"`
+	ReleaseDate      Date    `json:"release_date"`
 	Title            string  `json:"title"`
 	Video            bool    `json:"video"`
 	VoteAverage      float64 `json:"vote_average"`
@@ -282,7 +278,7 @@ type CombinedCreditsTVShowCrew struct {
 	CreditID         string   `json:"credit_id"`
 	Department       string   `json:"department"`
 	EpisodeCount     int      `json:"episode_count"`
-	FirstAirDate     string   `json:"first_air_date"`
+	FirstAirDate     Date     `json:"first_air_date"`
 	GenreIDs         []int    `json:"genre_ids"`
 	ID               int      `json:"id"`
 	Job              string   `json:"job"`
@@ -305,49 +301,37 @@ type CombinedCredits struct {
 	Crew []CombinedCreditsCrew `json:"crew"`
 }
 
-// GetMediaType retrieves the media type from a combined credits cast.
-func (cc CombinedCreditsCast) GetMediaType() string {
-	return cc["media_type"].(string)
-}
-
-// ToMovieCast converts the data to a movie cast.
-func (cc CombinedCreditsCast) ToMovieCast() (*CombinedCreditsMovieCast, error) {
-	var credits CombinedCreditsMovieCast
-	err := convert("movie", cc, &credits)
-	return &credits, errors.Wrap(err, "failed to convert object to movie cast")
-}
-
-// ToTVShowCast converts the data to a tv show cast.
-func (cc CombinedCreditsCast) ToTVShowCast() (*CombinedCreditsTVShowCast, error) {
-	var credits CombinedCreditsTVShowCast
-	err := convert("tv", cc, &credits)
-	return &credits, errors.Wrap(err, "failed to convert object to tv cast")
-}
-
-// GetMediaType retrieves the media type from a combined credits crew.
-func (cc CombinedCreditsCrew) GetMediaType() string {
-	return cc["media_type"].(string)
-}
-
-// ToMovieCrew converts the data to a movie crew.
-func (cc CombinedCreditsCrew) ToMovieCrew() (*CombinedCreditsMovieCrew, error) {
-	var credits CombinedCreditsMovieCrew
-	err := convert("movie", cc, &credits)
-	return &credits, errors.Wrap(err, "failed to convert object to movie crew")
-}
-
-// ToTVShowCrew converts the data to a tv show crew.
-func (cc CombinedCreditsCrew) ToTVShowCrew() (*CombinedCreditsTVShowCrew, error) {
-	var credits CombinedCreditsTVShowCrew
-	err := convert("tv", cc, &credits)
-	return &credits, errors.Wrap(err, "failed to convert object to tv crew")
+// UnmarshalJSON decodes each credit according to its media type.
+func (cc *CombinedCredits) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		ID   *int            `json:"id"`
+		Cast json.RawMessage `json:"cast"`
+		Crew json.RawMessage `json:"crew"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	credits := CombinedCredits{ID: raw.ID}
+	var err error
+	if len(raw.Cast) > 0 {
+		if credits.Cast, err = combinedCreditsCastDecoder.decodeList(raw.Cast); err != nil {
+			return err
+		}
+	}
+	if len(raw.Crew) > 0 {
+		if credits.Crew, err = combinedCreditsCrewDecoder.decodeList(raw.Crew); err != nil {
+			return err
+		}
+	}
+	*cc = credits
+	return nil
 }
 
 // GetCombinedCredits retrieves the movie and TV credits together in a single response.
-func (pr *PeopleResource) GetCombinedCredits(personID int, opt *CreditsOptions) (*CombinedCredits, *http.Response, error) {
+func (pr *PeopleResource) GetCombinedCredits(personID int, opt *CreditsOptions, options ...RequestOptionFn) (*CombinedCredits, *http.Response, error) {
 	path := fmt.Sprintf("/person/%d/combined_credits", personID)
 	var credits CombinedCredits
-	resp, err := pr.client.get(path, &credits, WithQueryParams(opt))
+	resp, err := pr.client.get(path, &credits, WithQueryParams(opt), withOptions(options...))
 	return &credits, resp, errors.Wrap(err, "failed to get combined credits")
 }
 
@@ -369,10 +353,10 @@ type ExternalIDOptions languageOptions
 // GetExternalIDs retrieves the external ids for a person.
 // Currently supported external sources:
 // IMDB ID, Facebook, Freebase MID, Freebase ID, Instagram, TVRage ID, Twitter
-func (pr *PeopleResource) GetExternalIDs(personID int, opt *ExternalIDOptions) (*PersonExternalIDs, *http.Response, error) {
+func (pr *PeopleResource) GetExternalIDs(personID int, opt *ExternalIDOptions, options ...RequestOptionFn) (*PersonExternalIDs, *http.Response, error) {
 	path := fmt.Sprintf("/person/%d/external_ids", personID)
 	var externalIDs PersonExternalIDs
-	resp, err := pr.client.get(path, &externalIDs, WithQueryParams(opt))
+	resp, err := pr.client.get(path, &externalIDs, WithQueryParams(opt), withOptions(options...))
 	return &externalIDs, resp, errors.Wrap(err, "failed to get external ids")
 }
 
@@ -383,10 +367,10 @@ type PersonImages struct {
 }
 
 // GetImages retrieves the images for a person.
-func (pr *PeopleResource) GetImages(personID int) (*PersonImages, *http.Response, error) {
+func (pr *PeopleResource) GetImages(personID int, options ...RequestOptionFn) (*PersonImages, *http.Response, error) {
 	path := fmt.Sprintf("/person/%d/images", personID)
 	var images PersonImages
-	resp, err := pr.client.get(path, &images)
+	resp, err := pr.client.get(path, &images, options...)
 	return &images, resp, errors.Wrap(err, "failed to get images")
 }
 
@@ -408,18 +392,37 @@ type TaggedImage struct {
 	Width       int       `json:"width"`
 }
 
+// UnmarshalJSON decodes the image media according to its media type.
+func (ti *TaggedImage) UnmarshalJSON(data []byte) error {
+	type taggedImage TaggedImage
+	var raw struct {
+		taggedImage
+		Media json.RawMessage `json:"media"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	media, err := movieOrTVDecoder.decodeAs(raw.Media, raw.MediaType)
+	if err != nil {
+		return err
+	}
+	*ti = TaggedImage(raw.taggedImage)
+	ti.Media = media
+	return nil
+}
+
 // TaggedImages represents tagged images in TMDb.
 type TaggedImages struct {
-	pagination
+	Pagination
 	ID     *int          `json:"id"`
 	Images []TaggedImage `json:"results"`
 }
 
 // GetTaggedImages retrieves the images that this person has been tagged in.
-func (pr *PeopleResource) GetTaggedImages(personID int, opt *TaggedImagesOptions) (*TaggedImages, *http.Response, error) {
+func (pr *PeopleResource) GetTaggedImages(personID int, opt *TaggedImagesOptions, options ...RequestOptionFn) (*TaggedImages, *http.Response, error) {
 	path := fmt.Sprintf("/person/%d/tagged_images", personID)
 	var images TaggedImages
-	resp, err := pr.client.get(path, &images, WithQueryParams(opt))
+	resp, err := pr.client.get(path, &images, WithQueryParams(opt), withOptions(options...))
 	return &images, resp, errors.Wrap(err, "failed to get images")
 }
 
@@ -447,10 +450,10 @@ type PersonTranslation struct {
 }
 
 // GetTranslations retrieves a list of translations that have been created for a person.
-func (pr *PeopleResource) GetTranslations(personID int, opt *PersonTranslationsOptions) (*PersonTranslations, *http.Response, error) {
+func (pr *PeopleResource) GetTranslations(personID int, opt *PersonTranslationsOptions, options ...RequestOptionFn) (*PersonTranslations, *http.Response, error) {
 	path := fmt.Sprintf("/person/%d/translations", personID)
 	var translations PersonTranslations
-	resp, err := pr.client.get(path, &translations, WithQueryParams(opt))
+	resp, err := pr.client.get(path, &translations, WithQueryParams(opt), withOptions(options...))
 	return &translations, resp, errors.Wrap(err, "failed to get translations")
 }
 
@@ -462,8 +465,8 @@ type LatestPerson struct {
 	Adult              bool     `json:"adult"`
 	AlsoKnownAs        []string `json:"also_known_as"`
 	Biography          *string  `json:"biography"`
-	Birthday           *string  `json:"birthday"`
-	Deathday           *string  `json:"deathday"`
+	Birthday           Date     `json:"birthday"`
+	Deathday           Date     `json:"deathday"`
 	Gender             int      `json:"gender"`
 	Homepage           *string  `json:"homepage"`
 	ID                 int      `json:"id"`
@@ -476,10 +479,10 @@ type LatestPerson struct {
 }
 
 // GetLatest retrieves the most newly created person. This is a live response and will continuously change.
-func (pr *PeopleResource) GetLatest(opt *LatestPersonOptions) (*LatestPerson, *http.Response, error) {
+func (pr *PeopleResource) GetLatest(opt *LatestPersonOptions, options ...RequestOptionFn) (*LatestPerson, *http.Response, error) {
 	path := "/person/latest"
 	var latest LatestPerson
-	resp, err := pr.client.get(path, &latest, WithQueryParams(opt))
+	resp, err := pr.client.get(path, &latest, WithQueryParams(opt), withOptions(options...))
 	return &latest, resp, errors.Wrap(err, "failed to get latest person")
 }
 
@@ -488,36 +491,36 @@ type PopularPeopleOptions languagePageOptions
 
 // PopularPerson represents a popular person in TMDb.
 type PopularPerson struct {
-	KnownFor           []MovieOrTV `json:"known_for"`
-	Adult              bool        `json:"adult"`
-	Gender             int         `json:"gender"`
-	ID                 int         `json:"id"`
-	KnownForDepartment string      `json:"known_for_department"`
-	Name               string      `json:"name"`
-	Popularity         float64     `json:"popularity"`
-	ProfilePath        string      `json:"profile_path"`
+	KnownFor           MoviesOrTVShows `json:"known_for"`
+	Adult              bool            `json:"adult"`
+	Gender             int             `json:"gender"`
+	ID                 int             `json:"id"`
+	KnownForDepartment string          `json:"known_for_department"`
+	Name               string          `json:"name"`
+	Popularity         float64         `json:"popularity"`
+	ProfilePath        string          `json:"profile_path"`
 }
 
 // PopularPeople represents popular people in TMDb.
 type PopularPeople struct {
-	pagination
+	Pagination
 	People []PopularPerson `json:"results"`
 }
 
 // GetPopular retrieves the list of popular people on TMDB. This list updates daily.
-func (pr *PeopleResource) GetPopular(opt *PopularPeopleOptions) (*PopularPeople, *http.Response, error) {
+func (pr *PeopleResource) GetPopular(opt *PopularPeopleOptions, options ...RequestOptionFn) (*PopularPeople, *http.Response, error) {
 	path := "/person/popular"
 	var popular PopularPeople
-	resp, err := pr.client.get(path, &popular, WithQueryParams(opt))
+	resp, err := pr.client.get(path, &popular, WithQueryParams(opt), withOptions(options...))
 	return &popular, resp, errors.Wrap(err, "failed to get popular people")
 }
 
 // GetPeopleChanges retrieves a list of all of the person ids that have been changed in the past 24 hours.
 // Query it for up to 14 days worth of changed IDs at a time with the start_date and end_date query parameters.
 // 100 items are returned per page.
-func (pr *PeopleResource) GetPeopleChanges(opt *ChangesOptions) (*MediaChanges, *http.Response, error) {
+func (pr *PeopleResource) GetPeopleChanges(opt *ChangesOptions, options ...RequestOptionFn) (*MediaChanges, *http.Response, error) {
 	path := "/person/changes"
 	var changes MediaChanges
-	resp, err := pr.client.get(path, &changes, WithQueryParams(opt))
+	resp, err := pr.client.get(path, &changes, WithQueryParams(opt), withOptions(options...))
 	return &changes, resp, errors.Wrap(err, "failed to get people changes")
 }
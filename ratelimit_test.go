@@ -0,0 +1,102 @@
+package tmdb
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	tests := []struct {
+		name              string
+		requestsPerSecond float64
+		burst             int
+		requests          int
+		wantDelays        []time.Duration
+	}{
+		{name: "within burst", requestsPerSecond: 10, burst: 3, requests: 3, wantDelays: []time.Duration{0, 0, 0}},
+		{name: "over burst", requestsPerSecond: 10, burst: 1, requests: 3, wantDelays: []time.Duration{0, 100 * time.Millisecond, 200 * time.Millisecond}},
+		{name: "queued after burst", requestsPerSecond: 4, burst: 2, requests: 4, wantDelays: []time.Duration{0, 0, 250 * time.Millisecond, 500 * time.Millisecond}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter := newRateLimiter(tt.requestsPerSecond, tt.burst)
+			for i := 0; i < tt.requests; i++ {
+				delay := limiter.reserve()
+				if want := tt.wantDelays[i]; delay < want-10*time.Millisecond || delay > want {
+					t.Errorf("request %d: got delay %v, want %v", i, delay, want)
+				}
+			}
+		})
+	}
+}
+
+func TestRateLimiterRefill(t *testing.T) {
+	limiter := newRateLimiter(100, 1)
+	if delay := limiter.reserve(); delay != 0 {
+		t.Fatalf("got delay %v, want 0", delay)
+	}
+	time.Sleep(20 * time.Millisecond)
+	if delay := limiter.reserve(); delay != 0 {
+		t.Errorf("got delay %v after refill, want 0", delay)
+	}
+}
+
+func TestRateLimiterWait(t *testing.T) {
+	limiter := newRateLimiter(20, 1)
+	ctx := context.Background()
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := limiter.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("got %v for 3 requests at 20 per second, want at least 100ms", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+	if err := limiter.Wait(ctx); err != context.Canceled {
+		t.Errorf("got error %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestRateLimiterWaitCanceled(t *testing.T) {
+	limiter := newRateLimiter(10, 1)
+	if delay := limiter.reserve(); delay != 0 {
+		t.Fatalf("got delay %v, want 0", delay)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for i := 0; i < 3; i++ {
+		if err := limiter.Wait(ctx); err != context.Canceled {
+			t.Fatalf("got error %v, want %v", err, context.Canceled)
+		}
+	}
+	// The tokens of the canceled requests are given back, so the next request does not wait for them.
+	if delay := limiter.reserve(); delay > 100*time.Millisecond {
+		t.Errorf("got delay %v after canceled requests, want at most 100ms", delay)
+	}
+}
+
+func TestWithRateLimit(t *testing.T) {
+	tests := []struct {
+		name              string
+		requestsPerSecond float64
+		burst             int
+		wantErr           bool
+	}{
+		{name: "valid", requestsPerSecond: 40, burst: 20},
+		{name: "zero rate", requestsPerSecond: 0, burst: 1, wantErr: true},
+		{name: "zero burst", requestsPerSecond: 1, burst: 0, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewClient("0123456789abcdef0123456789abcdef", WithRateLimit(tt.requestsPerSecond, tt.burst))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %t", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package tmdb
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/pkg/errors"
+)
+
+// RetryPolicy represents how failed requests are retried.
+// Requests are retried when TMDb answers with 429 (rate limited), or with a transient
+// 500, 502, 503 or 504 error, or when the request could not reach the server.
+// The wait between attempts grows exponentially from MinWait up to MaxWait,
+// unless the response has a Retry-After header, which is honored instead (capped at MaxWait).
+type RetryPolicy struct {
+	// Maximum number of retries after the first attempt.
+	MaxRetries int
+
+	// Minimum wait between attempts. Must be positive.
+	MinWait time.Duration
+
+	// Maximum wait between attempts.
+	MaxWait time.Duration
+}
+
+// DefaultRetryPolicy is a retry policy suitable for most uses.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	MinWait:    500 * time.Millisecond,
+	MaxWait:    10 * time.Second,
+}
+
+// validate checks whether the retry policy is valid.
+func (p RetryPolicy) validate() error {
+	if p.MaxRetries < 0 {
+		return errors.New("max retries must not be negative")
+	}
+	if p.MinWait <= 0 {
+		return errors.New("min wait must be positive")
+	}
+	if p.MaxWait < p.MinWait {
+		return errors.New("max wait must not be lower than min wait")
+	}
+	return nil
+}
+
+// apply configures the retry policy in the HTTP client.
+func (p RetryPolicy) apply(client *resty.Client) {
+	client.SetRetryCount(p.MaxRetries)
+	client.SetRetryWaitTime(p.MinWait)
+	client.SetRetryMaxWaitTime(p.MaxWait)
+	client.SetRetryAfter(retryAfter)
+	client.AddRetryCondition(shouldRetry)
+}
+
+// shouldRetry checks whether a request should be retried.
+func shouldRetry(resp *resty.Response, err error) bool {
+	if resp == nil {
+		// The request was not sent.
+		return false
+	}
+	if resp.RawResponse == nil {
+		// The request could not reach the server.
+		return err != nil && !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	switch resp.StatusCode() {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// retryAfter returns the wait time requested by the Retry-After header.
+// Zero means that the default exponential backoff is used.
+func retryAfter(_ *resty.Client, resp *resty.Response) (time.Duration, error) {
+	header := resp.Header().Get("Retry-After")
+	if header == "" {
+		return 0, nil
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		return time.Duration(seconds) * time.Second, nil
+	}
+	if date, err := http.ParseTime(header); err == nil && time.Until(date) > 0 {
+		return time.Until(date), nil
+	}
+	return 0, nil
+}
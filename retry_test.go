@@ -0,0 +1,181 @@
+package tmdb_test
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mdvalv/go-tmdb"
+	"github.com/mdvalv/go-tmdb/tmdbtest"
+)
+
+func TestRetry(t *testing.T) {
+	policy := tmdb.RetryPolicy{MaxRetries: 2, MinWait: time.Millisecond, MaxWait: 10 * time.Millisecond}
+	tests := []struct {
+		name         string
+		statuses     []int
+		wantErr      bool
+		wantAttempts int
+	}{
+		{name: "success", statuses: []int{http.StatusOK}, wantAttempts: 1},
+		{name: "rate limited then success", statuses: []int{http.StatusTooManyRequests, http.StatusOK}, wantAttempts: 2},
+		{name: "transient errors then success", statuses: []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusOK}, wantAttempts: 3},
+		{name: "not found is not retried", statuses: []int{http.StatusNotFound}, wantErr: true, wantAttempts: 1},
+		{name: "unauthorized is not retried", statuses: []int{http.StatusUnauthorized}, wantErr: true, wantAttempts: 1},
+		{name: "gives up after max retries", statuses: []int{http.StatusInternalServerError}, wantErr: true, wantAttempts: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := tmdbtest.NewServer()
+			defer server.Close()
+			var attempts int32
+			server.HandleFunc(http.MethodGet, "/3/movie/550", func(w http.ResponseWriter, r *http.Request) {
+				i := int(atomic.AddInt32(&attempts, 1)) - 1
+				if i >= len(tt.statuses) {
+					i = len(tt.statuses) - 1
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.statuses[i])
+				_, _ = w.Write([]byte(`{"id": 550}`))
+			})
+			client, err := server.Client(tmdb.WithRetry(policy))
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, _, err = client.Movies.GetMovie(tmdbtest.MovieID, nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("got error %v, want error %t", err, tt.wantErr)
+			}
+			if got := int(atomic.LoadInt32(&attempts)); got != tt.wantAttempts {
+				t.Errorf("got %d attempts, want %d", got, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := []struct {
+		name       string
+		retryAfter func() string
+		maxWait    time.Duration
+		wantMin    time.Duration
+		wantMax    time.Duration
+	}{
+		{
+			name:       "seconds",
+			retryAfter: func() string { return strconv.Itoa(tmdbtest.RetryAfter) },
+			maxWait:    5 * time.Second,
+			wantMin:    time.Second,
+			wantMax:    3 * time.Second,
+		},
+		{
+			name:       "capped at max wait",
+			retryAfter: func() string { return "60" },
+			maxWait:    50 * time.Millisecond,
+			wantMin:    50 * time.Millisecond,
+			wantMax:    time.Second,
+		},
+		{
+			name:       "http date",
+			retryAfter: func() string { return time.Now().Add(2 * time.Second).UTC().Format(http.TimeFormat) },
+			maxWait:    5 * time.Second,
+			wantMin:    500 * time.Millisecond,
+			wantMax:    3 * time.Second,
+		},
+		{
+			name:       "invalid falls back to backoff",
+			retryAfter: func() string { return "soon" },
+			maxWait:    50 * time.Millisecond,
+			wantMin:    0,
+			wantMax:    time.Second,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := tmdbtest.NewServer()
+			defer server.Close()
+			var attempts int32
+			server.HandleFunc(http.MethodGet, "/3/movie/550", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if atomic.AddInt32(&attempts, 1) == 1 {
+					w.Header().Set("Retry-After", tt.retryAfter())
+					w.WriteHeader(http.StatusTooManyRequests)
+					_, _ = w.Write([]byte(`{"status_code": 25}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"id": 550}`))
+			})
+			policy := tmdb.RetryPolicy{MaxRetries: 1, MinWait: time.Millisecond, MaxWait: tt.maxWait}
+			client, err := server.Client(tmdb.WithRetry(policy))
+			if err != nil {
+				t.Fatal(err)
+			}
+			start := time.Now()
+			if _, _, err := client.Movies.GetMovie(tmdbtest.MovieID, nil); err != nil {
+				t.Fatal(err)
+			}
+			if elapsed := time.Since(start); elapsed < tt.wantMin || elapsed > tt.wantMax {
+				t.Errorf("got wait %v, want between %v and %v", elapsed, tt.wantMin, tt.wantMax)
+			}
+		})
+	}
+}
+
+func TestWithRetryInvalidPolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy tmdb.RetryPolicy
+	}{
+		{name: "negative max retries", policy: tmdb.RetryPolicy{MaxRetries: -1, MinWait: time.Second, MaxWait: time.Second}},
+		{name: "zero min wait", policy: tmdb.RetryPolicy{MaxRetries: 1, MaxWait: time.Second}},
+		{name: "max wait lower than min wait", policy: tmdb.RetryPolicy{MaxRetries: 1, MinWait: time.Second, MaxWait: time.Millisecond}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tmdb.NewClient(tmdbtest.APIKey, tmdb.WithRetry(tt.policy)); err == nil {
+				t.Error("got no error for an invalid policy")
+			}
+		})
+	}
+}
+
+// recordingLogger records the messages logged by the client.
+type recordingLogger struct {
+	mu     sync.Mutex
+	errors []string
+}
+
+func (l *recordingLogger) Errorf(format string, v ...interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.errors = append(l.errors, fmt.Sprintf(format, v...))
+}
+
+func (l *recordingLogger) Warnf(format string, v ...interface{})  {}
+func (l *recordingLogger) Debugf(format string, v ...interface{}) {}
+
+func TestWithLogger(t *testing.T) {
+	server := tmdbtest.NewServer()
+	defer server.Close()
+	server.Fail(http.MethodGet, "/3/movie/550", http.StatusServiceUnavailable)
+	logger := &recordingLogger{}
+	policy := tmdb.RetryPolicy{MaxRetries: 2, MinWait: time.Millisecond, MaxWait: time.Millisecond}
+	client, err := server.Client(tmdb.WithRetry(policy), tmdb.WithLogger(logger))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := client.Movies.GetMovie(tmdbtest.MovieID, nil); err == nil {
+		t.Fatal("got no error")
+	}
+	if got := len(logger.errors); got != policy.MaxRetries+1 {
+		t.Errorf("got %d errors logged, want one per attempt", got)
+	}
+
+	if _, err := tmdb.NewClient(tmdbtest.APIKey, tmdb.WithLogger(nil)); err == nil {
+		t.Error("got no error for a nil logger")
+	}
+}
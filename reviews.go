@@ -26,8 +26,8 @@ type Review struct {
 	Author        string        `json:"author"`
 	AuthorDetails AuthorDetails `json:"author_details"`
 	Content       string        `json:"content"`
-	CreatedAt     string        `json:"created_at"`
-	UpdatedAt     string        `json:"updated_at"`
+	CreatedAt     Timestamp     `json:"created_at"`
+	UpdatedAt     Timestamp     `json:"updated_at"`
 	URL           string        `json:"url"`
 }
 
@@ -36,20 +36,20 @@ type ReviewDetails struct {
 	Author        string        `json:"author"`
 	AuthorDetails AuthorDetails `json:"author_details"`
 	Content       string        `json:"content"`
-	CreatedAt     string        `json:"created_at"`
+	CreatedAt     Timestamp     `json:"created_at"`
 	ID            string        `json:"id"`
 	ISO6391       string        `json:"iso_639_1"`
 	MediaID       int           `json:"media_id"`
 	MediaTitle    string        `json:"media_title"`
 	MediaType     string        `json:"media_type"`
-	UpdatedAt     string        `json:"updated_at"`
+	UpdatedAt     Timestamp     `json:"updated_at"`
 	URL           string        `json:"url"`
 }
 
 // GetReview retrieves the details of a movie or TV show review.
-func (rr *ReviewsResource) GetReview(id string) (*ReviewDetails, *http.Response, error) {
+func (rr *ReviewsResource) GetReview(id string, options ...RequestOptionFn) (*ReviewDetails, *http.Response, error) {
 	path := fmt.Sprintf("/review/%s", id)
 	var review ReviewDetails
-	resp, err := rr.client.get(path, &review)
+	resp, err := rr.client.get(path, &review, options...)
 	return &review, resp, errors.Wrap(err, "failed to get review")
 }
@@ -13,7 +13,7 @@ type SearchResource struct {
 
 // SearchCompanies represents companies in TMDb.
 type SearchCompanies struct {
-	pagination
+	Pagination
 	Results []Company `json:"results"`
 }
 
@@ -24,10 +24,10 @@ type SearchCompaniesOptions struct {
 }
 
 // Companies searches for companies.
-func (sr *SearchResource) Companies(query string, opt *SearchCompaniesOptions) (*SearchCompanies, *http.Response, error) {
+func (sr *SearchResource) Companies(query string, opt *SearchCompaniesOptions, options ...RequestOptionFn) (*SearchCompanies, *http.Response, error) {
 	path := "/search/company"
 	var companies SearchCompanies
-	resp, err := sr.client.get(path, &companies, WithQueryParam("query", query), WithQueryParams(opt))
+	resp, err := sr.client.get(path, &companies, WithQueryParam("query", query), WithQueryParams(opt), withOptions(options...))
 	return &companies, resp, errors.Wrap(err, "failed to search for companies")
 }
 
@@ -45,7 +45,7 @@ type SearchCollection struct {
 
 // SearchCollections represents collections in TMDb.
 type SearchCollections struct {
-	pagination
+	Pagination
 	Collections []SearchCollection `json:"results"`
 }
 
@@ -53,10 +53,10 @@ type SearchCollections struct {
 type SearchCollectionsOptions languagePageOptions
 
 // Collections searches for collections.
-func (sr *SearchResource) Collections(query string, opt *SearchCollectionsOptions) (*SearchCollections, *http.Response, error) {
+func (sr *SearchResource) Collections(query string, opt *SearchCollectionsOptions, options ...RequestOptionFn) (*SearchCollections, *http.Response, error) {
 	path := "/search/collection"
 	var collections SearchCollections
-	resp, err := sr.client.get(path, &collections, WithQueryParam("query", query), WithQueryParams(opt))
+	resp, err := sr.client.get(path, &collections, WithQueryParam("query", query), WithQueryParams(opt), withOptions(options...))
 	return &collections, resp, errors.Wrap(err, "failed to search for collections")
 }
 
@@ -68,15 +68,15 @@ type SearchKeywordsOptions struct {
 
 // SearchKeywords represents keywords in TMDb.
 type SearchKeywords struct {
-	pagination
+	Pagination
 	Keywords []Keyword `json:"results"`
 }
 
 // Keywords searches for keywords.
-func (sr *SearchResource) Keywords(query string, opt *SearchKeywordsOptions) (*SearchKeywords, *http.Response, error) {
+func (sr *SearchResource) Keywords(query string, opt *SearchKeywordsOptions, options ...RequestOptionFn) (*SearchKeywords, *http.Response, error) {
 	path := "/search/keyword"
 	var keywords SearchKeywords
-	resp, err := sr.client.get(path, &keywords, WithQueryParam("query", query), WithQueryParams(opt))
+	resp, err := sr.client.get(path, &keywords, WithQueryParam("query", query), WithQueryParams(opt), withOptions(options...))
 	return &keywords, resp, errors.Wrap(err, "failed to search for keywords")
 }
 
@@ -110,10 +110,10 @@ type SearchMoviesOptions struct {
 type SearchMovies paginatedMovies
 
 // Movies searches for movies.
-func (sr *SearchResource) Movies(query string, opt *SearchMoviesOptions) (*SearchMovies, *http.Response, error) {
+func (sr *SearchResource) Movies(query string, opt *SearchMoviesOptions, options ...RequestOptionFn) (*SearchMovies, *http.Response, error) {
 	path := "/search/movie"
 	var movies SearchMovies
-	resp, err := sr.client.get(path, &movies, WithQueryParam("query", query), WithQueryParams(opt))
+	resp, err := sr.client.get(path, &movies, WithQueryParam("query", query), WithQueryParams(opt), withOptions(options...))
 	return &movies, resp, errors.Wrap(err, "failed to search for movies")
 }
 
@@ -137,47 +137,29 @@ type SearchPeopleOptions struct {
 	Region string `url:"region,omitempty" json:"region,omitempty"`
 }
 
-// MovieOrTV can be either a movie or a tv show object in TMDb.
-type MovieOrTV map[string]interface{}
-
-// GetMediaType retrieves the media type from a movie or tv show.
-func (mt MovieOrTV) GetMediaType() string {
-	return mt["media_type"].(string)
-}
-
-// ToMovie converts the data to a movie.
-func (mt MovieOrTV) ToMovie() (*Movie, error) {
-	return convertToMovie(mt)
-}
-
-// ToTVShow converts the data to a tv show.
-func (mt MovieOrTV) ToTVShow() (*TVShow, error) {
-	return convertToTVShow(mt)
-}
-
 // SearchPerson represents a person in TMDb.
 type SearchPerson struct {
-	Adult              bool        `json:"adult"`
-	Gender             int         `json:"gender"`
-	ID                 int         `json:"id"`
-	KnownFor           []MovieOrTV `json:"known_for"`
-	KnownForDepartment string      `json:"known_for_department"`
-	Name               string      `json:"name"`
-	Popularity         float64     `json:"popularity"`
-	ProfilePath        *string     `json:"profile_path"`
+	Adult              bool            `json:"adult"`
+	Gender             int             `json:"gender"`
+	ID                 int             `json:"id"`
+	KnownFor           MoviesOrTVShows `json:"known_for"`
+	KnownForDepartment string          `json:"known_for_department"`
+	Name               string          `json:"name"`
+	Popularity         float64         `json:"popularity"`
+	ProfilePath        *string         `json:"profile_path"`
 }
 
 // SearchPeople represents people in TMDb.
 type SearchPeople struct {
-	pagination
+	Pagination
 	People []SearchPerson `json:"results"`
 }
 
 // People searches for people.
-func (sr *SearchResource) People(query string, opt *SearchPeopleOptions) (*SearchPeople, *http.Response, error) {
+func (sr *SearchResource) People(query string, opt *SearchPeopleOptions, options ...RequestOptionFn) (*SearchPeople, *http.Response, error) {
 	path := "/search/person"
 	var people SearchPeople
-	resp, err := sr.client.get(path, &people, WithQueryParam("query", query), WithQueryParams(opt))
+	resp, err := sr.client.get(path, &people, WithQueryParam("query", query), WithQueryParams(opt), withOptions(options...))
 	return &people, resp, errors.Wrap(err, "failed to search for people")
 }
 
@@ -206,10 +188,10 @@ type SearchTVShowsOptions struct {
 type SearchTVShows paginatedTVShows
 
 // TVShows searches for TV shows.
-func (sr *SearchResource) TVShows(query string, opt *SearchTVShowsOptions) (*SearchTVShows, *http.Response, error) {
+func (sr *SearchResource) TVShows(query string, opt *SearchTVShowsOptions, options ...RequestOptionFn) (*SearchTVShows, *http.Response, error) {
 	path := "/search/tv"
 	var tvShows SearchTVShows
-	resp, err := sr.client.get(path, &tvShows, WithQueryParam("query", query), WithQueryParams(opt))
+	resp, err := sr.client.get(path, &tvShows, WithQueryParam("query", query), WithQueryParams(opt), withOptions(options...))
 	return &tvShows, resp, errors.Wrap(err, "failed to search for tv shows")
 }
 
@@ -233,55 +215,17 @@ type SearchMultiOptions struct {
 	Region string `url:"region,omitempty" json:"region,omitempty"`
 }
 
-// SearchMultiPerson represents a person in TMDb.
-type SearchMultiPerson struct {
-	Adult              bool        `json:"adult"`
-	Gender             int         `json:"gender"`
-	ID                 int         `json:"id"`
-	KnownFor           []MovieOrTV `json:"known_for"`
-	KnownForDepartment string      `json:"known_for_department"`
-	Name               string      `json:"name"`
-	Popularity         float64     `json:"popularity"`
-	ProfilePath        *string     `json:"profile_path"`
-	MediaType          string      `json:"media_type"`
-}
-
-// SearchMultiResults represents results for a multi search in TMDb.
-type SearchMultiResults map[string]interface{}
-
 // SearchMulti represents a multi search in TMDb.
 type SearchMulti struct {
-	pagination
-	Results []SearchMultiResults `json:"results"`
+	Pagination
+	Results MultiResults `json:"results"`
 }
 
 // Multi searches multiple models in a single request.
 // Multi search currently supports searching for movies, tv shows and people in a single request.
-func (sr *SearchResource) Multi(query string, opt *SearchTVShowsOptions) (*SearchMulti, *http.Response, error) {
+func (sr *SearchResource) Multi(query string, opt *SearchTVShowsOptions, options ...RequestOptionFn) (*SearchMulti, *http.Response, error) {
 	path := "/search/multi"
 	var multi SearchMulti
-	resp, err := sr.client.get(path, &multi, WithQueryParam("query", query), WithQueryParams(opt))
+	resp, err := sr.client.get(path, &multi, WithQueryParam("query", query), WithQueryParams(opt), withOptions(options...))
 	return &multi, resp, errors.Wrap(err, "failed to search multi media")
 }
-
-// GetMediaType retrieves the media type from search multi results.
-func (sr SearchMultiResults) GetMediaType() string {
-	return sr["media_type"].(string)
-}
-
-// ToMovie converts the data to a movie.
-func (sr SearchMultiResults) ToMovie() (*Movie, error) {
-	return convertToMovie(sr)
-}
-
-// ToTVShow converts the data to a tv show.
-func (sr SearchMultiResults) ToTVShow() (*TVShow, error) {
-	return convertToTVShow(sr)
-}
-
-// ToPerson converts the data to a person.
-func (sr SearchMultiResults) ToPerson() (*SearchMultiPerson, error) {
-	var person SearchMultiPerson
-	err := convert("person", sr, &person)
-	return &person, err
-}
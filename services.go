@@ -0,0 +1,316 @@
+package tmdb
+
+import (
+	"context"
+	"net/http"
+)
+
+// AccountService handles account-related requests of TMDb API, see AccountResource.
+type AccountService interface {
+	GetAccount(sessionID string, options ...RequestOptionFn) (*Account, *http.Response, error)
+	GetCreatedLists(accountID int, sessionID string, opt *AccountListsOptions, options ...RequestOptionFn) (*CreatedLists, *http.Response, error)
+	GetFavoriteMovies(accountID int, sessionID string, opt *AccountOptions, options ...RequestOptionFn) (*FavoriteMovies, *http.Response, error)
+	GetFavoriteTVShows(accountID int, sessionID string, opt *AccountOptions, options ...RequestOptionFn) (*FavoriteTVShows, *http.Response, error)
+	GetRatedMovies(accountID int, sessionID string, opt *AccountOptions, options ...RequestOptionFn) (*RatedMovies, *http.Response, error)
+	GetRatedTVShows(accountID int, sessionID string, opt *AccountOptions, options ...RequestOptionFn) (*RatedTVShows, *http.Response, error)
+	GetRatedTVEpisodes(accountID int, sessionID string, opt *AccountOptions, options ...RequestOptionFn) (*RatedTVEpisodes, *http.Response, error)
+	GetWatchlistMovies(accountID int, sessionID string, opt *AccountOptions, options ...RequestOptionFn) (*WatchlistMovies, *http.Response, error)
+	GetWatchlistTVShows(accountID int, sessionID string, opt *AccountOptions, options ...RequestOptionFn) (*WatchlistTVShows, *http.Response, error)
+	Favorite(accountID int, sessionID string, favorite Favorite, options ...RequestOptionFn) (*FavoriteResponse, *http.Response, error)
+	Watchlist(accountID int, sessionID string, watchlist Watchlist, options ...RequestOptionFn) (*WatchlistResponse, *http.Response, error)
+}
+
+// AuthenticationService handles authentication-related requests of TMDb API, see AuthenticationResource.
+type AuthenticationService interface {
+	CreateRequestToken(options ...RequestOptionFn) (*AuthToken, *http.Response, error)
+	CreateGuestSession(options ...RequestOptionFn) (*GuestSession, *http.Response, error)
+	CreateSession(requestToken string, options ...RequestOptionFn) (*Session, *http.Response, error)
+	ValidateRequestToken(username, password, requestToken string, options ...RequestOptionFn) (*AuthToken, *http.Response, error)
+	CreateSessionWithV4Token(accessToken string, options ...RequestOptionFn) (*Session, *http.Response, error)
+	DeleteSession(sessionID string, options ...RequestOptionFn) (*DeleteSessionResponse, *http.Response, error)
+}
+
+// CertificationsService handles certification-related requests of TMDb API, see CertificationsResource.
+type CertificationsService interface {
+	GetMovieCertifications(options ...RequestOptionFn) (*MovieCertificationsResponse, *http.Response, error)
+	GetTVCertifications(options ...RequestOptionFn) (*TVCertificationsResponse, *http.Response, error)
+}
+
+// CollectionsService handles collection-related requests of TMDb API, see CollectionsResource.
+type CollectionsService interface {
+	GetCollection(id int, opt *CollectionsOptions, options ...RequestOptionFn) (*Collection, *http.Response, error)
+	GetImages(id int, opt *CollectionsOptions, options ...RequestOptionFn) (*CollectionImages, *http.Response, error)
+	GetTranslations(id int, opt *CollectionsOptions, options ...RequestOptionFn) (*CollectionTranslations, *http.Response, error)
+}
+
+// CompaniesService handles company-related requests of TMDb API, see CompaniesResource.
+type CompaniesService interface {
+	GetCompany(id int, options ...RequestOptionFn) (*CompanyDetails, *http.Response, error)
+	GetAlternativeNames(id int, options ...RequestOptionFn) (*CompanyAlternativeNames, *http.Response, error)
+	GetImages(id int, options ...RequestOptionFn) (*CompanyImages, *http.Response, error)
+}
+
+// ConfigurationService handles configuration-related requests of TMDb API, see ConfigurationResource.
+type ConfigurationService interface {
+	GetAPIConfiguration(options ...RequestOptionFn) (*Configuration, *http.Response, error)
+	GetCountries(options ...RequestOptionFn) (Countries, *http.Response, error)
+	GetJobs(options ...RequestOptionFn) (Jobs, *http.Response, error)
+	GetLanguages(options ...RequestOptionFn) (Languages, *http.Response, error)
+	GetPrimaryTranslations(options ...RequestOptionFn) (PrimaryTranslations, *http.Response, error)
+	GetTimezones(options ...RequestOptionFn) (Timezones, *http.Response, error)
+}
+
+// CreditsService handles credit-related requests of TMDb API, see CreditsResource.
+type CreditsService interface {
+	GetCredit(id string, options ...RequestOptionFn) (*Credit, *http.Response, error)
+}
+
+// DiscoverService handles discover-related requests of TMDb API, see DiscoverResource.
+type DiscoverService interface {
+	DiscoverMovies(opt *DiscoverMoviesOptions, options ...RequestOptionFn) (*DiscoverMovies, *http.Response, error)
+	DiscoverTVShows(opt *DiscoverTVShowsOptions, options ...RequestOptionFn) (*DiscoverTVShows, *http.Response, error)
+}
+
+// FindService handles find-related requests of TMDb API, see FindResource.
+type FindService interface {
+	Find(externalID string, externalSource ExternalSource, opt *FindOptions, options ...RequestOptionFn) (*Findings, *http.Response, error)
+}
+
+// GenresService handles genre-related requests of TMDb API, see GenresResource.
+type GenresService interface {
+	GetMovieGenres(opt *GenresOptions, options ...RequestOptionFn) (*GenresResponse, *http.Response, error)
+	GetTVGenres(opt *GenresOptions, options ...RequestOptionFn) (*GenresResponse, *http.Response, error)
+}
+
+// GuestSessionService handles guest session-related requests of TMDb API, see GuestSessionResource.
+type GuestSessionService interface {
+	GetRatedMovies(sessionID string, opt *GuestSessionOptions, options ...RequestOptionFn) (*RatedMovies, *http.Response, error)
+	GetRatedTVShows(sessionID string, opt *GuestSessionOptions, options ...RequestOptionFn) (*RatedTVShows, *http.Response, error)
+	GetRatedTVEpisodes(sessionID string, opt *GuestSessionOptions, options ...RequestOptionFn) (*RatedTVEpisodes, *http.Response, error)
+}
+
+// KeywordsService handles keyword-related requests of TMDb API, see KeywordsResource.
+type KeywordsService interface {
+	GetKeyword(id int, options ...RequestOptionFn) (*Keyword, *http.Response, error)
+	GetKeywordMovies(id int, opt *KeywordMoviesOptions, options ...RequestOptionFn) (*KeywordMovies, *http.Response, error)
+}
+
+// ListsService handles list-related requests of TMDb API, see ListsResource.
+type ListsService interface {
+	GetList(listID string, opt *ListOptions, options ...RequestOptionFn) (*List, *http.Response, error)
+	GetItemStatus(listID string, movieID int, options ...RequestOptionFn) (*ItemStatus, *http.Response, error)
+	CreateList(sessionID string, list CreateList, options ...RequestOptionFn) (*CreateListResponse, *http.Response, error)
+	AddMovie(sessionID, listID string, itemID int, options ...RequestOptionFn) (*AddItemResponse, *http.Response, error)
+	RemoveMovie(sessionID, listID string, itemID int, options ...RequestOptionFn) (*RemoveItemResponse, *http.Response, error)
+	Clear(sessionID, listID string, options ...RequestOptionFn) (*ClearListResponse, *http.Response, error)
+	Delete(sessionID, listID string, options ...RequestOptionFn) (*DeleteListResponse, *http.Response, error)
+}
+
+// MoviesService handles movie-related requests of TMDb API, see MoviesResource.
+type MoviesService interface {
+	GetMany(ctx context.Context, movieIDs []int, opt *MovieDetailsOptions, batch BatchOptions, options ...RequestOptionFn) ([]BatchResult[*MovieDetails], error)
+	StreamMany(ctx context.Context, movieIDs []int, opt *MovieDetailsOptions, batch BatchOptions, options ...RequestOptionFn) (<-chan BatchResult[*MovieDetails], error)
+	GetMovie(movieID int, opt *MovieDetailsOptions, options ...RequestOptionFn) (*MovieDetails, *http.Response, error)
+	GetMoviesChanges(opt *ChangesOptions, options ...RequestOptionFn) (*MediaChanges, *http.Response, error)
+	GetLatest(opt *LatestOptions, options ...RequestOptionFn) (*LatestMovie, *http.Response, error)
+	GetNowPlaying(opt *NowPlayingMoviesOptions, options ...RequestOptionFn) (*NowPlayingMovies, *http.Response, error)
+	GetPopular(opt *PopularMoviesOptions, options ...RequestOptionFn) (*PopularMovies, *http.Response, error)
+	GetTopRated(opt *TopRatedMoviesOptions, options ...RequestOptionFn) (*TopRatedMovies, *http.Response, error)
+	GetUpcoming(opt *UpcomingMoviesOptions, options ...RequestOptionFn) (*UpcomingMovies, *http.Response, error)
+	GetAccountStates(movieID int, sessionID string, options ...RequestOptionFn) (*AccountStates, *http.Response, error)
+	Rate(movieID int, rating float64, sessionID Auth, options ...RequestOptionFn) (*RateResponse, *http.Response, error)
+	DeleteRating(movieID int, sessionID Auth, options ...RequestOptionFn) (*DeleteRatingResponse, *http.Response, error)
+	GetAlternativeTitles(movieID int, opt *MovieAlternativeTitlesOptions, options ...RequestOptionFn) (*AlternativeMovieTitles, *http.Response, error)
+	GetChanges(movieID int, opt *ChangesOptions, options ...RequestOptionFn) (*Changes, *http.Response, error)
+	GetCredits(movieID int, opt *CreditsOptions, options ...RequestOptionFn) (*MovieCredits, *http.Response, error)
+	GetExternalIDs(movieID int, options ...RequestOptionFn) (*MovieExternalIDs, *http.Response, error)
+	GetImages(movieID int, opt *ImagesOptions, options ...RequestOptionFn) (*Images, *http.Response, error)
+	GetKeywords(movieID int, options ...RequestOptionFn) (*MovieKeywords, *http.Response, error)
+	GetLists(movieID int, opt *MoviesOptions, options ...RequestOptionFn) (*MovieLists, *http.Response, error)
+	GetRecommendations(movieID int, opt *MoviesOptions, options ...RequestOptionFn) (*RecommendedMovies, *http.Response, error)
+	GetReleaseDates(movieID int, options ...RequestOptionFn) (*MovieReleaseDates, *http.Response, error)
+	GetReviews(movieID int, opt *MoviesOptions, options ...RequestOptionFn) (*MovieReviews, *http.Response, error)
+	GetSimilar(movieID int, opt *MoviesOptions, options ...RequestOptionFn) (*SimilarMovies, *http.Response, error)
+	GetTranslations(movieID int, options ...RequestOptionFn) (*MovieTranslations, *http.Response, error)
+	GetVideos(movieID int, opt *VideosOptions, options ...RequestOptionFn) (*Videos, *http.Response, error)
+	GetWatchProviders(movieID int, options ...RequestOptionFn) (*WatchProviders, *http.Response, error)
+}
+
+// NetworksService handles network-related requests of TMDb API, see NetworksResource.
+type NetworksService interface {
+	GetNetwork(id int, options ...RequestOptionFn) (*Network, *http.Response, error)
+	GetAlternativeNames(id int, options ...RequestOptionFn) (*NetworkAlternativeNames, *http.Response, error)
+	GetImages(id int, options ...RequestOptionFn) (*NetworkImages, *http.Response, error)
+}
+
+// PeopleService handles person-related requests of TMDb API, see PeopleResource.
+type PeopleService interface {
+	GetMany(ctx context.Context, personIDs []int, opt *PersonDetailsOptions, batch BatchOptions, options ...RequestOptionFn) ([]BatchResult[*PersonDetails], error)
+	StreamMany(ctx context.Context, personIDs []int, opt *PersonDetailsOptions, batch BatchOptions, options ...RequestOptionFn) (<-chan BatchResult[*PersonDetails], error)
+	GetPerson(personID int, opt *PersonDetailsOptions, options ...RequestOptionFn) (*PersonDetails, *http.Response, error)
+	GetChanges(personID int, opt *ChangesOptions, options ...RequestOptionFn) (*Changes, *http.Response, error)
+	GetMovieCredits(personID int, opt *CreditsOptions, options ...RequestOptionFn) (*PersonMovieCredits, *http.Response, error)
+	GetTVCredits(personID int, opt *CreditsOptions, options ...RequestOptionFn) (*PersonTVShowCredits, *http.Response, error)
+	GetCombinedCredits(personID int, opt *CreditsOptions, options ...RequestOptionFn) (*CombinedCredits, *http.Response, error)
+	GetExternalIDs(personID int, opt *ExternalIDOptions, options ...RequestOptionFn) (*PersonExternalIDs, *http.Response, error)
+	GetImages(personID int, options ...RequestOptionFn) (*PersonImages, *http.Response, error)
+	GetTaggedImages(personID int, opt *TaggedImagesOptions, options ...RequestOptionFn) (*TaggedImages, *http.Response, error)
+	GetTranslations(personID int, opt *PersonTranslationsOptions, options ...RequestOptionFn) (*PersonTranslations, *http.Response, error)
+	GetLatest(opt *LatestPersonOptions, options ...RequestOptionFn) (*LatestPerson, *http.Response, error)
+	GetPopular(opt *PopularPeopleOptions, options ...RequestOptionFn) (*PopularPeople, *http.Response, error)
+	GetPeopleChanges(opt *ChangesOptions, options ...RequestOptionFn) (*MediaChanges, *http.Response, error)
+}
+
+// ReviewsService handles review-related requests of TMDb API, see ReviewsResource.
+type ReviewsService interface {
+	GetReview(id string, options ...RequestOptionFn) (*ReviewDetails, *http.Response, error)
+}
+
+// SearchService handles search-related requests of TMDb API, see SearchResource.
+type SearchService interface {
+	Companies(query string, opt *SearchCompaniesOptions, options ...RequestOptionFn) (*SearchCompanies, *http.Response, error)
+	Collections(query string, opt *SearchCollectionsOptions, options ...RequestOptionFn) (*SearchCollections, *http.Response, error)
+	Keywords(query string, opt *SearchKeywordsOptions, options ...RequestOptionFn) (*SearchKeywords, *http.Response, error)
+	Movies(query string, opt *SearchMoviesOptions, options ...RequestOptionFn) (*SearchMovies, *http.Response, error)
+	People(query string, opt *SearchPeopleOptions, options ...RequestOptionFn) (*SearchPeople, *http.Response, error)
+	TVShows(query string, opt *SearchTVShowsOptions, options ...RequestOptionFn) (*SearchTVShows, *http.Response, error)
+	Multi(query string, opt *SearchTVShowsOptions, options ...RequestOptionFn) (*SearchMulti, *http.Response, error)
+}
+
+// TrendingService handles trending-related requests of TMDb API, see TrendingResource.
+type TrendingService interface {
+	GetTrendingMovies(timeWindow TimeWindow, options ...RequestOptionFn) (*TrendingMovies, *http.Response, error)
+	GetTrendingTVShows(timeWindow TimeWindow, options ...RequestOptionFn) (*TrendingTVShows, *http.Response, error)
+	GetTrendingPeople(timeWindow TimeWindow, options ...RequestOptionFn) (*TrendingPeople, *http.Response, error)
+	GetTrending(timeWindow TimeWindow, options ...RequestOptionFn) (*Trending, *http.Response, error)
+}
+
+// TVService handles tv-related requests of TMDb API, see TVResource.
+type TVService interface {
+	GetMany(ctx context.Context, tvIDs []int, opt *TVShowDetailsOptions, batch BatchOptions, options ...RequestOptionFn) ([]BatchResult[*TVShowDetails], error)
+	StreamMany(ctx context.Context, tvIDs []int, opt *TVShowDetailsOptions, batch BatchOptions, options ...RequestOptionFn) (<-chan BatchResult[*TVShowDetails], error)
+	GetTVShow(tvID int, opt *TVShowDetailsOptions, options ...RequestOptionFn) (*TVShowDetails, *http.Response, error)
+	GetAccountStates(tvID int, sessionID string, options ...RequestOptionFn) (*AccountStates, *http.Response, error)
+	GetAggregateCredits(tvID int, opt *AggregateCreditsOptions, options ...RequestOptionFn) (*AggregateCredits, *http.Response, error)
+	GetAlternativeTitles(tvID int, opt *TVShowAlternativeTitlesOptions, options ...RequestOptionFn) (*TVShowAlternativeTitles, *http.Response, error)
+	GetChanges(tvID int, opt *ChangesOptions, options ...RequestOptionFn) (*Changes, *http.Response, error)
+	GetContentRatings(tvID int, opt *ContentRatingsOptions, options ...RequestOptionFn) (*ContentRatings, *http.Response, error)
+	GetCredits(tvID int, opt *CreditsOptions, options ...RequestOptionFn) (*TVShowCredits, *http.Response, error)
+	GetEpisodeGroups(tvID int, opt *EpisodeGroupsOptions, options ...RequestOptionFn) (*EpisodeGroups, *http.Response, error)
+	GetExternalIDs(tvID int, opt *ExternalIDsOptions, options ...RequestOptionFn) (*TVShowExternalIDs, *http.Response, error)
+	GetImages(tvID int, opt *ImagesOptions, options ...RequestOptionFn) (*Images, *http.Response, error)
+	GetKeywords(tvID int, options ...RequestOptionFn) (*TVShowKeywords, *http.Response, error)
+	GetRecommendations(tvID int, opt *RecommendationsOptions, options ...RequestOptionFn) (*RecommendedTVShows, *http.Response, error)
+	GetReviews(tvID int, opt *ReviewsOptions, options ...RequestOptionFn) (*TVShowReviews, *http.Response, error)
+	GetScreenedTheatrically(tvID int, options ...RequestOptionFn) (*ScreenedTheatrically, *http.Response, error)
+	GetSimilar(tvID int, opt *SimilarTVShowsOptions, options ...RequestOptionFn) (*SimilarTVShows, *http.Response, error)
+	GetTranslations(tvID int, options ...RequestOptionFn) (*TVShowTranslations, *http.Response, error)
+	GetVideos(tvID int, opt *VideosOptions, options ...RequestOptionFn) (*Videos, *http.Response, error)
+	GetWatchProviders(tvID int, options ...RequestOptionFn) (*WatchProviders, *http.Response, error)
+	GetLatest(opt *LatestOptions, options ...RequestOptionFn) (*LatestTVShow, *http.Response, error)
+	GetAiringToday(opt *TVShowsAiringOptions, options ...RequestOptionFn) (*TVShowsAiring, *http.Response, error)
+	GetTVShowsChanges(opt *ChangesOptions, options ...RequestOptionFn) (*MediaChanges, *http.Response, error)
+	GetOnTheAir(opt *TVShowsAiringOptions, options ...RequestOptionFn) (*TVShowsAiring, *http.Response, error)
+	GetPopular(opt *PopularTVShowsOptions, options ...RequestOptionFn) (*PopularTVShows, *http.Response, error)
+	GetTopRated(opt *TopRatedTVShowOptions, options ...RequestOptionFn) (*TopRatedTVShows, *http.Response, error)
+	GetEpisodeGroup(groupID string, opt *EpisodeGroupOptions, options ...RequestOptionFn) (*EpisodeGroup, *http.Response, error)
+	Rate(tvID int, rating float64, sessionID Auth, options ...RequestOptionFn) (*RateResponse, *http.Response, error)
+	DeleteRating(movieID int, sessionID Auth, options ...RequestOptionFn) (*DeleteRatingResponse, *http.Response, error)
+	GetFullShow(tvID int, opt *FullTVShowOptions, options ...RequestOptionFn) (*TVShowDetails, *http.Response, error)
+}
+
+// TVEpisodesService handles tv-related requests of TMDb API, see TVEpisodesResource.
+type TVEpisodesService interface {
+	GetEpisode(tvID, seasonNumber, episodeNumber int, opt *TVEpisodeDetailsOptions, options ...RequestOptionFn) (*TVEpisodeDetails, *http.Response, error)
+	GetAccountStates(tvID, seasonNumber, episodeNumber int, sessionID string, options ...RequestOptionFn) (*AccountStatesEpisode, *http.Response, error)
+	GetChanges(episodeID int, opt *ChangesOptions, options ...RequestOptionFn) (*Changes, *http.Response, error)
+	GetCredits(tvID, seasonNumber, episodeNumber int, opt *CreditsOptions, options ...RequestOptionFn) (*TVEpisodeCredits, *http.Response, error)
+	GetExternalIDs(tvID, seasonNumber, episodeNumber int, opt *ExternalIDsOptions, options ...RequestOptionFn) (*TVEpisodeExternalIDs, *http.Response, error)
+	GetImages(tvID, seasonNumber, episodeNumber int, opt *ImagesOptions, options ...RequestOptionFn) (*TVEpisodeImages, *http.Response, error)
+	GetTranslations(tvID, seasonNumber, episodeNumber int, options ...RequestOptionFn) (*TVEpisodeTranslations, *http.Response, error)
+	GetVideos(tvID, seasonNumber, episodeNumber int, opt *VideosOptions, options ...RequestOptionFn) (*Videos, *http.Response, error)
+	Rate(tvID, seasonNumber, episodeNumber int, rating float64, sessionID Auth, options ...RequestOptionFn) (*RateResponse, *http.Response, error)
+	DeleteRating(tvID, seasonNumber, episodeNumber int, sessionID Auth, options ...RequestOptionFn) (*DeleteRatingResponse, *http.Response, error)
+}
+
+// TVSeasonsService handles tv-related requests of TMDb API, see TVSeasonsResource.
+type TVSeasonsService interface {
+	GetSeason(tvID, seasonNumber int, opt *TVSeasonDetailsOptions, options ...RequestOptionFn) (*TVSeasonDetails, *http.Response, error)
+	GetAccountStates(tvID, seasonNumber int, sessionID string, options ...RequestOptionFn) (*AccountStatesSeason, *http.Response, error)
+	GetAggregateCredits(tvID, seasonNumber int, opt *AggregateCreditsOptions, options ...RequestOptionFn) (*AggregateCredits, *http.Response, error)
+	GetChanges(seasonID int, opt *ChangesOptions, options ...RequestOptionFn) (*Changes, *http.Response, error)
+	GetCredits(tvID, seasonNumber int, opt *CreditsOptions, options ...RequestOptionFn) (*TVShowCredits, *http.Response, error)
+	GetExternalIDs(tvID, seasonNumber int, opt *ExternalIDsOptions, options ...RequestOptionFn) (*TVSeasonExternalIDs, *http.Response, error)
+	GetImages(tvID, seasonNumber int, opt *ImagesOptions, options ...RequestOptionFn) (*TVSeasonImages, *http.Response, error)
+	GetTranslations(tvID, seasonNumber int, options ...RequestOptionFn) (*TVSeasonTranslations, *http.Response, error)
+	GetVideos(tvID, seasonNumber int, opt *VideosOptions, options ...RequestOptionFn) (*Videos, *http.Response, error)
+}
+
+// WatchProvidersService handles watch providers-related requests of TMDb API, see WatchProvidersResource.
+type WatchProvidersService interface {
+	GetMovieProviders(opt *ProvidersOptions, options ...RequestOptionFn) ([]Provider, *http.Response, error)
+	GetTVProviders(opt *ProvidersOptions, options ...RequestOptionFn) ([]Provider, *http.Response, error)
+	GetProviderRegions(opt *ProviderRegionsOptions, options ...RequestOptionFn) ([]ProviderRegion, *http.Response, error)
+}
+
+// AccountV4Service handles v4 account-related requests of TMDb API, see AccountV4Resource.
+type AccountV4Service interface {
+	GetLists(accountID, accessToken string, opt *AccountListsV4Options, options ...RequestOptionFn) (*AccountListsV4, *http.Response, error)
+	GetFavoriteMovies(accountID, accessToken string, opt *AccountV4Options, options ...RequestOptionFn) (*FavoriteMovies, *http.Response, error)
+	GetFavoriteTVShows(accountID, accessToken string, opt *AccountV4Options, options ...RequestOptionFn) (*FavoriteTVShows, *http.Response, error)
+	GetMovieRecommendations(accountID, accessToken string, opt *AccountV4Options, options ...RequestOptionFn) (*AccountRecommendedMovies, *http.Response, error)
+	GetTVShowRecommendations(accountID, accessToken string, opt *AccountV4Options, options ...RequestOptionFn) (*AccountRecommendedTVShows, *http.Response, error)
+	GetWatchlistMovies(accountID, accessToken string, opt *AccountV4Options, options ...RequestOptionFn) (*WatchlistMovies, *http.Response, error)
+	GetWatchlistTVShows(accountID, accessToken string, opt *AccountV4Options, options ...RequestOptionFn) (*WatchlistTVShows, *http.Response, error)
+	GetRatedMovies(accountID, accessToken string, opt *AccountV4Options, options ...RequestOptionFn) (*RatedMoviesV4, *http.Response, error)
+	GetRatedTVShows(accountID, accessToken string, opt *AccountV4Options, options ...RequestOptionFn) (*RatedTVShowsV4, *http.Response, error)
+}
+
+// AuthenticationV4Service handles v4 authentication-related requests of TMDb API, see AuthenticationV4Resource.
+type AuthenticationV4Service interface {
+	CreateRequestToken(redirectTo string, options ...RequestOptionFn) (*RequestTokenV4, *http.Response, error)
+	CreateAccessToken(requestToken string, options ...RequestOptionFn) (*AccessToken, *http.Response, error)
+	DeleteAccessToken(accessToken string, options ...RequestOptionFn) (*DeleteAccessTokenResponse, *http.Response, error)
+}
+
+// ListsV4Service handles v4 list-related requests of TMDb API, see ListsV4Resource.
+type ListsV4Service interface {
+	GetList(listID int, opt *ListV4Options, options ...RequestOptionFn) (*ListV4, *http.Response, error)
+	CreateList(accessToken string, list CreateListV4, options ...RequestOptionFn) (*CreateListV4Response, *http.Response, error)
+	UpdateList(accessToken string, listID int, list UpdateListV4, options ...RequestOptionFn) (*UpdateListV4Response, *http.Response, error)
+	Clear(accessToken string, listID int, options ...RequestOptionFn) (*ClearListV4Response, *http.Response, error)
+	Delete(accessToken string, listID int, options ...RequestOptionFn) (*DeleteListV4Response, *http.Response, error)
+	AddItems(accessToken string, listID int, items []ListItemV4, options ...RequestOptionFn) (*ListItemsV4Response, *http.Response, error)
+	UpdateItems(accessToken string, listID int, items []ListItemV4, options ...RequestOptionFn) (*ListItemsV4Response, *http.Response, error)
+	RemoveItems(accessToken string, listID int, items []ListItemV4, options ...RequestOptionFn) (*ListItemsV4Response, *http.Response, error)
+	GetItemStatus(accessToken string, listID int, mediaType string, mediaID int, options ...RequestOptionFn) (*ItemStatusV4, *http.Response, error)
+}
+
+var (
+	_ AccountService          = (*AccountResource)(nil)
+	_ AuthenticationService   = (*AuthenticationResource)(nil)
+	_ CertificationsService   = (*CertificationsResource)(nil)
+	_ CollectionsService      = (*CollectionsResource)(nil)
+	_ CompaniesService        = (*CompaniesResource)(nil)
+	_ ConfigurationService    = (*ConfigurationResource)(nil)
+	_ CreditsService          = (*CreditsResource)(nil)
+	_ DiscoverService         = (*DiscoverResource)(nil)
+	_ FindService             = (*FindResource)(nil)
+	_ GenresService           = (*GenresResource)(nil)
+	_ GuestSessionService     = (*GuestSessionResource)(nil)
+	_ KeywordsService         = (*KeywordsResource)(nil)
+	_ ListsService            = (*ListsResource)(nil)
+	_ MoviesService           = (*MoviesResource)(nil)
+	_ NetworksService         = (*NetworksResource)(nil)
+	_ PeopleService           = (*PeopleResource)(nil)
+	_ ReviewsService          = (*ReviewsResource)(nil)
+	_ SearchService           = (*SearchResource)(nil)
+	_ TrendingService         = (*TrendingResource)(nil)
+	_ TVService               = (*TVResource)(nil)
+	_ TVEpisodesService       = (*TVEpisodesResource)(nil)
+	_ TVSeasonsService        = (*TVSeasonsResource)(nil)
+	_ WatchProvidersService   = (*WatchProvidersResource)(nil)
+	_ AccountV4Service        = (*AccountV4Resource)(nil)
+	_ AuthenticationV4Service = (*AuthenticationV4Resource)(nil)
+	_ ListsV4Service          = (*ListsV4Resource)(nil)
+)
@@ -0,0 +1,447 @@
+package tmdb
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// approvalURL is the TMDb page where users approve request tokens, followed by the request token.
+const approvalURL = "https://www.themoviedb.org/authenticate/"
+
+// GuestSessionKey is the key guest sessions are stored with, see SessionManager.
+const GuestSessionKey = "guest"
+
+var (
+	// ErrSessionNotFound is returned when no session is stored for a key.
+	ErrSessionNotFound = errors.New("session not found")
+
+	// ErrSessionExpired is returned when the stored guest session has expired.
+	ErrSessionExpired = errors.New("session expired")
+
+	// ErrRequestTokenExpired is returned when a session is created from an expired request token.
+	ErrRequestTokenExpired = errors.New("request token expired")
+
+	// ErrGuestSession is returned by the requests that need a user session when using a guest session.
+	ErrGuestSession = errors.New("not allowed with a guest session")
+)
+
+// StoredSession represents a session persisted in a SessionStore.
+// Exactly one of SessionID and GuestSessionID is set.
+type StoredSession struct {
+	SessionID      string `json:"session_id,omitempty"`
+	GuestSessionID string `json:"guest_session_id,omitempty"`
+
+	// When the session expires. User sessions never expire and have a zero ExpiresAt.
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// IsGuest reports whether the session is a guest session.
+func (s StoredSession) IsGuest() bool {
+	return s.GuestSessionID != ""
+}
+
+// Expired reports whether the session has expired at the given time.
+func (s StoredSession) Expired(now time.Time) bool {
+	return !s.ExpiresAt.IsZero() && !now.Before(s.ExpiresAt)
+}
+
+// Auth returns the session as expected by rating requests.
+func (s StoredSession) Auth() Auth {
+	return Auth{SessionID: s.SessionID, GuestSessionID: s.GuestSessionID}
+}
+
+// SessionStore persists sessions by key, see SessionManager.
+// Implementations must be safe for concurrent use.
+type SessionStore interface {
+	// Get retrieves a session from the store, if present.
+	Get(key string) (*StoredSession, bool, error)
+
+	// Set stores a session.
+	Set(key string, session *StoredSession) error
+
+	// Delete removes a session from the store.
+	Delete(key string) error
+}
+
+// MemorySessionStore is an in-memory session store, whose sessions are lost when the program exits.
+type MemorySessionStore struct {
+	mu       sync.Mutex
+	sessions map[string]StoredSession
+}
+
+// NewMemorySessionStore returns an empty memory session store.
+func NewMemorySessionStore() *MemorySessionStore {
+	return &MemorySessionStore{sessions: make(map[string]StoredSession)}
+}
+
+// Get retrieves a session from the store, if present.
+func (ms *MemorySessionStore) Get(key string) (*StoredSession, bool, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	session, ok := ms.sessions[key]
+	if !ok {
+		return nil, false, nil
+	}
+	return &session, true, nil
+}
+
+// Set stores a session.
+func (ms *MemorySessionStore) Set(key string, session *StoredSession) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	ms.sessions[key] = *session
+	return nil
+}
+
+// Delete removes a session from the store.
+func (ms *MemorySessionStore) Delete(key string) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	delete(ms.sessions, key)
+	return nil
+}
+
+// FileSessionStore is a session store persisting the sessions in a JSON file.
+// The file is only readable by its owner, since session IDs grant access to the accounts.
+type FileSessionStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileSessionStore returns a session store persisting the sessions in the file at path.
+// The file is created on the first stored session.
+func NewFileSessionStore(path string) *FileSessionStore {
+	return &FileSessionStore{path: path}
+}
+
+// Get retrieves a session from the store, if present.
+func (fs *FileSessionStore) Get(key string) (*StoredSession, bool, error) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	sessions, err := fs.load()
+	if err != nil {
+		return nil, false, err
+	}
+	session, ok := sessions[key]
+	if !ok {
+		return nil, false, nil
+	}
+	return &session, true, nil
+}
+
+// Set stores a session.
+func (fs *FileSessionStore) Set(key string, session *StoredSession) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	sessions, err := fs.load()
+	if err != nil {
+		return err
+	}
+	sessions[key] = *session
+	return fs.save(sessions)
+}
+
+// Delete removes a session from the store.
+func (fs *FileSessionStore) Delete(key string) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	sessions, err := fs.load()
+	if err != nil {
+		return err
+	}
+	if _, ok := sessions[key]; !ok {
+		return nil
+	}
+	delete(sessions, key)
+	return fs.save(sessions)
+}
+
+// load reads the sessions from the file, a missing file holding no session.
+func (fs *FileSessionStore) load() (map[string]StoredSession, error) {
+	sessions := make(map[string]StoredSession)
+	data, err := os.ReadFile(fs.path)
+	if os.IsNotExist(err) {
+		return sessions, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read session store")
+	}
+	if err := json.Unmarshal(data, &sessions); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode session store %s", fs.path)
+	}
+	return sessions, nil
+}
+
+// save writes the sessions to a temporary file renamed over the file,
+// so that the file is never left partially written.
+func (fs *FileSessionStore) save(sessions map[string]StoredSession) error {
+	data, err := json.MarshalIndent(sessions, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to encode session store")
+	}
+	dir := filepath.Dir(fs.path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return errors.Wrap(err, "failed to save session store")
+	}
+	tmp, err := os.CreateTemp(dir, filepath.Base(fs.path)+".*.tmp")
+	if err != nil {
+		return errors.Wrap(err, "failed to save session store")
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return errors.Wrap(err, "failed to save session store")
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrap(err, "failed to save session store")
+	}
+	return errors.Wrap(os.Rename(tmp.Name(), fs.path), "failed to save session store")
+}
+
+// SessionManager runs the v3 login flow and persists the sessions in a SessionStore,
+// so that users only log in once.
+// User sessions are stored with a key chosen by the caller, the username for Login,
+// and the guest session with GuestSessionKey.
+//
+// Example:
+//
+//	manager := tmdb.NewSessionManager(client, tmdb.NewFileSessionStore("sessions.json"))
+//	session, err := manager.Login(username, password)
+//	if err != nil {
+//		return err
+//	}
+//	account, _, err := session.GetAccount()
+type SessionManager struct {
+	client *Client
+	store  SessionStore
+
+	// now returns the current time, used to check expirations.
+	now func() time.Time
+}
+
+// NewSessionManager returns a session manager persisting the sessions in the store,
+// or in memory if store is nil.
+func NewSessionManager(client *Client, store SessionStore) *SessionManager {
+	if store == nil {
+		store = NewMemorySessionStore()
+	}
+	return &SessionManager{client: client, store: store, now: time.Now}
+}
+
+// Login returns a session of the user, stored with the username as key.
+// Without a stored session, it creates a request token, validates it with the credentials
+// and creates a session.
+func (sm *SessionManager) Login(username, password string, options ...RequestOptionFn) (*SessionClient, error) {
+	session, err := sm.Session(username)
+	if err == nil || !errors.Is(err, ErrSessionNotFound) {
+		return session, err
+	}
+	token, _, err := sm.client.Authentication.CreateRequestToken(options...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to log in")
+	}
+	token, _, err = sm.client.Authentication.ValidateRequestToken(username, password, token.RequestToken, options...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to log in")
+	}
+	return sm.CompleteLogin(username, token, options...)
+}
+
+// RequestApproval creates a request token to be approved by the user in a browser, at the returned URL.
+// Once approved, the user is redirected to redirectTo if set, and the login is completed with CompleteLogin.
+func (sm *SessionManager) RequestApproval(redirectTo string, options ...RequestOptionFn) (*AuthToken, string, error) {
+	token, _, err := sm.client.Authentication.CreateRequestToken(options...)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to request approval")
+	}
+	approval := approvalURL + url.PathEscape(token.RequestToken)
+	if redirectTo != "" {
+		approval += "?redirect_to=" + url.QueryEscape(redirectTo)
+	}
+	return token, approval, nil
+}
+
+// CompleteLogin creates a session from a request token approved by the user, and stores it with the key.
+func (sm *SessionManager) CompleteLogin(key string, token *AuthToken, options ...RequestOptionFn) (*SessionClient, error) {
+	if token == nil {
+		return nil, errors.New("failed to create session: missing request token")
+	}
+	if !token.ExpiresAt.IsZero() && !sm.now().Before(token.ExpiresAt.Time) {
+		return nil, errors.Wrap(ErrRequestTokenExpired, "failed to create session")
+	}
+	created, _, err := sm.client.Authentication.CreateSession(token.RequestToken, options...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create session")
+	}
+	session := &StoredSession{SessionID: created.SessionID, CreatedAt: sm.now()}
+	if err := sm.store.Set(key, session); err != nil {
+		return nil, errors.Wrap(err, "failed to store session")
+	}
+	return sm.client.sessionClient(*session), nil
+}
+
+// GuestSession returns the stored guest session, creating a new one if it is missing or has expired.
+func (sm *SessionManager) GuestSession(options ...RequestOptionFn) (*SessionClient, error) {
+	session, err := sm.Session(GuestSessionKey)
+	if err == nil || !errors.Is(err, ErrSessionNotFound) && !errors.Is(err, ErrSessionExpired) {
+		return session, err
+	}
+	created, _, err := sm.client.Authentication.CreateGuestSession(options...)
+	if err != nil {
+		return nil, err
+	}
+	stored := &StoredSession{
+		GuestSessionID: created.GuestSessionID,
+		ExpiresAt:      created.ExpiresAt.Time,
+		CreatedAt:      sm.now(),
+	}
+	if err := sm.store.Set(GuestSessionKey, stored); err != nil {
+		return nil, errors.Wrap(err, "failed to store session")
+	}
+	return sm.client.sessionClient(*stored), nil
+}
+
+// Session returns the session stored with the key.
+// Expired sessions are removed from the store, and reported with ErrSessionExpired.
+func (sm *SessionManager) Session(key string) (*SessionClient, error) {
+	session, ok, err := sm.store.Get(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get session")
+	}
+	if !ok {
+		return nil, errors.Wrapf(ErrSessionNotFound, "failed to get session %q", key)
+	}
+	if session.Expired(sm.now()) {
+		if err := sm.store.Delete(key); err != nil {
+			return nil, errors.Wrap(err, "failed to delete expired session")
+		}
+		return nil, errors.Wrapf(ErrSessionExpired, "failed to get session %q", key)
+	}
+	return sm.client.sessionClient(*session), nil
+}
+
+// Logout deletes the session stored with the key from TMDb and from the store.
+// Guest sessions cannot be deleted from TMDb and are only removed from the store.
+func (sm *SessionManager) Logout(key string, options ...RequestOptionFn) error {
+	session, ok, err := sm.store.Get(key)
+	if err != nil {
+		return errors.Wrap(err, "failed to get session")
+	}
+	if !ok {
+		return nil
+	}
+	if !session.IsGuest() {
+		if _, _, err := sm.client.Authentication.DeleteSession(session.SessionID, options...); err != nil && !IsNotFound(err) {
+			return err
+		}
+	}
+	return errors.Wrap(sm.store.Delete(key), "failed to delete session")
+}
+
+// SessionClient is a view of a client bound to a session, so that session IDs are implicit.
+// The requests also needing the account ID, such as favorites, watchlist, ratings and created lists,
+// are available with Me, which retrieves the account ID once.
+type SessionClient struct {
+	client  *Client
+	session StoredSession
+
+	// Account ID of the session, resolved once by Me.
+	mu        sync.Mutex
+	accountID int
+}
+
+// WithSession returns a view of the client bound to a user session.
+func (c *Client) WithSession(sessionID string) *SessionClient {
+	return c.sessionClient(StoredSession{SessionID: sessionID})
+}
+
+// WithGuestSession returns a view of the client bound to a guest session.
+// Guest sessions can only rate, requests needing a user session fail with ErrGuestSession.
+func (c *Client) WithGuestSession(guestSessionID string) *SessionClient {
+	return c.sessionClient(StoredSession{GuestSessionID: guestSessionID})
+}
+
+// sessionClient returns a view of the client bound to the session.
+func (c *Client) sessionClient(session StoredSession) *SessionClient {
+	return &SessionClient{client: c, session: session}
+}
+
+// Client returns the client the view is bound from.
+func (sc *SessionClient) Client() *Client {
+	return sc.client
+}
+
+// Session returns the session the view is bound to.
+func (sc *SessionClient) Session() StoredSession {
+	return sc.session
+}
+
+// userSessionID returns the session ID, failing for guest sessions.
+func (sc *SessionClient) userSessionID() (string, error) {
+	if sc.session.IsGuest() {
+		return "", ErrGuestSession
+	}
+	return sc.session.SessionID, nil
+}
+
+// GetAccount retrieves the account of the session.
+func (sc *SessionClient) GetAccount(options ...RequestOptionFn) (*Account, *http.Response, error) {
+	sessionID, err := sc.userSessionID()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get account")
+	}
+	return sc.client.Account.GetAccount(sessionID, options...)
+}
+
+// CreateList creates a list owned by the account of the session.
+func (sc *SessionClient) CreateList(list CreateList, options ...RequestOptionFn) (*CreateListResponse, *http.Response, error) {
+	sessionID, err := sc.userSessionID()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to create list")
+	}
+	return sc.client.Lists.CreateList(sessionID, list, options...)
+}
+
+// AddMovieToList adds a movie to a list of the account of the session.
+func (sc *SessionClient) AddMovieToList(listID string, movieID int, options ...RequestOptionFn) (*AddItemResponse, *http.Response, error) {
+	sessionID, err := sc.userSessionID()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to add movie")
+	}
+	return sc.client.Lists.AddMovie(sessionID, listID, movieID, options...)
+}
+
+// RemoveMovieFromList removes a movie from a list of the account of the session.
+func (sc *SessionClient) RemoveMovieFromList(listID string, movieID int, options ...RequestOptionFn) (*RemoveItemResponse, *http.Response, error) {
+	sessionID, err := sc.userSessionID()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to remove movie")
+	}
+	return sc.client.Lists.RemoveMovie(sessionID, listID, movieID, options...)
+}
+
+// ClearList clears all of the items from a list of the account of the session.
+func (sc *SessionClient) ClearList(listID string, options ...RequestOptionFn) (*ClearListResponse, *http.Response, error) {
+	sessionID, err := sc.userSessionID()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to clear list")
+	}
+	return sc.client.Lists.Clear(sessionID, listID, options...)
+}
+
+// DeleteList deletes a list of the account of the session.
+func (sc *SessionClient) DeleteList(listID string, options ...RequestOptionFn) (*DeleteListResponse, *http.Response, error) {
+	sessionID, err := sc.userSessionID()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to delete list")
+	}
+	return sc.client.Lists.Delete(sessionID, listID, options...)
+}
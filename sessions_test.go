@@ -0,0 +1,128 @@
+package tmdb_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mdvalv/go-tmdb"
+	"github.com/mdvalv/go-tmdb/tmdbtest"
+	"github.com/pkg/errors"
+)
+
+func TestCompleteLogin(t *testing.T) {
+	tests := []struct {
+		name          string
+		token         *tmdb.AuthToken
+		wantSessionID string
+		wantErr       error
+	}{
+		{name: "nil token"},
+		{name: "empty token", token: &tmdb.AuthToken{}},
+		{
+			name:    "expired token",
+			token:   &tmdb.AuthToken{RequestToken: tmdbtest.RequestToken, ExpiresAt: tmdb.Timestamp{Time: time.Now().Add(-time.Minute)}},
+			wantErr: tmdb.ErrRequestTokenExpired,
+		},
+		{
+			name:          "approved token",
+			token:         &tmdb.AuthToken{RequestToken: tmdbtest.RequestToken, ExpiresAt: tmdb.Timestamp{Time: time.Now().Add(time.Hour)}},
+			wantSessionID: tmdbtest.SessionID,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := tmdbtest.NewServer()
+			defer server.Close()
+			client, err := server.Client()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			session, err := tmdb.NewSessionManager(client, nil).CompleteLogin("user", tt.token)
+			if tt.wantSessionID == "" {
+				if err == nil {
+					t.Fatal("got no error")
+				}
+				if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+					t.Errorf("got error %v, want %v", err, tt.wantErr)
+				}
+				if got := len(server.Requests()); got != 0 {
+					t.Errorf("got %d requests", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := session.Session().SessionID; got != tt.wantSessionID {
+				t.Errorf("got session id %q, want %q", got, tt.wantSessionID)
+			}
+		})
+	}
+}
+
+func TestCompleteLoginFailure(t *testing.T) {
+	server := tmdbtest.NewServer()
+	defer server.Close()
+	server.Fail(http.MethodPost, "/3/authentication/session/new", http.StatusUnauthorized)
+	client, err := server.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	token := &tmdb.AuthToken{RequestToken: tmdbtest.RequestToken}
+	_, err = tmdb.NewSessionManager(client, nil).CompleteLogin("user", token)
+	if !tmdb.IsUnauthorized(err) {
+		t.Errorf("got error %v, want unauthorized", err)
+	}
+	if err == nil || !strings.HasPrefix(err.Error(), "failed to create session") {
+		t.Errorf("got error %v, want it wrapped", err)
+	}
+}
+
+func TestLogin(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+		wantErr  bool
+	}{
+		{name: "valid credentials", password: tmdbtest.Password},
+		{name: "invalid credentials", password: "invalid", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := tmdbtest.NewServer()
+			defer server.Close()
+			client, err := server.Client()
+			if err != nil {
+				t.Fatal(err)
+			}
+			manager := tmdb.NewSessionManager(client, nil)
+
+			_, err = manager.Login(tmdbtest.Username, tt.password)
+			if tt.wantErr {
+				if !tmdb.IsUnauthorized(err) {
+					t.Errorf("got error %v, want unauthorized", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			// The stored session is reused without logging in again.
+			requests := len(server.Requests())
+			session, err := manager.Login(tmdbtest.Username, tt.password)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if session.Session().SessionID != tmdbtest.SessionID {
+				t.Errorf("got session id %q, want %q", session.Session().SessionID, tmdbtest.SessionID)
+			}
+			if got := len(server.Requests()); got != requests {
+				t.Errorf("got %d more requests for a stored session", got-requests)
+			}
+		})
+	}
+}
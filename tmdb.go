@@ -1,11 +1,10 @@
-// Package tmdb is a complete implementation for TMDb API v3.
+// Package tmdb is a complete implementation for TMDb API v3, along with the TMDb API v4 lists, account and auth.
 package tmdb
 
 import (
-	"encoding/json"
-	"fmt"
+	"context"
 	"net/http"
-	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/go-resty/resty/v2"
@@ -17,6 +16,9 @@ import (
 const (
 	// BaseURL is the base URL for TMDb API.
 	BaseURL = "https://api.themoviedb.org/3"
+
+	// BaseURLv4 is the base URL for TMDb API v4.
+	BaseURLv4 = "https://api.themoviedb.org/4"
 )
 
 // Client handles interaction with TMDb API.
@@ -24,38 +26,86 @@ type Client struct {
 	// HTTP client used to communicate with the API.
 	HTTPClient *resty.Client
 
+	// Base URL for v4 requests, derived from the v3 base URL.
+	baseURLv4 string
+
+	// Cache for get requests, if configured.
+	cache *responseCache
+
 	// Available TMDb resources that can be interacted with through the API.
-	Account        *AccountResource
-	Authentication *AuthenticationResource
-	Certifications *CertificationsResource
-	Collections    *CollectionsResource
-	Companies      *CompaniesResource
-	Configuration  *ConfigurationResource
-	Credits        *CreditsResource
-	Discover       *DiscoverResource
-	Find           *FindResource
-	Genres         *GenresResource
-	GuestSession   *GuestSessionResource
-	Keywords       *KeywordsResource
-	Lists          *ListsResource
-	Movies         *MoviesResource
-	Networks       *NetworksResource
-	People         *PeopleResource
-	Reviews        *ReviewsResource
-	Search         *SearchResource
-	Trending       *TrendingResource
-	TV             *TVResource
-	TVEpisodes     *TVEpisodesResource
-	TVSeasons      *TVSeasonsResource
-	WatchProviders *WatchProvidersResource
+	// They can be replaced with fakes in tests, see package tmdbfake.
+	Account        AccountService
+	Authentication AuthenticationService
+	Certifications CertificationsService
+	Collections    CollectionsService
+	Companies      CompaniesService
+	Configuration  ConfigurationService
+	Credits        CreditsService
+	Discover       DiscoverService
+	Find           FindService
+	Genres         GenresService
+	GuestSession   GuestSessionService
+	Keywords       KeywordsService
+	Lists          ListsService
+	Movies         MoviesService
+	Networks       NetworksService
+	People         PeopleService
+	Reviews        ReviewsService
+	Search         SearchService
+	Trending       TrendingService
+	TV             TVService
+	TVEpisodes     TVEpisodesService
+	TVSeasons      TVSeasonsService
+	WatchProviders WatchProvidersService
+
+	// Available TMDb API v4 resources.
+	AccountV4        AccountV4Service
+	AuthenticationV4 AuthenticationV4Service
+	ListsV4          ListsV4Service
 }
 
 // getRestyClient adds some custom configuration to the HTTP client used by TMDb client.
-func getRestyClient(token, baseURL string) *resty.Client {
-	client := resty.New()
-	client.SetBaseURL(baseURL)
-	client.SetQueryParam("api_key", token)
+func getRestyClient(token string, config *clientConfig) *resty.Client {
+	var client *resty.Client
+	if config.httpClient != nil {
+		httpClient := *config.httpClient
+		client = resty.NewWithClient(&httpClient)
+	} else {
+		client = resty.New()
+	}
+	if config.logger != nil {
+		client.SetLogger(config.logger)
+	}
+	if config.transport != nil {
+		client.SetTransport(config.transport)
+	}
+	client.SetBaseURL(config.baseURL)
+	if config.useBearer(token) {
+		client.SetAuthToken(token)
+	} else {
+		client.SetQueryParam("api_key", token)
+	}
 	client.SetHeader("Accept", "application/json")
+	if config.userAgent != "" {
+		client.SetHeader("User-Agent", config.userAgent)
+	}
+	if config.timeout > 0 {
+		client.SetTimeout(config.timeout)
+	}
+	if config.language != "" {
+		client.SetQueryParam("language", config.language)
+	}
+	if config.region != "" {
+		client.SetQueryParam("region", config.region)
+	}
+	if config.rateLimiter != nil {
+		client.OnBeforeRequest(func(c *resty.Client, req *resty.Request) error {
+			return config.rateLimiter.Wait(req.Context())
+		})
+	}
+	if config.retryPolicy != nil {
+		config.retryPolicy.apply(client)
+	}
 	client.OnAfterResponse(func(c *resty.Client, resp *resty.Response) error {
 		return checkResponse(resp)
 	})
@@ -63,9 +113,23 @@ func getRestyClient(token, baseURL string) *resty.Client {
 }
 
 // NewClient returns a new TMDb API client.
-func NewClient(token string) (*Client, error) {
+// The token can be either a v3 API key or a v4 API Read Access Token, see WithAuthMethod.
+func NewClient(token string, options ...ClientOption) (*Client, error) {
+	config := clientConfig{
+		baseURL: BaseURL,
+	}
+	for _, fn := range options {
+		if fn != nil {
+			if err := fn(&config); err != nil {
+				return nil, errors.Wrap(err, "failed to configure client")
+			}
+		}
+	}
+
 	c := &Client{
-		HTTPClient: getRestyClient(token, BaseURL),
+		HTTPClient: getRestyClient(token, &config),
+		baseURLv4:  strings.TrimSuffix(config.baseURL, "/3") + "/4",
+		cache:      config.cache,
 	}
 
 	c.Account = &AccountResource{client: c}
@@ -92,51 +156,21 @@ func NewClient(token string) (*Client, error) {
 	c.TVSeasons = &TVSeasonsResource{client: c}
 	c.WatchProviders = &WatchProvidersResource{client: c}
 
+	c.AccountV4 = &AccountV4Resource{client: c}
+	c.AuthenticationV4 = &AuthenticationV4Resource{client: c}
+	c.ListsV4 = &ListsV4Resource{client: c}
+
 	return c, nil
 }
 
 // checkResponse checks the API response for errors, and returns them if present.
+// Unsuccessful responses are returned as *APIError.
 func checkResponse(resp *resty.Response) error {
 	switch resp.StatusCode() {
 	case 200, 201, 202, 204, 304:
 		return nil
 	}
-
-	var err error
-	if resp.Body() != nil {
-		var raw interface{}
-		if err = json.Unmarshal(resp.Body(), &raw); err != nil {
-			return errors.Wrap(err, "failed to parse unknown error format")
-		}
-		return errors.New(parseError(raw))
-	}
-
-	return err
-}
-
-// parseError parses the error trying to make them more presentable.
-func parseError(raw interface{}) string {
-	switch raw := raw.(type) {
-	case string:
-		return raw
-	case int, float32, float64, bool:
-		return fmt.Sprint(raw)
-	case []interface{}:
-		var errs []string
-		for _, v := range raw {
-			errs = append(errs, parseError(v))
-		}
-		return fmt.Sprintf("[%s]", strings.Join(errs, ", "))
-	case map[string]interface{}:
-		var errs []string
-		for k, v := range raw {
-			errs = append(errs, fmt.Sprintf("{%s: %s}", k, parseError(v)))
-		}
-		sort.Strings(errs)
-		return strings.Join(errs, ", ")
-	default:
-		return fmt.Sprintf("failed to parse unexpected error type: %T", raw)
-	}
+	return newAPIError(resp)
 }
 
 // RequestOptionFn can be used to customize the request fields.
@@ -145,6 +179,9 @@ type RequestOptionFn func(*resty.Request) error
 // WithBody can be used to set a custom request body.
 func WithBody(body interface{}) RequestOptionFn {
 	return func(r *resty.Request) error {
+		if err := validateOptions(body); err != nil {
+			return errors.Wrap(err, "invalid request body")
+		}
 		if body != nil {
 			r.SetHeader("Content-Type", "application/json")
 			r.SetBody(body)
@@ -156,6 +193,9 @@ func WithBody(body interface{}) RequestOptionFn {
 // WithQueryParams can be used to set custom query parameters to the request.
 func WithQueryParams(params interface{}) RequestOptionFn {
 	return func(r *resty.Request) error {
+		if err := validateOptions(params); err != nil {
+			return errors.Wrap(err, "invalid request query params")
+		}
 		q, err := query.Values(params)
 		if err != nil {
 			return errors.Wrap(err, "failed to prepare request query params")
@@ -173,6 +213,14 @@ func WithQueryParam(param, value string) RequestOptionFn {
 	}
 }
 
+// WithPage can be used to set the page to query, overriding the page in the request options.
+func WithPage(page int) RequestOptionFn {
+	return func(r *resty.Request) error {
+		r.SetQueryParam("page", strconv.Itoa(page))
+		return nil
+	}
+}
+
 // WithSessionID can be used to set a session ID to the request.
 func WithSessionID(sessionID string) RequestOptionFn {
 	return func(r *resty.Request) error {
@@ -181,6 +229,42 @@ func WithSessionID(sessionID string) RequestOptionFn {
 	}
 }
 
+// WithAccessToken can be used to authenticate the request with a v4 user access token.
+// It takes precedence over the token the client was created with. Empty tokens are ignored.
+func WithAccessToken(accessToken string) RequestOptionFn {
+	return func(r *resty.Request) error {
+		if accessToken != "" {
+			r.SetAuthToken(accessToken)
+		}
+		return nil
+	}
+}
+
+// WithContext can be used to set a context to the request.
+// The context controls the cancellation and the deadline of the request.
+func WithContext(ctx context.Context) RequestOptionFn {
+	return func(r *resty.Request) error {
+		if ctx != nil {
+			r.SetContext(ctx)
+		}
+		return nil
+	}
+}
+
+// withOptions combines multiple request options into a single one.
+func withOptions(options ...RequestOptionFn) RequestOptionFn {
+	return func(r *resty.Request) error {
+		for _, fn := range options {
+			if fn != nil {
+				if err := fn(r); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+}
+
 // newRequest prepares a new resty.Request.
 func (c *Client) newRequest(resource interface{}, options ...RequestOptionFn) (*resty.Request, error) {
 	req := c.HTTPClient.NewRequest().SetResult(resource)
@@ -200,8 +284,11 @@ func (c *Client) get(path string, resource interface{}, options ...RequestOption
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get request")
 	}
+	if c.cache != nil {
+		return c.cachedGet(req, path, resource)
+	}
 	resp, err := req.Get(path)
-	return resp.RawResponse, errors.Wrap(err, "failed to execute request")
+	return rawResponse(resp), errors.Wrap(err, "failed to execute request")
 }
 
 // delete performs a delete request.
@@ -211,44 +298,41 @@ func (c *Client) delete(path string, resource interface{}, options ...RequestOpt
 		return nil, errors.Wrap(err, "failed to get request")
 	}
 	resp, err := req.Delete(path)
-	return resp.RawResponse, errors.Wrap(err, "failed to execute request")
+	return rawResponse(resp), errors.Wrap(err, "failed to execute request")
 }
 
-// post performs post request.
-func (c *Client) post(path string, resource interface{}, options ...RequestOptionFn) (*http.Response, error) {
+// put performs a put request.
+func (c *Client) put(path string, resource interface{}, options ...RequestOptionFn) (*http.Response, error) {
 	req, err := c.newRequest(resource, options...)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get request")
 	}
-	resp, err := req.Post(path)
-	return resp.RawResponse, errors.Wrap(err, "failed to execute request")
-}
-
-type media interface {
-	GetMediaType() string
+	resp, err := req.Put(path)
+	return rawResponse(resp), errors.Wrap(err, "failed to execute request")
 }
 
-func convertToMovie(obj media) (*Movie, error) {
-	var movie Movie
-	err := convert("movie", obj, &movie)
-	return &movie, errors.Wrap(err, "failed to convert object to movie")
+// urlV4 returns the absolute URL of a v4 path.
+// Absolute URLs take precedence over the client base URL, which is used for v3.
+func (c *Client) urlV4(path string) string {
+	return c.baseURLv4 + path
 }
 
-func convertToTVShow(obj media) (*TVShow, error) {
-	var tvShow TVShow
-	err := convert("tv", obj, &tvShow)
-	return &tvShow, errors.Wrap(err, "failed to convert object to tv show")
+// post performs post request.
+func (c *Client) post(path string, resource interface{}, options ...RequestOptionFn) (*http.Response, error) {
+	req, err := c.newRequest(resource, options...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get request")
+	}
+	resp, err := req.Post(path)
+	return rawResponse(resp), errors.Wrap(err, "failed to execute request")
 }
 
-func convert(expectedMedia string, obj media, to interface{}) error {
-	if obj.GetMediaType() != expectedMedia {
-		return fmt.Errorf("invalid conversion from %s to %s", obj.GetMediaType(), expectedMedia)
-	}
-	result, err := json.Marshal(obj)
-	if err != nil {
-		return errors.Wrap(err, "failed to marshal object")
+// rawResponse returns the HTTP response, if the request was sent.
+func rawResponse(resp *resty.Response) *http.Response {
+	if resp == nil {
+		return nil
 	}
-	return json.Unmarshal(result, to)
+	return resp.RawResponse
 }
 
 type statusResponse struct {
@@ -256,8 +340,38 @@ type statusResponse struct {
 	StatusMessage string `json:"status_message"`
 }
 
-type pagination struct {
+// Pagination represents the pagination metadata of a paginated response in TMDb.
+type Pagination struct {
 	Page         int `json:"page"`
 	TotalPages   int `json:"total_pages"`
 	TotalResults int `json:"total_results"`
 }
+
+// Paginated is implemented by every paginated response in TMDb.
+type Paginated interface {
+	GetPagination() Pagination
+}
+
+// GetPagination retrieves the pagination metadata.
+func (p Pagination) GetPagination() Pagination {
+	return p
+}
+
+// HasNext checks whether there is a page after the current one.
+// Pages after MaxPages are not available, even if TMDb reports them.
+func (p Pagination) HasNext() bool {
+	return p.Page < p.TotalPages && p.Page < MaxPages
+}
+
+// IsLast checks whether the current page is the last available one.
+func (p Pagination) IsLast() bool {
+	return !p.HasNext()
+}
+
+// NextPage retrieves the number of the next page, or 0 if it is the last one.
+func (p Pagination) NextPage() int {
+	if !p.HasNext() {
+		return 0
+	}
+	return p.Page + 1
+}
@@ -0,0 +1,63 @@
+// Package tmdbfake provides in-memory fakes of the services of the tmdb client,
+// so that code using the client can be tested without sending any request.
+//
+// Example:
+//
+//	client, fakes := tmdbfake.NewClient()
+//	fakes.Movies.GetMovieFunc = func(movieID int, opt *tmdb.MovieDetailsOptions, options ...tmdb.RequestOptionFn) (*tmdb.MovieDetails, *http.Response, error) {
+//		return &tmdb.MovieDetails{ID: movieID, Title: "Fight Club"}, nil, nil
+//	}
+//	movie, _, err := client.Movies.GetMovie(550, nil)
+//	calls := fakes.Movies.Calls() // [{GetMovie [550 <nil> []]}]
+//
+// The fakes are generated from the service interfaces of package tmdb.
+package tmdbfake
+
+//go:generate go run gen.go
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// ErrNotImplemented is returned by the methods of the fakes whose function is not set.
+var ErrNotImplemented = errors.New("tmdbfake: not implemented")
+
+// notImplemented returns ErrNotImplemented for a method.
+func notImplemented(method string) error {
+	return errors.Wrap(ErrNotImplemented, method)
+}
+
+// Call represents a call to a method of a fake.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// calls records the calls to the methods of a fake.
+type calls struct {
+	mu   sync.Mutex
+	list []Call
+}
+
+// record records a call.
+func (c *calls) record(method string, args ...interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.list = append(c.list, Call{Method: method, Args: args})
+}
+
+// Calls returns the calls to the methods of the fake, in the order they were made.
+func (c *calls) Calls() []Call {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]Call(nil), c.list...)
+}
+
+// Reset removes the recorded calls.
+func (c *calls) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.list = nil
+}
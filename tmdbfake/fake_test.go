@@ -0,0 +1,94 @@
+package tmdbfake_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/mdvalv/go-tmdb"
+	"github.com/mdvalv/go-tmdb/tmdbfake"
+	"github.com/pkg/errors"
+)
+
+func TestNotImplemented(t *testing.T) {
+	client, _ := tmdbfake.NewClient()
+	tests := []struct {
+		name string
+		do   func() error
+	}{
+		{
+			name: "movies",
+			do: func() error {
+				_, _, err := client.Movies.GetMovie(550, nil)
+				return err
+			},
+		},
+		{
+			name: "batch",
+			do: func() error {
+				_, err := client.People.GetMany(context.Background(), []int{287}, nil, tmdb.BatchOptions{})
+				return err
+			},
+		},
+		{
+			name: "v4 lists",
+			do: func() error {
+				_, _, err := client.ListsV4.Delete("access-token", 1)
+				return err
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.do(); !errors.Is(err, tmdbfake.ErrNotImplemented) {
+				t.Errorf("got error %v, want %v", err, tmdbfake.ErrNotImplemented)
+			}
+		})
+	}
+}
+
+func TestCalls(t *testing.T) {
+	client, fakes := tmdbfake.NewClient()
+	fakes.Movies.GetMovieFunc = func(movieID int, opt *tmdb.MovieDetailsOptions, options ...tmdb.RequestOptionFn) (*tmdb.MovieDetails, *http.Response, error) {
+		return &tmdb.MovieDetails{ID: movieID, Title: "Fight Club"}, nil, nil
+	}
+
+	movie, _, err := client.Movies.GetMovie(550, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if movie.ID != 550 || movie.Title != "Fight Club" {
+		t.Errorf("got movie %+v", movie)
+	}
+	opt := &tmdb.MovieDetailsOptions{Language: "pt-BR"}
+	if _, _, err := client.Movies.GetMovie(603, opt); err != nil {
+		t.Fatal(err)
+	}
+	_, _, _ = client.Movies.GetLatest(nil)
+
+	calls := fakes.Movies.Calls()
+	want := []string{"GetMovie", "GetMovie", "GetLatest"}
+	if len(calls) != len(want) {
+		t.Fatalf("got calls %v, want %v", calls, want)
+	}
+	for i, call := range calls {
+		if call.Method != want[i] {
+			t.Errorf("got call %d to %s, want %s", i, call.Method, want[i])
+		}
+	}
+	if got := fmt.Sprint(calls[0].Args[0], calls[1].Args[0]); got != "550 603" {
+		t.Errorf("got movie ids %s, want 550 603", got)
+	}
+	if calls[1].Args[1] != opt {
+		t.Errorf("got options %v, want the options of the call", calls[1].Args[1])
+	}
+	if got := len(fakes.People.Calls()); got != 0 {
+		t.Errorf("got %d calls to other fakes", got)
+	}
+
+	fakes.Movies.Reset()
+	if got := len(fakes.Movies.Calls()); got != 0 {
+		t.Errorf("got %d calls after reset", got)
+	}
+}
@@ -0,0 +1,2308 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package tmdbfake
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/mdvalv/go-tmdb"
+)
+
+// Client holds the fakes of a client, see NewClient.
+type Client struct {
+	Account          *AccountService
+	Authentication   *AuthenticationService
+	Certifications   *CertificationsService
+	Collections      *CollectionsService
+	Companies        *CompaniesService
+	Configuration    *ConfigurationService
+	Credits          *CreditsService
+	Discover         *DiscoverService
+	Find             *FindService
+	Genres           *GenresService
+	GuestSession     *GuestSessionService
+	Keywords         *KeywordsService
+	Lists            *ListsService
+	Movies           *MoviesService
+	Networks         *NetworksService
+	People           *PeopleService
+	Reviews          *ReviewsService
+	Search           *SearchService
+	Trending         *TrendingService
+	TV               *TVService
+	TVEpisodes       *TVEpisodesService
+	TVSeasons        *TVSeasonsService
+	WatchProviders   *WatchProvidersService
+	AccountV4        *AccountV4Service
+	AuthenticationV4 *AuthenticationV4Service
+	ListsV4          *ListsV4Service
+}
+
+// NewClient returns a client whose services are all fakes, along with the fakes.
+func NewClient() (*tmdb.Client, *Client) {
+	fakes := &Client{
+		Account:          &AccountService{},
+		Authentication:   &AuthenticationService{},
+		Certifications:   &CertificationsService{},
+		Collections:      &CollectionsService{},
+		Companies:        &CompaniesService{},
+		Configuration:    &ConfigurationService{},
+		Credits:          &CreditsService{},
+		Discover:         &DiscoverService{},
+		Find:             &FindService{},
+		Genres:           &GenresService{},
+		GuestSession:     &GuestSessionService{},
+		Keywords:         &KeywordsService{},
+		Lists:            &ListsService{},
+		Movies:           &MoviesService{},
+		Networks:         &NetworksService{},
+		People:           &PeopleService{},
+		Reviews:          &ReviewsService{},
+		Search:           &SearchService{},
+		Trending:         &TrendingService{},
+		TV:               &TVService{},
+		TVEpisodes:       &TVEpisodesService{},
+		TVSeasons:        &TVSeasonsService{},
+		WatchProviders:   &WatchProvidersService{},
+		AccountV4:        &AccountV4Service{},
+		AuthenticationV4: &AuthenticationV4Service{},
+		ListsV4:          &ListsV4Service{},
+	}
+	client := &tmdb.Client{
+		Account:          fakes.Account,
+		Authentication:   fakes.Authentication,
+		Certifications:   fakes.Certifications,
+		Collections:      fakes.Collections,
+		Companies:        fakes.Companies,
+		Configuration:    fakes.Configuration,
+		Credits:          fakes.Credits,
+		Discover:         fakes.Discover,
+		Find:             fakes.Find,
+		Genres:           fakes.Genres,
+		GuestSession:     fakes.GuestSession,
+		Keywords:         fakes.Keywords,
+		Lists:            fakes.Lists,
+		Movies:           fakes.Movies,
+		Networks:         fakes.Networks,
+		People:           fakes.People,
+		Reviews:          fakes.Reviews,
+		Search:           fakes.Search,
+		Trending:         fakes.Trending,
+		TV:               fakes.TV,
+		TVEpisodes:       fakes.TVEpisodes,
+		TVSeasons:        fakes.TVSeasons,
+		WatchProviders:   fakes.WatchProviders,
+		AccountV4:        fakes.AccountV4,
+		AuthenticationV4: fakes.AuthenticationV4,
+		ListsV4:          fakes.ListsV4,
+	}
+	return client, fakes
+}
+
+// AccountService is a fake tmdb.AccountService.
+// Each method records its call and calls the function of the same name with the Func suffix,
+// or returns ErrNotImplemented if it is not set.
+type AccountService struct {
+	calls
+
+	GetAccountFunc          func(sessionID string, options ...tmdb.RequestOptionFn) (*tmdb.Account, *http.Response, error)
+	GetCreatedListsFunc     func(accountID int, sessionID string, opt *tmdb.AccountListsOptions, options ...tmdb.RequestOptionFn) (*tmdb.CreatedLists, *http.Response, error)
+	GetFavoriteMoviesFunc   func(accountID int, sessionID string, opt *tmdb.AccountOptions, options ...tmdb.RequestOptionFn) (*tmdb.FavoriteMovies, *http.Response, error)
+	GetFavoriteTVShowsFunc  func(accountID int, sessionID string, opt *tmdb.AccountOptions, options ...tmdb.RequestOptionFn) (*tmdb.FavoriteTVShows, *http.Response, error)
+	GetRatedMoviesFunc      func(accountID int, sessionID string, opt *tmdb.AccountOptions, options ...tmdb.RequestOptionFn) (*tmdb.RatedMovies, *http.Response, error)
+	GetRatedTVShowsFunc     func(accountID int, sessionID string, opt *tmdb.AccountOptions, options ...tmdb.RequestOptionFn) (*tmdb.RatedTVShows, *http.Response, error)
+	GetRatedTVEpisodesFunc  func(accountID int, sessionID string, opt *tmdb.AccountOptions, options ...tmdb.RequestOptionFn) (*tmdb.RatedTVEpisodes, *http.Response, error)
+	GetWatchlistMoviesFunc  func(accountID int, sessionID string, opt *tmdb.AccountOptions, options ...tmdb.RequestOptionFn) (*tmdb.WatchlistMovies, *http.Response, error)
+	GetWatchlistTVShowsFunc func(accountID int, sessionID string, opt *tmdb.AccountOptions, options ...tmdb.RequestOptionFn) (*tmdb.WatchlistTVShows, *http.Response, error)
+	FavoriteFunc            func(accountID int, sessionID string, favorite tmdb.Favorite, options ...tmdb.RequestOptionFn) (*tmdb.FavoriteResponse, *http.Response, error)
+	WatchlistFunc           func(accountID int, sessionID string, watchlist tmdb.Watchlist, options ...tmdb.RequestOptionFn) (*tmdb.WatchlistResponse, *http.Response, error)
+}
+
+var _ tmdb.AccountService = (*AccountService)(nil)
+
+// GetAccount calls GetAccountFunc.
+func (f *AccountService) GetAccount(sessionID string, options ...tmdb.RequestOptionFn) (r0 *tmdb.Account, r1 *http.Response, r2 error) {
+	f.record("GetAccount", sessionID, options)
+	if f.GetAccountFunc == nil {
+		r2 = notImplemented("AccountService.GetAccount")
+		return
+	}
+	return f.GetAccountFunc(sessionID, options...)
+}
+
+// GetCreatedLists calls GetCreatedListsFunc.
+func (f *AccountService) GetCreatedLists(accountID int, sessionID string, opt *tmdb.AccountListsOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.CreatedLists, r1 *http.Response, r2 error) {
+	f.record("GetCreatedLists", accountID, sessionID, opt, options)
+	if f.GetCreatedListsFunc == nil {
+		r2 = notImplemented("AccountService.GetCreatedLists")
+		return
+	}
+	return f.GetCreatedListsFunc(accountID, sessionID, opt, options...)
+}
+
+// GetFavoriteMovies calls GetFavoriteMoviesFunc.
+func (f *AccountService) GetFavoriteMovies(accountID int, sessionID string, opt *tmdb.AccountOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.FavoriteMovies, r1 *http.Response, r2 error) {
+	f.record("GetFavoriteMovies", accountID, sessionID, opt, options)
+	if f.GetFavoriteMoviesFunc == nil {
+		r2 = notImplemented("AccountService.GetFavoriteMovies")
+		return
+	}
+	return f.GetFavoriteMoviesFunc(accountID, sessionID, opt, options...)
+}
+
+// GetFavoriteTVShows calls GetFavoriteTVShowsFunc.
+func (f *AccountService) GetFavoriteTVShows(accountID int, sessionID string, opt *tmdb.AccountOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.FavoriteTVShows, r1 *http.Response, r2 error) {
+	f.record("GetFavoriteTVShows", accountID, sessionID, opt, options)
+	if f.GetFavoriteTVShowsFunc == nil {
+		r2 = notImplemented("AccountService.GetFavoriteTVShows")
+		return
+	}
+	return f.GetFavoriteTVShowsFunc(accountID, sessionID, opt, options...)
+}
+
+// GetRatedMovies calls GetRatedMoviesFunc.
+func (f *AccountService) GetRatedMovies(accountID int, sessionID string, opt *tmdb.AccountOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.RatedMovies, r1 *http.Response, r2 error) {
+	f.record("GetRatedMovies", accountID, sessionID, opt, options)
+	if f.GetRatedMoviesFunc == nil {
+		r2 = notImplemented("AccountService.GetRatedMovies")
+		return
+	}
+	return f.GetRatedMoviesFunc(accountID, sessionID, opt, options...)
+}
+
+// GetRatedTVShows calls GetRatedTVShowsFunc.
+func (f *AccountService) GetRatedTVShows(accountID int, sessionID string, opt *tmdb.AccountOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.RatedTVShows, r1 *http.Response, r2 error) {
+	f.record("GetRatedTVShows", accountID, sessionID, opt, options)
+	if f.GetRatedTVShowsFunc == nil {
+		r2 = notImplemented("AccountService.GetRatedTVShows")
+		return
+	}
+	return f.GetRatedTVShowsFunc(accountID, sessionID, opt, options...)
+}
+
+// GetRatedTVEpisodes calls GetRatedTVEpisodesFunc.
+func (f *AccountService) GetRatedTVEpisodes(accountID int, sessionID string, opt *tmdb.AccountOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.RatedTVEpisodes, r1 *http.Response, r2 error) {
+	f.record("GetRatedTVEpisodes", accountID, sessionID, opt, options)
+	if f.GetRatedTVEpisodesFunc == nil {
+		r2 = notImplemented("AccountService.GetRatedTVEpisodes")
+		return
+	}
+	return f.GetRatedTVEpisodesFunc(accountID, sessionID, opt, options...)
+}
+
+// GetWatchlistMovies calls GetWatchlistMoviesFunc.
+func (f *AccountService) GetWatchlistMovies(accountID int, sessionID string, opt *tmdb.AccountOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.WatchlistMovies, r1 *http.Response, r2 error) {
+	f.record("GetWatchlistMovies", accountID, sessionID, opt, options)
+	if f.GetWatchlistMoviesFunc == nil {
+		r2 = notImplemented("AccountService.GetWatchlistMovies")
+		return
+	}
+	return f.GetWatchlistMoviesFunc(accountID, sessionID, opt, options...)
+}
+
+// GetWatchlistTVShows calls GetWatchlistTVShowsFunc.
+func (f *AccountService) GetWatchlistTVShows(accountID int, sessionID string, opt *tmdb.AccountOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.WatchlistTVShows, r1 *http.Response, r2 error) {
+	f.record("GetWatchlistTVShows", accountID, sessionID, opt, options)
+	if f.GetWatchlistTVShowsFunc == nil {
+		r2 = notImplemented("AccountService.GetWatchlistTVShows")
+		return
+	}
+	return f.GetWatchlistTVShowsFunc(accountID, sessionID, opt, options...)
+}
+
+// Favorite calls FavoriteFunc.
+func (f *AccountService) Favorite(accountID int, sessionID string, favorite tmdb.Favorite, options ...tmdb.RequestOptionFn) (r0 *tmdb.FavoriteResponse, r1 *http.Response, r2 error) {
+	f.record("Favorite", accountID, sessionID, favorite, options)
+	if f.FavoriteFunc == nil {
+		r2 = notImplemented("AccountService.Favorite")
+		return
+	}
+	return f.FavoriteFunc(accountID, sessionID, favorite, options...)
+}
+
+// Watchlist calls WatchlistFunc.
+func (f *AccountService) Watchlist(accountID int, sessionID string, watchlist tmdb.Watchlist, options ...tmdb.RequestOptionFn) (r0 *tmdb.WatchlistResponse, r1 *http.Response, r2 error) {
+	f.record("Watchlist", accountID, sessionID, watchlist, options)
+	if f.WatchlistFunc == nil {
+		r2 = notImplemented("AccountService.Watchlist")
+		return
+	}
+	return f.WatchlistFunc(accountID, sessionID, watchlist, options...)
+}
+
+// AuthenticationService is a fake tmdb.AuthenticationService.
+// Each method records its call and calls the function of the same name with the Func suffix,
+// or returns ErrNotImplemented if it is not set.
+type AuthenticationService struct {
+	calls
+
+	CreateRequestTokenFunc       func(options ...tmdb.RequestOptionFn) (*tmdb.AuthToken, *http.Response, error)
+	CreateGuestSessionFunc       func(options ...tmdb.RequestOptionFn) (*tmdb.GuestSession, *http.Response, error)
+	CreateSessionFunc            func(requestToken string, options ...tmdb.RequestOptionFn) (*tmdb.Session, *http.Response, error)
+	ValidateRequestTokenFunc     func(username, password, requestToken string, options ...tmdb.RequestOptionFn) (*tmdb.AuthToken, *http.Response, error)
+	CreateSessionWithV4TokenFunc func(accessToken string, options ...tmdb.RequestOptionFn) (*tmdb.Session, *http.Response, error)
+	DeleteSessionFunc            func(sessionID string, options ...tmdb.RequestOptionFn) (*tmdb.DeleteSessionResponse, *http.Response, error)
+}
+
+var _ tmdb.AuthenticationService = (*AuthenticationService)(nil)
+
+// CreateRequestToken calls CreateRequestTokenFunc.
+func (f *AuthenticationService) CreateRequestToken(options ...tmdb.RequestOptionFn) (r0 *tmdb.AuthToken, r1 *http.Response, r2 error) {
+	f.record("CreateRequestToken", options)
+	if f.CreateRequestTokenFunc == nil {
+		r2 = notImplemented("AuthenticationService.CreateRequestToken")
+		return
+	}
+	return f.CreateRequestTokenFunc(options...)
+}
+
+// CreateGuestSession calls CreateGuestSessionFunc.
+func (f *AuthenticationService) CreateGuestSession(options ...tmdb.RequestOptionFn) (r0 *tmdb.GuestSession, r1 *http.Response, r2 error) {
+	f.record("CreateGuestSession", options)
+	if f.CreateGuestSessionFunc == nil {
+		r2 = notImplemented("AuthenticationService.CreateGuestSession")
+		return
+	}
+	return f.CreateGuestSessionFunc(options...)
+}
+
+// CreateSession calls CreateSessionFunc.
+func (f *AuthenticationService) CreateSession(requestToken string, options ...tmdb.RequestOptionFn) (r0 *tmdb.Session, r1 *http.Response, r2 error) {
+	f.record("CreateSession", requestToken, options)
+	if f.CreateSessionFunc == nil {
+		r2 = notImplemented("AuthenticationService.CreateSession")
+		return
+	}
+	return f.CreateSessionFunc(requestToken, options...)
+}
+
+// ValidateRequestToken calls ValidateRequestTokenFunc.
+func (f *AuthenticationService) ValidateRequestToken(username string, password string, requestToken string, options ...tmdb.RequestOptionFn) (r0 *tmdb.AuthToken, r1 *http.Response, r2 error) {
+	f.record("ValidateRequestToken", username, password, requestToken, options)
+	if f.ValidateRequestTokenFunc == nil {
+		r2 = notImplemented("AuthenticationService.ValidateRequestToken")
+		return
+	}
+	return f.ValidateRequestTokenFunc(username, password, requestToken, options...)
+}
+
+// CreateSessionWithV4Token calls CreateSessionWithV4TokenFunc.
+func (f *AuthenticationService) CreateSessionWithV4Token(accessToken string, options ...tmdb.RequestOptionFn) (r0 *tmdb.Session, r1 *http.Response, r2 error) {
+	f.record("CreateSessionWithV4Token", accessToken, options)
+	if f.CreateSessionWithV4TokenFunc == nil {
+		r2 = notImplemented("AuthenticationService.CreateSessionWithV4Token")
+		return
+	}
+	return f.CreateSessionWithV4TokenFunc(accessToken, options...)
+}
+
+// DeleteSession calls DeleteSessionFunc.
+func (f *AuthenticationService) DeleteSession(sessionID string, options ...tmdb.RequestOptionFn) (r0 *tmdb.DeleteSessionResponse, r1 *http.Response, r2 error) {
+	f.record("DeleteSession", sessionID, options)
+	if f.DeleteSessionFunc == nil {
+		r2 = notImplemented("AuthenticationService.DeleteSession")
+		return
+	}
+	return f.DeleteSessionFunc(sessionID, options...)
+}
+
+// CertificationsService is a fake tmdb.CertificationsService.
+// Each method records its call and calls the function of the same name with the Func suffix,
+// or returns ErrNotImplemented if it is not set.
+type CertificationsService struct {
+	calls
+
+	GetMovieCertificationsFunc func(options ...tmdb.RequestOptionFn) (*tmdb.MovieCertificationsResponse, *http.Response, error)
+	GetTVCertificationsFunc    func(options ...tmdb.RequestOptionFn) (*tmdb.TVCertificationsResponse, *http.Response, error)
+}
+
+var _ tmdb.CertificationsService = (*CertificationsService)(nil)
+
+// GetMovieCertifications calls GetMovieCertificationsFunc.
+func (f *CertificationsService) GetMovieCertifications(options ...tmdb.RequestOptionFn) (r0 *tmdb.MovieCertificationsResponse, r1 *http.Response, r2 error) {
+	f.record("GetMovieCertifications", options)
+	if f.GetMovieCertificationsFunc == nil {
+		r2 = notImplemented("CertificationsService.GetMovieCertifications")
+		return
+	}
+	return f.GetMovieCertificationsFunc(options...)
+}
+
+// GetTVCertifications calls GetTVCertificationsFunc.
+func (f *CertificationsService) GetTVCertifications(options ...tmdb.RequestOptionFn) (r0 *tmdb.TVCertificationsResponse, r1 *http.Response, r2 error) {
+	f.record("GetTVCertifications", options)
+	if f.GetTVCertificationsFunc == nil {
+		r2 = notImplemented("CertificationsService.GetTVCertifications")
+		return
+	}
+	return f.GetTVCertificationsFunc(options...)
+}
+
+// CollectionsService is a fake tmdb.CollectionsService.
+// Each method records its call and calls the function of the same name with the Func suffix,
+// or returns ErrNotImplemented if it is not set.
+type CollectionsService struct {
+	calls
+
+	GetCollectionFunc   func(id int, opt *tmdb.CollectionsOptions, options ...tmdb.RequestOptionFn) (*tmdb.Collection, *http.Response, error)
+	GetImagesFunc       func(id int, opt *tmdb.CollectionsOptions, options ...tmdb.RequestOptionFn) (*tmdb.CollectionImages, *http.Response, error)
+	GetTranslationsFunc func(id int, opt *tmdb.CollectionsOptions, options ...tmdb.RequestOptionFn) (*tmdb.CollectionTranslations, *http.Response, error)
+}
+
+var _ tmdb.CollectionsService = (*CollectionsService)(nil)
+
+// GetCollection calls GetCollectionFunc.
+func (f *CollectionsService) GetCollection(id int, opt *tmdb.CollectionsOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.Collection, r1 *http.Response, r2 error) {
+	f.record("GetCollection", id, opt, options)
+	if f.GetCollectionFunc == nil {
+		r2 = notImplemented("CollectionsService.GetCollection")
+		return
+	}
+	return f.GetCollectionFunc(id, opt, options...)
+}
+
+// GetImages calls GetImagesFunc.
+func (f *CollectionsService) GetImages(id int, opt *tmdb.CollectionsOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.CollectionImages, r1 *http.Response, r2 error) {
+	f.record("GetImages", id, opt, options)
+	if f.GetImagesFunc == nil {
+		r2 = notImplemented("CollectionsService.GetImages")
+		return
+	}
+	return f.GetImagesFunc(id, opt, options...)
+}
+
+// GetTranslations calls GetTranslationsFunc.
+func (f *CollectionsService) GetTranslations(id int, opt *tmdb.CollectionsOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.CollectionTranslations, r1 *http.Response, r2 error) {
+	f.record("GetTranslations", id, opt, options)
+	if f.GetTranslationsFunc == nil {
+		r2 = notImplemented("CollectionsService.GetTranslations")
+		return
+	}
+	return f.GetTranslationsFunc(id, opt, options...)
+}
+
+// CompaniesService is a fake tmdb.CompaniesService.
+// Each method records its call and calls the function of the same name with the Func suffix,
+// or returns ErrNotImplemented if it is not set.
+type CompaniesService struct {
+	calls
+
+	GetCompanyFunc          func(id int, options ...tmdb.RequestOptionFn) (*tmdb.CompanyDetails, *http.Response, error)
+	GetAlternativeNamesFunc func(id int, options ...tmdb.RequestOptionFn) (*tmdb.CompanyAlternativeNames, *http.Response, error)
+	GetImagesFunc           func(id int, options ...tmdb.RequestOptionFn) (*tmdb.CompanyImages, *http.Response, error)
+}
+
+var _ tmdb.CompaniesService = (*CompaniesService)(nil)
+
+// GetCompany calls GetCompanyFunc.
+func (f *CompaniesService) GetCompany(id int, options ...tmdb.RequestOptionFn) (r0 *tmdb.CompanyDetails, r1 *http.Response, r2 error) {
+	f.record("GetCompany", id, options)
+	if f.GetCompanyFunc == nil {
+		r2 = notImplemented("CompaniesService.GetCompany")
+		return
+	}
+	return f.GetCompanyFunc(id, options...)
+}
+
+// GetAlternativeNames calls GetAlternativeNamesFunc.
+func (f *CompaniesService) GetAlternativeNames(id int, options ...tmdb.RequestOptionFn) (r0 *tmdb.CompanyAlternativeNames, r1 *http.Response, r2 error) {
+	f.record("GetAlternativeNames", id, options)
+	if f.GetAlternativeNamesFunc == nil {
+		r2 = notImplemented("CompaniesService.GetAlternativeNames")
+		return
+	}
+	return f.GetAlternativeNamesFunc(id, options...)
+}
+
+// GetImages calls GetImagesFunc.
+func (f *CompaniesService) GetImages(id int, options ...tmdb.RequestOptionFn) (r0 *tmdb.CompanyImages, r1 *http.Response, r2 error) {
+	f.record("GetImages", id, options)
+	if f.GetImagesFunc == nil {
+		r2 = notImplemented("CompaniesService.GetImages")
+		return
+	}
+	return f.GetImagesFunc(id, options...)
+}
+
+// ConfigurationService is a fake tmdb.ConfigurationService.
+// Each method records its call and calls the function of the same name with the Func suffix,
+// or returns ErrNotImplemented if it is not set.
+type ConfigurationService struct {
+	calls
+
+	GetAPIConfigurationFunc    func(options ...tmdb.RequestOptionFn) (*tmdb.Configuration, *http.Response, error)
+	GetCountriesFunc           func(options ...tmdb.RequestOptionFn) (tmdb.Countries, *http.Response, error)
+	GetJobsFunc                func(options ...tmdb.RequestOptionFn) (tmdb.Jobs, *http.Response, error)
+	GetLanguagesFunc           func(options ...tmdb.RequestOptionFn) (tmdb.Languages, *http.Response, error)
+	GetPrimaryTranslationsFunc func(options ...tmdb.RequestOptionFn) (tmdb.PrimaryTranslations, *http.Response, error)
+	GetTimezonesFunc           func(options ...tmdb.RequestOptionFn) (tmdb.Timezones, *http.Response, error)
+}
+
+var _ tmdb.ConfigurationService = (*ConfigurationService)(nil)
+
+// GetAPIConfiguration calls GetAPIConfigurationFunc.
+func (f *ConfigurationService) GetAPIConfiguration(options ...tmdb.RequestOptionFn) (r0 *tmdb.Configuration, r1 *http.Response, r2 error) {
+	f.record("GetAPIConfiguration", options)
+	if f.GetAPIConfigurationFunc == nil {
+		r2 = notImplemented("ConfigurationService.GetAPIConfiguration")
+		return
+	}
+	return f.GetAPIConfigurationFunc(options...)
+}
+
+// GetCountries calls GetCountriesFunc.
+func (f *ConfigurationService) GetCountries(options ...tmdb.RequestOptionFn) (r0 tmdb.Countries, r1 *http.Response, r2 error) {
+	f.record("GetCountries", options)
+	if f.GetCountriesFunc == nil {
+		r2 = notImplemented("ConfigurationService.GetCountries")
+		return
+	}
+	return f.GetCountriesFunc(options...)
+}
+
+// GetJobs calls GetJobsFunc.
+func (f *ConfigurationService) GetJobs(options ...tmdb.RequestOptionFn) (r0 tmdb.Jobs, r1 *http.Response, r2 error) {
+	f.record("GetJobs", options)
+	if f.GetJobsFunc == nil {
+		r2 = notImplemented("ConfigurationService.GetJobs")
+		return
+	}
+	return f.GetJobsFunc(options...)
+}
+
+// GetLanguages calls GetLanguagesFunc.
+func (f *ConfigurationService) GetLanguages(options ...tmdb.RequestOptionFn) (r0 tmdb.Languages, r1 *http.Response, r2 error) {
+	f.record("GetLanguages", options)
+	if f.GetLanguagesFunc == nil {
+		r2 = notImplemented("ConfigurationService.GetLanguages")
+		return
+	}
+	return f.GetLanguagesFunc(options...)
+}
+
+// GetPrimaryTranslations calls GetPrimaryTranslationsFunc.
+func (f *ConfigurationService) GetPrimaryTranslations(options ...tmdb.RequestOptionFn) (r0 tmdb.PrimaryTranslations, r1 *http.Response, r2 error) {
+	f.record("GetPrimaryTranslations", options)
+	if f.GetPrimaryTranslationsFunc == nil {
+		r2 = notImplemented("ConfigurationService.GetPrimaryTranslations")
+		return
+	}
+	return f.GetPrimaryTranslationsFunc(options...)
+}
+
+// GetTimezones calls GetTimezonesFunc.
+func (f *ConfigurationService) GetTimezones(options ...tmdb.RequestOptionFn) (r0 tmdb.Timezones, r1 *http.Response, r2 error) {
+	f.record("GetTimezones", options)
+	if f.GetTimezonesFunc == nil {
+		r2 = notImplemented("ConfigurationService.GetTimezones")
+		return
+	}
+	return f.GetTimezonesFunc(options...)
+}
+
+// CreditsService is a fake tmdb.CreditsService.
+// Each method records its call and calls the function of the same name with the Func suffix,
+// or returns ErrNotImplemented if it is not set.
+type CreditsService struct {
+	calls
+
+	GetCreditFunc func(id string, options ...tmdb.RequestOptionFn) (*tmdb.Credit, *http.Response, error)
+}
+
+var _ tmdb.CreditsService = (*CreditsService)(nil)
+
+// GetCredit calls GetCreditFunc.
+func (f *CreditsService) GetCredit(id string, options ...tmdb.RequestOptionFn) (r0 *tmdb.Credit, r1 *http.Response, r2 error) {
+	f.record("GetCredit", id, options)
+	if f.GetCreditFunc == nil {
+		r2 = notImplemented("CreditsService.GetCredit")
+		return
+	}
+	return f.GetCreditFunc(id, options...)
+}
+
+// DiscoverService is a fake tmdb.DiscoverService.
+// Each method records its call and calls the function of the same name with the Func suffix,
+// or returns ErrNotImplemented if it is not set.
+type DiscoverService struct {
+	calls
+
+	DiscoverMoviesFunc  func(opt *tmdb.DiscoverMoviesOptions, options ...tmdb.RequestOptionFn) (*tmdb.DiscoverMovies, *http.Response, error)
+	DiscoverTVShowsFunc func(opt *tmdb.DiscoverTVShowsOptions, options ...tmdb.RequestOptionFn) (*tmdb.DiscoverTVShows, *http.Response, error)
+}
+
+var _ tmdb.DiscoverService = (*DiscoverService)(nil)
+
+// DiscoverMovies calls DiscoverMoviesFunc.
+func (f *DiscoverService) DiscoverMovies(opt *tmdb.DiscoverMoviesOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.DiscoverMovies, r1 *http.Response, r2 error) {
+	f.record("DiscoverMovies", opt, options)
+	if f.DiscoverMoviesFunc == nil {
+		r2 = notImplemented("DiscoverService.DiscoverMovies")
+		return
+	}
+	return f.DiscoverMoviesFunc(opt, options...)
+}
+
+// DiscoverTVShows calls DiscoverTVShowsFunc.
+func (f *DiscoverService) DiscoverTVShows(opt *tmdb.DiscoverTVShowsOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.DiscoverTVShows, r1 *http.Response, r2 error) {
+	f.record("DiscoverTVShows", opt, options)
+	if f.DiscoverTVShowsFunc == nil {
+		r2 = notImplemented("DiscoverService.DiscoverTVShows")
+		return
+	}
+	return f.DiscoverTVShowsFunc(opt, options...)
+}
+
+// FindService is a fake tmdb.FindService.
+// Each method records its call and calls the function of the same name with the Func suffix,
+// or returns ErrNotImplemented if it is not set.
+type FindService struct {
+	calls
+
+	FindFunc func(externalID string, externalSource tmdb.ExternalSource, opt *tmdb.FindOptions, options ...tmdb.RequestOptionFn) (*tmdb.Findings, *http.Response, error)
+}
+
+var _ tmdb.FindService = (*FindService)(nil)
+
+// Find calls FindFunc.
+func (f *FindService) Find(externalID string, externalSource tmdb.ExternalSource, opt *tmdb.FindOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.Findings, r1 *http.Response, r2 error) {
+	f.record("Find", externalID, externalSource, opt, options)
+	if f.FindFunc == nil {
+		r2 = notImplemented("FindService.Find")
+		return
+	}
+	return f.FindFunc(externalID, externalSource, opt, options...)
+}
+
+// GenresService is a fake tmdb.GenresService.
+// Each method records its call and calls the function of the same name with the Func suffix,
+// or returns ErrNotImplemented if it is not set.
+type GenresService struct {
+	calls
+
+	GetMovieGenresFunc func(opt *tmdb.GenresOptions, options ...tmdb.RequestOptionFn) (*tmdb.GenresResponse, *http.Response, error)
+	GetTVGenresFunc    func(opt *tmdb.GenresOptions, options ...tmdb.RequestOptionFn) (*tmdb.GenresResponse, *http.Response, error)
+}
+
+var _ tmdb.GenresService = (*GenresService)(nil)
+
+// GetMovieGenres calls GetMovieGenresFunc.
+func (f *GenresService) GetMovieGenres(opt *tmdb.GenresOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.GenresResponse, r1 *http.Response, r2 error) {
+	f.record("GetMovieGenres", opt, options)
+	if f.GetMovieGenresFunc == nil {
+		r2 = notImplemented("GenresService.GetMovieGenres")
+		return
+	}
+	return f.GetMovieGenresFunc(opt, options...)
+}
+
+// GetTVGenres calls GetTVGenresFunc.
+func (f *GenresService) GetTVGenres(opt *tmdb.GenresOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.GenresResponse, r1 *http.Response, r2 error) {
+	f.record("GetTVGenres", opt, options)
+	if f.GetTVGenresFunc == nil {
+		r2 = notImplemented("GenresService.GetTVGenres")
+		return
+	}
+	return f.GetTVGenresFunc(opt, options...)
+}
+
+// GuestSessionService is a fake tmdb.GuestSessionService.
+// Each method records its call and calls the function of the same name with the Func suffix,
+// or returns ErrNotImplemented if it is not set.
+type GuestSessionService struct {
+	calls
+
+	GetRatedMoviesFunc     func(sessionID string, opt *tmdb.GuestSessionOptions, options ...tmdb.RequestOptionFn) (*tmdb.RatedMovies, *http.Response, error)
+	GetRatedTVShowsFunc    func(sessionID string, opt *tmdb.GuestSessionOptions, options ...tmdb.RequestOptionFn) (*tmdb.RatedTVShows, *http.Response, error)
+	GetRatedTVEpisodesFunc func(sessionID string, opt *tmdb.GuestSessionOptions, options ...tmdb.RequestOptionFn) (*tmdb.RatedTVEpisodes, *http.Response, error)
+}
+
+var _ tmdb.GuestSessionService = (*GuestSessionService)(nil)
+
+// GetRatedMovies calls GetRatedMoviesFunc.
+func (f *GuestSessionService) GetRatedMovies(sessionID string, opt *tmdb.GuestSessionOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.RatedMovies, r1 *http.Response, r2 error) {
+	f.record("GetRatedMovies", sessionID, opt, options)
+	if f.GetRatedMoviesFunc == nil {
+		r2 = notImplemented("GuestSessionService.GetRatedMovies")
+		return
+	}
+	return f.GetRatedMoviesFunc(sessionID, opt, options...)
+}
+
+// GetRatedTVShows calls GetRatedTVShowsFunc.
+func (f *GuestSessionService) GetRatedTVShows(sessionID string, opt *tmdb.GuestSessionOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.RatedTVShows, r1 *http.Response, r2 error) {
+	f.record("GetRatedTVShows", sessionID, opt, options)
+	if f.GetRatedTVShowsFunc == nil {
+		r2 = notImplemented("GuestSessionService.GetRatedTVShows")
+		return
+	}
+	return f.GetRatedTVShowsFunc(sessionID, opt, options...)
+}
+
+// GetRatedTVEpisodes calls GetRatedTVEpisodesFunc.
+func (f *GuestSessionService) GetRatedTVEpisodes(sessionID string, opt *tmdb.GuestSessionOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.RatedTVEpisodes, r1 *http.Response, r2 error) {
+	f.record("GetRatedTVEpisodes", sessionID, opt, options)
+	if f.GetRatedTVEpisodesFunc == nil {
+		r2 = notImplemented("GuestSessionService.GetRatedTVEpisodes")
+		return
+	}
+	return f.GetRatedTVEpisodesFunc(sessionID, opt, options...)
+}
+
+// KeywordsService is a fake tmdb.KeywordsService.
+// Each method records its call and calls the function of the same name with the Func suffix,
+// or returns ErrNotImplemented if it is not set.
+type KeywordsService struct {
+	calls
+
+	GetKeywordFunc       func(id int, options ...tmdb.RequestOptionFn) (*tmdb.Keyword, *http.Response, error)
+	GetKeywordMoviesFunc func(id int, opt *tmdb.KeywordMoviesOptions, options ...tmdb.RequestOptionFn) (*tmdb.KeywordMovies, *http.Response, error)
+}
+
+var _ tmdb.KeywordsService = (*KeywordsService)(nil)
+
+// GetKeyword calls GetKeywordFunc.
+func (f *KeywordsService) GetKeyword(id int, options ...tmdb.RequestOptionFn) (r0 *tmdb.Keyword, r1 *http.Response, r2 error) {
+	f.record("GetKeyword", id, options)
+	if f.GetKeywordFunc == nil {
+		r2 = notImplemented("KeywordsService.GetKeyword")
+		return
+	}
+	return f.GetKeywordFunc(id, options...)
+}
+
+// GetKeywordMovies calls GetKeywordMoviesFunc.
+func (f *KeywordsService) GetKeywordMovies(id int, opt *tmdb.KeywordMoviesOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.KeywordMovies, r1 *http.Response, r2 error) {
+	f.record("GetKeywordMovies", id, opt, options)
+	if f.GetKeywordMoviesFunc == nil {
+		r2 = notImplemented("KeywordsService.GetKeywordMovies")
+		return
+	}
+	return f.GetKeywordMoviesFunc(id, opt, options...)
+}
+
+// ListsService is a fake tmdb.ListsService.
+// Each method records its call and calls the function of the same name with the Func suffix,
+// or returns ErrNotImplemented if it is not set.
+type ListsService struct {
+	calls
+
+	GetListFunc       func(listID string, opt *tmdb.ListOptions, options ...tmdb.RequestOptionFn) (*tmdb.List, *http.Response, error)
+	GetItemStatusFunc func(listID string, movieID int, options ...tmdb.RequestOptionFn) (*tmdb.ItemStatus, *http.Response, error)
+	CreateListFunc    func(sessionID string, list tmdb.CreateList, options ...tmdb.RequestOptionFn) (*tmdb.CreateListResponse, *http.Response, error)
+	AddMovieFunc      func(sessionID, listID string, itemID int, options ...tmdb.RequestOptionFn) (*tmdb.AddItemResponse, *http.Response, error)
+	RemoveMovieFunc   func(sessionID, listID string, itemID int, options ...tmdb.RequestOptionFn) (*tmdb.RemoveItemResponse, *http.Response, error)
+	ClearFunc         func(sessionID, listID string, options ...tmdb.RequestOptionFn) (*tmdb.ClearListResponse, *http.Response, error)
+	DeleteFunc        func(sessionID, listID string, options ...tmdb.RequestOptionFn) (*tmdb.DeleteListResponse, *http.Response, error)
+}
+
+var _ tmdb.ListsService = (*ListsService)(nil)
+
+// GetList calls GetListFunc.
+func (f *ListsService) GetList(listID string, opt *tmdb.ListOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.List, r1 *http.Response, r2 error) {
+	f.record("GetList", listID, opt, options)
+	if f.GetListFunc == nil {
+		r2 = notImplemented("ListsService.GetList")
+		return
+	}
+	return f.GetListFunc(listID, opt, options...)
+}
+
+// GetItemStatus calls GetItemStatusFunc.
+func (f *ListsService) GetItemStatus(listID string, movieID int, options ...tmdb.RequestOptionFn) (r0 *tmdb.ItemStatus, r1 *http.Response, r2 error) {
+	f.record("GetItemStatus", listID, movieID, options)
+	if f.GetItemStatusFunc == nil {
+		r2 = notImplemented("ListsService.GetItemStatus")
+		return
+	}
+	return f.GetItemStatusFunc(listID, movieID, options...)
+}
+
+// CreateList calls CreateListFunc.
+func (f *ListsService) CreateList(sessionID string, list tmdb.CreateList, options ...tmdb.RequestOptionFn) (r0 *tmdb.CreateListResponse, r1 *http.Response, r2 error) {
+	f.record("CreateList", sessionID, list, options)
+	if f.CreateListFunc == nil {
+		r2 = notImplemented("ListsService.CreateList")
+		return
+	}
+	return f.CreateListFunc(sessionID, list, options...)
+}
+
+// AddMovie calls AddMovieFunc.
+func (f *ListsService) AddMovie(sessionID string, listID string, itemID int, options ...tmdb.RequestOptionFn) (r0 *tmdb.AddItemResponse, r1 *http.Response, r2 error) {
+	f.record("AddMovie", sessionID, listID, itemID, options)
+	if f.AddMovieFunc == nil {
+		r2 = notImplemented("ListsService.AddMovie")
+		return
+	}
+	return f.AddMovieFunc(sessionID, listID, itemID, options...)
+}
+
+// RemoveMovie calls RemoveMovieFunc.
+func (f *ListsService) RemoveMovie(sessionID string, listID string, itemID int, options ...tmdb.RequestOptionFn) (r0 *tmdb.RemoveItemResponse, r1 *http.Response, r2 error) {
+	f.record("RemoveMovie", sessionID, listID, itemID, options)
+	if f.RemoveMovieFunc == nil {
+		r2 = notImplemented("ListsService.RemoveMovie")
+		return
+	}
+	return f.RemoveMovieFunc(sessionID, listID, itemID, options...)
+}
+
+// Clear calls ClearFunc.
+func (f *ListsService) Clear(sessionID string, listID string, options ...tmdb.RequestOptionFn) (r0 *tmdb.ClearListResponse, r1 *http.Response, r2 error) {
+	f.record("Clear", sessionID, listID, options)
+	if f.ClearFunc == nil {
+		r2 = notImplemented("ListsService.Clear")
+		return
+	}
+	return f.ClearFunc(sessionID, listID, options...)
+}
+
+// Delete calls DeleteFunc.
+func (f *ListsService) Delete(sessionID string, listID string, options ...tmdb.RequestOptionFn) (r0 *tmdb.DeleteListResponse, r1 *http.Response, r2 error) {
+	f.record("Delete", sessionID, listID, options)
+	if f.DeleteFunc == nil {
+		r2 = notImplemented("ListsService.Delete")
+		return
+	}
+	return f.DeleteFunc(sessionID, listID, options...)
+}
+
+// MoviesService is a fake tmdb.MoviesService.
+// Each method records its call and calls the function of the same name with the Func suffix,
+// or returns ErrNotImplemented if it is not set.
+type MoviesService struct {
+	calls
+
+	GetManyFunc              func(ctx context.Context, movieIDs []int, opt *tmdb.MovieDetailsOptions, batch tmdb.BatchOptions, options ...tmdb.RequestOptionFn) ([]tmdb.BatchResult[*tmdb.MovieDetails], error)
+	StreamManyFunc           func(ctx context.Context, movieIDs []int, opt *tmdb.MovieDetailsOptions, batch tmdb.BatchOptions, options ...tmdb.RequestOptionFn) (<-chan tmdb.BatchResult[*tmdb.MovieDetails], error)
+	GetMovieFunc             func(movieID int, opt *tmdb.MovieDetailsOptions, options ...tmdb.RequestOptionFn) (*tmdb.MovieDetails, *http.Response, error)
+	GetMoviesChangesFunc     func(opt *tmdb.ChangesOptions, options ...tmdb.RequestOptionFn) (*tmdb.MediaChanges, *http.Response, error)
+	GetLatestFunc            func(opt *tmdb.LatestOptions, options ...tmdb.RequestOptionFn) (*tmdb.LatestMovie, *http.Response, error)
+	GetNowPlayingFunc        func(opt *tmdb.NowPlayingMoviesOptions, options ...tmdb.RequestOptionFn) (*tmdb.NowPlayingMovies, *http.Response, error)
+	GetPopularFunc           func(opt *tmdb.PopularMoviesOptions, options ...tmdb.RequestOptionFn) (*tmdb.PopularMovies, *http.Response, error)
+	GetTopRatedFunc          func(opt *tmdb.TopRatedMoviesOptions, options ...tmdb.RequestOptionFn) (*tmdb.TopRatedMovies, *http.Response, error)
+	GetUpcomingFunc          func(opt *tmdb.UpcomingMoviesOptions, options ...tmdb.RequestOptionFn) (*tmdb.UpcomingMovies, *http.Response, error)
+	GetAccountStatesFunc     func(movieID int, sessionID string, options ...tmdb.RequestOptionFn) (*tmdb.AccountStates, *http.Response, error)
+	RateFunc                 func(movieID int, rating float64, sessionID tmdb.Auth, options ...tmdb.RequestOptionFn) (*tmdb.RateResponse, *http.Response, error)
+	DeleteRatingFunc         func(movieID int, sessionID tmdb.Auth, options ...tmdb.RequestOptionFn) (*tmdb.DeleteRatingResponse, *http.Response, error)
+	GetAlternativeTitlesFunc func(movieID int, opt *tmdb.MovieAlternativeTitlesOptions, options ...tmdb.RequestOptionFn) (*tmdb.AlternativeMovieTitles, *http.Response, error)
+	GetChangesFunc           func(movieID int, opt *tmdb.ChangesOptions, options ...tmdb.RequestOptionFn) (*tmdb.Changes, *http.Response, error)
+	GetCreditsFunc           func(movieID int, opt *tmdb.CreditsOptions, options ...tmdb.RequestOptionFn) (*tmdb.MovieCredits, *http.Response, error)
+	GetExternalIDsFunc       func(movieID int, options ...tmdb.RequestOptionFn) (*tmdb.MovieExternalIDs, *http.Response, error)
+	GetImagesFunc            func(movieID int, opt *tmdb.ImagesOptions, options ...tmdb.RequestOptionFn) (*tmdb.Images, *http.Response, error)
+	GetKeywordsFunc          func(movieID int, options ...tmdb.RequestOptionFn) (*tmdb.MovieKeywords, *http.Response, error)
+	GetListsFunc             func(movieID int, opt *tmdb.MoviesOptions, options ...tmdb.RequestOptionFn) (*tmdb.MovieLists, *http.Response, error)
+	GetRecommendationsFunc   func(movieID int, opt *tmdb.MoviesOptions, options ...tmdb.RequestOptionFn) (*tmdb.RecommendedMovies, *http.Response, error)
+	GetReleaseDatesFunc      func(movieID int, options ...tmdb.RequestOptionFn) (*tmdb.MovieReleaseDates, *http.Response, error)
+	GetReviewsFunc           func(movieID int, opt *tmdb.MoviesOptions, options ...tmdb.RequestOptionFn) (*tmdb.MovieReviews, *http.Response, error)
+	GetSimilarFunc           func(movieID int, opt *tmdb.MoviesOptions, options ...tmdb.RequestOptionFn) (*tmdb.SimilarMovies, *http.Response, error)
+	GetTranslationsFunc      func(movieID int, options ...tmdb.RequestOptionFn) (*tmdb.MovieTranslations, *http.Response, error)
+	GetVideosFunc            func(movieID int, opt *tmdb.VideosOptions, options ...tmdb.RequestOptionFn) (*tmdb.Videos, *http.Response, error)
+	GetWatchProvidersFunc    func(movieID int, options ...tmdb.RequestOptionFn) (*tmdb.WatchProviders, *http.Response, error)
+}
+
+var _ tmdb.MoviesService = (*MoviesService)(nil)
+
+// GetMany calls GetManyFunc.
+func (f *MoviesService) GetMany(ctx context.Context, movieIDs []int, opt *tmdb.MovieDetailsOptions, batch tmdb.BatchOptions, options ...tmdb.RequestOptionFn) (r0 []tmdb.BatchResult[*tmdb.MovieDetails], r1 error) {
+	f.record("GetMany", ctx, movieIDs, opt, batch, options)
+	if f.GetManyFunc == nil {
+		r1 = notImplemented("MoviesService.GetMany")
+		return
+	}
+	return f.GetManyFunc(ctx, movieIDs, opt, batch, options...)
+}
+
+// StreamMany calls StreamManyFunc.
+func (f *MoviesService) StreamMany(ctx context.Context, movieIDs []int, opt *tmdb.MovieDetailsOptions, batch tmdb.BatchOptions, options ...tmdb.RequestOptionFn) (r0 <-chan tmdb.BatchResult[*tmdb.MovieDetails], r1 error) {
+	f.record("StreamMany", ctx, movieIDs, opt, batch, options)
+	if f.StreamManyFunc == nil {
+		r1 = notImplemented("MoviesService.StreamMany")
+		return
+	}
+	return f.StreamManyFunc(ctx, movieIDs, opt, batch, options...)
+}
+
+// GetMovie calls GetMovieFunc.
+func (f *MoviesService) GetMovie(movieID int, opt *tmdb.MovieDetailsOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.MovieDetails, r1 *http.Response, r2 error) {
+	f.record("GetMovie", movieID, opt, options)
+	if f.GetMovieFunc == nil {
+		r2 = notImplemented("MoviesService.GetMovie")
+		return
+	}
+	return f.GetMovieFunc(movieID, opt, options...)
+}
+
+// GetMoviesChanges calls GetMoviesChangesFunc.
+func (f *MoviesService) GetMoviesChanges(opt *tmdb.ChangesOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.MediaChanges, r1 *http.Response, r2 error) {
+	f.record("GetMoviesChanges", opt, options)
+	if f.GetMoviesChangesFunc == nil {
+		r2 = notImplemented("MoviesService.GetMoviesChanges")
+		return
+	}
+	return f.GetMoviesChangesFunc(opt, options...)
+}
+
+// GetLatest calls GetLatestFunc.
+func (f *MoviesService) GetLatest(opt *tmdb.LatestOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.LatestMovie, r1 *http.Response, r2 error) {
+	f.record("GetLatest", opt, options)
+	if f.GetLatestFunc == nil {
+		r2 = notImplemented("MoviesService.GetLatest")
+		return
+	}
+	return f.GetLatestFunc(opt, options...)
+}
+
+// GetNowPlaying calls GetNowPlayingFunc.
+func (f *MoviesService) GetNowPlaying(opt *tmdb.NowPlayingMoviesOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.NowPlayingMovies, r1 *http.Response, r2 error) {
+	f.record("GetNowPlaying", opt, options)
+	if f.GetNowPlayingFunc == nil {
+		r2 = notImplemented("MoviesService.GetNowPlaying")
+		return
+	}
+	return f.GetNowPlayingFunc(opt, options...)
+}
+
+// GetPopular calls GetPopularFunc.
+func (f *MoviesService) GetPopular(opt *tmdb.PopularMoviesOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.PopularMovies, r1 *http.Response, r2 error) {
+	f.record("GetPopular", opt, options)
+	if f.GetPopularFunc == nil {
+		r2 = notImplemented("MoviesService.GetPopular")
+		return
+	}
+	return f.GetPopularFunc(opt, options...)
+}
+
+// GetTopRated calls GetTopRatedFunc.
+func (f *MoviesService) GetTopRated(opt *tmdb.TopRatedMoviesOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.TopRatedMovies, r1 *http.Response, r2 error) {
+	f.record("GetTopRated", opt, options)
+	if f.GetTopRatedFunc == nil {
+		r2 = notImplemented("MoviesService.GetTopRated")
+		return
+	}
+	return f.GetTopRatedFunc(opt, options...)
+}
+
+// GetUpcoming calls GetUpcomingFunc.
+func (f *MoviesService) GetUpcoming(opt *tmdb.UpcomingMoviesOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.UpcomingMovies, r1 *http.Response, r2 error) {
+	f.record("GetUpcoming", opt, options)
+	if f.GetUpcomingFunc == nil {
+		r2 = notImplemented("MoviesService.GetUpcoming")
+		return
+	}
+	return f.GetUpcomingFunc(opt, options...)
+}
+
+// GetAccountStates calls GetAccountStatesFunc.
+func (f *MoviesService) GetAccountStates(movieID int, sessionID string, options ...tmdb.RequestOptionFn) (r0 *tmdb.AccountStates, r1 *http.Response, r2 error) {
+	f.record("GetAccountStates", movieID, sessionID, options)
+	if f.GetAccountStatesFunc == nil {
+		r2 = notImplemented("MoviesService.GetAccountStates")
+		return
+	}
+	return f.GetAccountStatesFunc(movieID, sessionID, options...)
+}
+
+// Rate calls RateFunc.
+func (f *MoviesService) Rate(movieID int, rating float64, sessionID tmdb.Auth, options ...tmdb.RequestOptionFn) (r0 *tmdb.RateResponse, r1 *http.Response, r2 error) {
+	f.record("Rate", movieID, rating, sessionID, options)
+	if f.RateFunc == nil {
+		r2 = notImplemented("MoviesService.Rate")
+		return
+	}
+	return f.RateFunc(movieID, rating, sessionID, options...)
+}
+
+// DeleteRating calls DeleteRatingFunc.
+func (f *MoviesService) DeleteRating(movieID int, sessionID tmdb.Auth, options ...tmdb.RequestOptionFn) (r0 *tmdb.DeleteRatingResponse, r1 *http.Response, r2 error) {
+	f.record("DeleteRating", movieID, sessionID, options)
+	if f.DeleteRatingFunc == nil {
+		r2 = notImplemented("MoviesService.DeleteRating")
+		return
+	}
+	return f.DeleteRatingFunc(movieID, sessionID, options...)
+}
+
+// GetAlternativeTitles calls GetAlternativeTitlesFunc.
+func (f *MoviesService) GetAlternativeTitles(movieID int, opt *tmdb.MovieAlternativeTitlesOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.AlternativeMovieTitles, r1 *http.Response, r2 error) {
+	f.record("GetAlternativeTitles", movieID, opt, options)
+	if f.GetAlternativeTitlesFunc == nil {
+		r2 = notImplemented("MoviesService.GetAlternativeTitles")
+		return
+	}
+	return f.GetAlternativeTitlesFunc(movieID, opt, options...)
+}
+
+// GetChanges calls GetChangesFunc.
+func (f *MoviesService) GetChanges(movieID int, opt *tmdb.ChangesOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.Changes, r1 *http.Response, r2 error) {
+	f.record("GetChanges", movieID, opt, options)
+	if f.GetChangesFunc == nil {
+		r2 = notImplemented("MoviesService.GetChanges")
+		return
+	}
+	return f.GetChangesFunc(movieID, opt, options...)
+}
+
+// GetCredits calls GetCreditsFunc.
+func (f *MoviesService) GetCredits(movieID int, opt *tmdb.CreditsOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.MovieCredits, r1 *http.Response, r2 error) {
+	f.record("GetCredits", movieID, opt, options)
+	if f.GetCreditsFunc == nil {
+		r2 = notImplemented("MoviesService.GetCredits")
+		return
+	}
+	return f.GetCreditsFunc(movieID, opt, options...)
+}
+
+// GetExternalIDs calls GetExternalIDsFunc.
+func (f *MoviesService) GetExternalIDs(movieID int, options ...tmdb.RequestOptionFn) (r0 *tmdb.MovieExternalIDs, r1 *http.Response, r2 error) {
+	f.record("GetExternalIDs", movieID, options)
+	if f.GetExternalIDsFunc == nil {
+		r2 = notImplemented("MoviesService.GetExternalIDs")
+		return
+	}
+	return f.GetExternalIDsFunc(movieID, options...)
+}
+
+// GetImages calls GetImagesFunc.
+func (f *MoviesService) GetImages(movieID int, opt *tmdb.ImagesOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.Images, r1 *http.Response, r2 error) {
+	f.record("GetImages", movieID, opt, options)
+	if f.GetImagesFunc == nil {
+		r2 = notImplemented("MoviesService.GetImages")
+		return
+	}
+	return f.GetImagesFunc(movieID, opt, options...)
+}
+
+// GetKeywords calls GetKeywordsFunc.
+func (f *MoviesService) GetKeywords(movieID int, options ...tmdb.RequestOptionFn) (r0 *tmdb.MovieKeywords, r1 *http.Response, r2 error) {
+	f.record("GetKeywords", movieID, options)
+	if f.GetKeywordsFunc == nil {
+		r2 = notImplemented("MoviesService.GetKeywords")
+		return
+	}
+	return f.GetKeywordsFunc(movieID, options...)
+}
+
+// GetLists calls GetListsFunc.
+func (f *MoviesService) GetLists(movieID int, opt *tmdb.MoviesOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.MovieLists, r1 *http.Response, r2 error) {
+	f.record("GetLists", movieID, opt, options)
+	if f.GetListsFunc == nil {
+		r2 = notImplemented("MoviesService.GetLists")
+		return
+	}
+	return f.GetListsFunc(movieID, opt, options...)
+}
+
+// GetRecommendations calls GetRecommendationsFunc.
+func (f *MoviesService) GetRecommendations(movieID int, opt *tmdb.MoviesOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.RecommendedMovies, r1 *http.Response, r2 error) {
+	f.record("GetRecommendations", movieID, opt, options)
+	if f.GetRecommendationsFunc == nil {
+		r2 = notImplemented("MoviesService.GetRecommendations")
+		return
+	}
+	return f.GetRecommendationsFunc(movieID, opt, options...)
+}
+
+// GetReleaseDates calls GetReleaseDatesFunc.
+func (f *MoviesService) GetReleaseDates(movieID int, options ...tmdb.RequestOptionFn) (r0 *tmdb.MovieReleaseDates, r1 *http.Response, r2 error) {
+	f.record("GetReleaseDates", movieID, options)
+	if f.GetReleaseDatesFunc == nil {
+		r2 = notImplemented("MoviesService.GetReleaseDates")
+		return
+	}
+	return f.GetReleaseDatesFunc(movieID, options...)
+}
+
+// GetReviews calls GetReviewsFunc.
+func (f *MoviesService) GetReviews(movieID int, opt *tmdb.MoviesOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.MovieReviews, r1 *http.Response, r2 error) {
+	f.record("GetReviews", movieID, opt, options)
+	if f.GetReviewsFunc == nil {
+		r2 = notImplemented("MoviesService.GetReviews")
+		return
+	}
+	return f.GetReviewsFunc(movieID, opt, options...)
+}
+
+// GetSimilar calls GetSimilarFunc.
+func (f *MoviesService) GetSimilar(movieID int, opt *tmdb.MoviesOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.SimilarMovies, r1 *http.Response, r2 error) {
+	f.record("GetSimilar", movieID, opt, options)
+	if f.GetSimilarFunc == nil {
+		r2 = notImplemented("MoviesService.GetSimilar")
+		return
+	}
+	return f.GetSimilarFunc(movieID, opt, options...)
+}
+
+// GetTranslations calls GetTranslationsFunc.
+func (f *MoviesService) GetTranslations(movieID int, options ...tmdb.RequestOptionFn) (r0 *tmdb.MovieTranslations, r1 *http.Response, r2 error) {
+	f.record("GetTranslations", movieID, options)
+	if f.GetTranslationsFunc == nil {
+		r2 = notImplemented("MoviesService.GetTranslations")
+		return
+	}
+	return f.GetTranslationsFunc(movieID, options...)
+}
+
+// GetVideos calls GetVideosFunc.
+func (f *MoviesService) GetVideos(movieID int, opt *tmdb.VideosOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.Videos, r1 *http.Response, r2 error) {
+	f.record("GetVideos", movieID, opt, options)
+	if f.GetVideosFunc == nil {
+		r2 = notImplemented("MoviesService.GetVideos")
+		return
+	}
+	return f.GetVideosFunc(movieID, opt, options...)
+}
+
+// GetWatchProviders calls GetWatchProvidersFunc.
+func (f *MoviesService) GetWatchProviders(movieID int, options ...tmdb.RequestOptionFn) (r0 *tmdb.WatchProviders, r1 *http.Response, r2 error) {
+	f.record("GetWatchProviders", movieID, options)
+	if f.GetWatchProvidersFunc == nil {
+		r2 = notImplemented("MoviesService.GetWatchProviders")
+		return
+	}
+	return f.GetWatchProvidersFunc(movieID, options...)
+}
+
+// NetworksService is a fake tmdb.NetworksService.
+// Each method records its call and calls the function of the same name with the Func suffix,
+// or returns ErrNotImplemented if it is not set.
+type NetworksService struct {
+	calls
+
+	GetNetworkFunc          func(id int, options ...tmdb.RequestOptionFn) (*tmdb.Network, *http.Response, error)
+	GetAlternativeNamesFunc func(id int, options ...tmdb.RequestOptionFn) (*tmdb.NetworkAlternativeNames, *http.Response, error)
+	GetImagesFunc           func(id int, options ...tmdb.RequestOptionFn) (*tmdb.NetworkImages, *http.Response, error)
+}
+
+var _ tmdb.NetworksService = (*NetworksService)(nil)
+
+// GetNetwork calls GetNetworkFunc.
+func (f *NetworksService) GetNetwork(id int, options ...tmdb.RequestOptionFn) (r0 *tmdb.Network, r1 *http.Response, r2 error) {
+	f.record("GetNetwork", id, options)
+	if f.GetNetworkFunc == nil {
+		r2 = notImplemented("NetworksService.GetNetwork")
+		return
+	}
+	return f.GetNetworkFunc(id, options...)
+}
+
+// GetAlternativeNames calls GetAlternativeNamesFunc.
+func (f *NetworksService) GetAlternativeNames(id int, options ...tmdb.RequestOptionFn) (r0 *tmdb.NetworkAlternativeNames, r1 *http.Response, r2 error) {
+	f.record("GetAlternativeNames", id, options)
+	if f.GetAlternativeNamesFunc == nil {
+		r2 = notImplemented("NetworksService.GetAlternativeNames")
+		return
+	}
+	return f.GetAlternativeNamesFunc(id, options...)
+}
+
+// GetImages calls GetImagesFunc.
+func (f *NetworksService) GetImages(id int, options ...tmdb.RequestOptionFn) (r0 *tmdb.NetworkImages, r1 *http.Response, r2 error) {
+	f.record("GetImages", id, options)
+	if f.GetImagesFunc == nil {
+		r2 = notImplemented("NetworksService.GetImages")
+		return
+	}
+	return f.GetImagesFunc(id, options...)
+}
+
+// PeopleService is a fake tmdb.PeopleService.
+// Each method records its call and calls the function of the same name with the Func suffix,
+// or returns ErrNotImplemented if it is not set.
+type PeopleService struct {
+	calls
+
+	GetManyFunc            func(ctx context.Context, personIDs []int, opt *tmdb.PersonDetailsOptions, batch tmdb.BatchOptions, options ...tmdb.RequestOptionFn) ([]tmdb.BatchResult[*tmdb.PersonDetails], error)
+	StreamManyFunc         func(ctx context.Context, personIDs []int, opt *tmdb.PersonDetailsOptions, batch tmdb.BatchOptions, options ...tmdb.RequestOptionFn) (<-chan tmdb.BatchResult[*tmdb.PersonDetails], error)
+	GetPersonFunc          func(personID int, opt *tmdb.PersonDetailsOptions, options ...tmdb.RequestOptionFn) (*tmdb.PersonDetails, *http.Response, error)
+	GetChangesFunc         func(personID int, opt *tmdb.ChangesOptions, options ...tmdb.RequestOptionFn) (*tmdb.Changes, *http.Response, error)
+	GetMovieCreditsFunc    func(personID int, opt *tmdb.CreditsOptions, options ...tmdb.RequestOptionFn) (*tmdb.PersonMovieCredits, *http.Response, error)
+	GetTVCreditsFunc       func(personID int, opt *tmdb.CreditsOptions, options ...tmdb.RequestOptionFn) (*tmdb.PersonTVShowCredits, *http.Response, error)
+	GetCombinedCreditsFunc func(personID int, opt *tmdb.CreditsOptions, options ...tmdb.RequestOptionFn) (*tmdb.CombinedCredits, *http.Response, error)
+	GetExternalIDsFunc     func(personID int, opt *tmdb.ExternalIDOptions, options ...tmdb.RequestOptionFn) (*tmdb.PersonExternalIDs, *http.Response, error)
+	GetImagesFunc          func(personID int, options ...tmdb.RequestOptionFn) (*tmdb.PersonImages, *http.Response, error)
+	GetTaggedImagesFunc    func(personID int, opt *tmdb.TaggedImagesOptions, options ...tmdb.RequestOptionFn) (*tmdb.TaggedImages, *http.Response, error)
+	GetTranslationsFunc    func(personID int, opt *tmdb.PersonTranslationsOptions, options ...tmdb.RequestOptionFn) (*tmdb.PersonTranslations, *http.Response, error)
+	GetLatestFunc          func(opt *tmdb.LatestPersonOptions, options ...tmdb.RequestOptionFn) (*tmdb.LatestPerson, *http.Response, error)
+	GetPopularFunc         func(opt *tmdb.PopularPeopleOptions, options ...tmdb.RequestOptionFn) (*tmdb.PopularPeople, *http.Response, error)
+	GetPeopleChangesFunc   func(opt *tmdb.ChangesOptions, options ...tmdb.RequestOptionFn) (*tmdb.MediaChanges, *http.Response, error)
+}
+
+var _ tmdb.PeopleService = (*PeopleService)(nil)
+
+// GetMany calls GetManyFunc.
+func (f *PeopleService) GetMany(ctx context.Context, personIDs []int, opt *tmdb.PersonDetailsOptions, batch tmdb.BatchOptions, options ...tmdb.RequestOptionFn) (r0 []tmdb.BatchResult[*tmdb.PersonDetails], r1 error) {
+	f.record("GetMany", ctx, personIDs, opt, batch, options)
+	if f.GetManyFunc == nil {
+		r1 = notImplemented("PeopleService.GetMany")
+		return
+	}
+	return f.GetManyFunc(ctx, personIDs, opt, batch, options...)
+}
+
+// StreamMany calls StreamManyFunc.
+func (f *PeopleService) StreamMany(ctx context.Context, personIDs []int, opt *tmdb.PersonDetailsOptions, batch tmdb.BatchOptions, options ...tmdb.RequestOptionFn) (r0 <-chan tmdb.BatchResult[*tmdb.PersonDetails], r1 error) {
+	f.record("StreamMany", ctx, personIDs, opt, batch, options)
+	if f.StreamManyFunc == nil {
+		r1 = notImplemented("PeopleService.StreamMany")
+		return
+	}
+	return f.StreamManyFunc(ctx, personIDs, opt, batch, options...)
+}
+
+// GetPerson calls GetPersonFunc.
+func (f *PeopleService) GetPerson(personID int, opt *tmdb.PersonDetailsOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.PersonDetails, r1 *http.Response, r2 error) {
+	f.record("GetPerson", personID, opt, options)
+	if f.GetPersonFunc == nil {
+		r2 = notImplemented("PeopleService.GetPerson")
+		return
+	}
+	return f.GetPersonFunc(personID, opt, options...)
+}
+
+// GetChanges calls GetChangesFunc.
+func (f *PeopleService) GetChanges(personID int, opt *tmdb.ChangesOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.Changes, r1 *http.Response, r2 error) {
+	f.record("GetChanges", personID, opt, options)
+	if f.GetChangesFunc == nil {
+		r2 = notImplemented("PeopleService.GetChanges")
+		return
+	}
+	return f.GetChangesFunc(personID, opt, options...)
+}
+
+// GetMovieCredits calls GetMovieCreditsFunc.
+func (f *PeopleService) GetMovieCredits(personID int, opt *tmdb.CreditsOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.PersonMovieCredits, r1 *http.Response, r2 error) {
+	f.record("GetMovieCredits", personID, opt, options)
+	if f.GetMovieCreditsFunc == nil {
+		r2 = notImplemented("PeopleService.GetMovieCredits")
+		return
+	}
+	return f.GetMovieCreditsFunc(personID, opt, options...)
+}
+
+// GetTVCredits calls GetTVCreditsFunc.
+func (f *PeopleService) GetTVCredits(personID int, opt *tmdb.CreditsOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.PersonTVShowCredits, r1 *http.Response, r2 error) {
+	f.record("GetTVCredits", personID, opt, options)
+	if f.GetTVCreditsFunc == nil {
+		r2 = notImplemented("PeopleService.GetTVCredits")
+		return
+	}
+	return f.GetTVCreditsFunc(personID, opt, options...)
+}
+
+// GetCombinedCredits calls GetCombinedCreditsFunc.
+func (f *PeopleService) GetCombinedCredits(personID int, opt *tmdb.CreditsOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.CombinedCredits, r1 *http.Response, r2 error) {
+	f.record("GetCombinedCredits", personID, opt, options)
+	if f.GetCombinedCreditsFunc == nil {
+		r2 = notImplemented("PeopleService.GetCombinedCredits")
+		return
+	}
+	return f.GetCombinedCreditsFunc(personID, opt, options...)
+}
+
+// GetExternalIDs calls GetExternalIDsFunc.
+func (f *PeopleService) GetExternalIDs(personID int, opt *tmdb.ExternalIDOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.PersonExternalIDs, r1 *http.Response, r2 error) {
+	f.record("GetExternalIDs", personID, opt, options)
+	if f.GetExternalIDsFunc == nil {
+		r2 = notImplemented("PeopleService.GetExternalIDs")
+		return
+	}
+	return f.GetExternalIDsFunc(personID, opt, options...)
+}
+
+// GetImages calls GetImagesFunc.
+func (f *PeopleService) GetImages(personID int, options ...tmdb.RequestOptionFn) (r0 *tmdb.PersonImages, r1 *http.Response, r2 error) {
+	f.record("GetImages", personID, options)
+	if f.GetImagesFunc == nil {
+		r2 = notImplemented("PeopleService.GetImages")
+		return
+	}
+	return f.GetImagesFunc(personID, options...)
+}
+
+// GetTaggedImages calls GetTaggedImagesFunc.
+func (f *PeopleService) GetTaggedImages(personID int, opt *tmdb.TaggedImagesOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.TaggedImages, r1 *http.Response, r2 error) {
+	f.record("GetTaggedImages", personID, opt, options)
+	if f.GetTaggedImagesFunc == nil {
+		r2 = notImplemented("PeopleService.GetTaggedImages")
+		return
+	}
+	return f.GetTaggedImagesFunc(personID, opt, options...)
+}
+
+// GetTranslations calls GetTranslationsFunc.
+func (f *PeopleService) GetTranslations(personID int, opt *tmdb.PersonTranslationsOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.PersonTranslations, r1 *http.Response, r2 error) {
+	f.record("GetTranslations", personID, opt, options)
+	if f.GetTranslationsFunc == nil {
+		r2 = notImplemented("PeopleService.GetTranslations")
+		return
+	}
+	return f.GetTranslationsFunc(personID, opt, options...)
+}
+
+// GetLatest calls GetLatestFunc.
+func (f *PeopleService) GetLatest(opt *tmdb.LatestPersonOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.LatestPerson, r1 *http.Response, r2 error) {
+	f.record("GetLatest", opt, options)
+	if f.GetLatestFunc == nil {
+		r2 = notImplemented("PeopleService.GetLatest")
+		return
+	}
+	return f.GetLatestFunc(opt, options...)
+}
+
+// GetPopular calls GetPopularFunc.
+func (f *PeopleService) GetPopular(opt *tmdb.PopularPeopleOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.PopularPeople, r1 *http.Response, r2 error) {
+	f.record("GetPopular", opt, options)
+	if f.GetPopularFunc == nil {
+		r2 = notImplemented("PeopleService.GetPopular")
+		return
+	}
+	return f.GetPopularFunc(opt, options...)
+}
+
+// GetPeopleChanges calls GetPeopleChangesFunc.
+func (f *PeopleService) GetPeopleChanges(opt *tmdb.ChangesOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.MediaChanges, r1 *http.Response, r2 error) {
+	f.record("GetPeopleChanges", opt, options)
+	if f.GetPeopleChangesFunc == nil {
+		r2 = notImplemented("PeopleService.GetPeopleChanges")
+		return
+	}
+	return f.GetPeopleChangesFunc(opt, options...)
+}
+
+// ReviewsService is a fake tmdb.ReviewsService.
+// Each method records its call and calls the function of the same name with the Func suffix,
+// or returns ErrNotImplemented if it is not set.
+type ReviewsService struct {
+	calls
+
+	GetReviewFunc func(id string, options ...tmdb.RequestOptionFn) (*tmdb.ReviewDetails, *http.Response, error)
+}
+
+var _ tmdb.ReviewsService = (*ReviewsService)(nil)
+
+// GetReview calls GetReviewFunc.
+func (f *ReviewsService) GetReview(id string, options ...tmdb.RequestOptionFn) (r0 *tmdb.ReviewDetails, r1 *http.Response, r2 error) {
+	f.record("GetReview", id, options)
+	if f.GetReviewFunc == nil {
+		r2 = notImplemented("ReviewsService.GetReview")
+		return
+	}
+	return f.GetReviewFunc(id, options...)
+}
+
+// SearchService is a fake tmdb.SearchService.
+// Each method records its call and calls the function of the same name with the Func suffix,
+// or returns ErrNotImplemented if it is not set.
+type SearchService struct {
+	calls
+
+	CompaniesFunc   func(query string, opt *tmdb.SearchCompaniesOptions, options ...tmdb.RequestOptionFn) (*tmdb.SearchCompanies, *http.Response, error)
+	CollectionsFunc func(query string, opt *tmdb.SearchCollectionsOptions, options ...tmdb.RequestOptionFn) (*tmdb.SearchCollections, *http.Response, error)
+	KeywordsFunc    func(query string, opt *tmdb.SearchKeywordsOptions, options ...tmdb.RequestOptionFn) (*tmdb.SearchKeywords, *http.Response, error)
+	MoviesFunc      func(query string, opt *tmdb.SearchMoviesOptions, options ...tmdb.RequestOptionFn) (*tmdb.SearchMovies, *http.Response, error)
+	PeopleFunc      func(query string, opt *tmdb.SearchPeopleOptions, options ...tmdb.RequestOptionFn) (*tmdb.SearchPeople, *http.Response, error)
+	TVShowsFunc     func(query string, opt *tmdb.SearchTVShowsOptions, options ...tmdb.RequestOptionFn) (*tmdb.SearchTVShows, *http.Response, error)
+	MultiFunc       func(query string, opt *tmdb.SearchTVShowsOptions, options ...tmdb.RequestOptionFn) (*tmdb.SearchMulti, *http.Response, error)
+}
+
+var _ tmdb.SearchService = (*SearchService)(nil)
+
+// Companies calls CompaniesFunc.
+func (f *SearchService) Companies(query string, opt *tmdb.SearchCompaniesOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.SearchCompanies, r1 *http.Response, r2 error) {
+	f.record("Companies", query, opt, options)
+	if f.CompaniesFunc == nil {
+		r2 = notImplemented("SearchService.Companies")
+		return
+	}
+	return f.CompaniesFunc(query, opt, options...)
+}
+
+// Collections calls CollectionsFunc.
+func (f *SearchService) Collections(query string, opt *tmdb.SearchCollectionsOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.SearchCollections, r1 *http.Response, r2 error) {
+	f.record("Collections", query, opt, options)
+	if f.CollectionsFunc == nil {
+		r2 = notImplemented("SearchService.Collections")
+		return
+	}
+	return f.CollectionsFunc(query, opt, options...)
+}
+
+// Keywords calls KeywordsFunc.
+func (f *SearchService) Keywords(query string, opt *tmdb.SearchKeywordsOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.SearchKeywords, r1 *http.Response, r2 error) {
+	f.record("Keywords", query, opt, options)
+	if f.KeywordsFunc == nil {
+		r2 = notImplemented("SearchService.Keywords")
+		return
+	}
+	return f.KeywordsFunc(query, opt, options...)
+}
+
+// Movies calls MoviesFunc.
+func (f *SearchService) Movies(query string, opt *tmdb.SearchMoviesOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.SearchMovies, r1 *http.Response, r2 error) {
+	f.record("Movies", query, opt, options)
+	if f.MoviesFunc == nil {
+		r2 = notImplemented("SearchService.Movies")
+		return
+	}
+	return f.MoviesFunc(query, opt, options...)
+}
+
+// People calls PeopleFunc.
+func (f *SearchService) People(query string, opt *tmdb.SearchPeopleOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.SearchPeople, r1 *http.Response, r2 error) {
+	f.record("People", query, opt, options)
+	if f.PeopleFunc == nil {
+		r2 = notImplemented("SearchService.People")
+		return
+	}
+	return f.PeopleFunc(query, opt, options...)
+}
+
+// TVShows calls TVShowsFunc.
+func (f *SearchService) TVShows(query string, opt *tmdb.SearchTVShowsOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.SearchTVShows, r1 *http.Response, r2 error) {
+	f.record("TVShows", query, opt, options)
+	if f.TVShowsFunc == nil {
+		r2 = notImplemented("SearchService.TVShows")
+		return
+	}
+	return f.TVShowsFunc(query, opt, options...)
+}
+
+// Multi calls MultiFunc.
+func (f *SearchService) Multi(query string, opt *tmdb.SearchTVShowsOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.SearchMulti, r1 *http.Response, r2 error) {
+	f.record("Multi", query, opt, options)
+	if f.MultiFunc == nil {
+		r2 = notImplemented("SearchService.Multi")
+		return
+	}
+	return f.MultiFunc(query, opt, options...)
+}
+
+// TrendingService is a fake tmdb.TrendingService.
+// Each method records its call and calls the function of the same name with the Func suffix,
+// or returns ErrNotImplemented if it is not set.
+type TrendingService struct {
+	calls
+
+	GetTrendingMoviesFunc  func(timeWindow tmdb.TimeWindow, options ...tmdb.RequestOptionFn) (*tmdb.TrendingMovies, *http.Response, error)
+	GetTrendingTVShowsFunc func(timeWindow tmdb.TimeWindow, options ...tmdb.RequestOptionFn) (*tmdb.TrendingTVShows, *http.Response, error)
+	GetTrendingPeopleFunc  func(timeWindow tmdb.TimeWindow, options ...tmdb.RequestOptionFn) (*tmdb.TrendingPeople, *http.Response, error)
+	GetTrendingFunc        func(timeWindow tmdb.TimeWindow, options ...tmdb.RequestOptionFn) (*tmdb.Trending, *http.Response, error)
+}
+
+var _ tmdb.TrendingService = (*TrendingService)(nil)
+
+// GetTrendingMovies calls GetTrendingMoviesFunc.
+func (f *TrendingService) GetTrendingMovies(timeWindow tmdb.TimeWindow, options ...tmdb.RequestOptionFn) (r0 *tmdb.TrendingMovies, r1 *http.Response, r2 error) {
+	f.record("GetTrendingMovies", timeWindow, options)
+	if f.GetTrendingMoviesFunc == nil {
+		r2 = notImplemented("TrendingService.GetTrendingMovies")
+		return
+	}
+	return f.GetTrendingMoviesFunc(timeWindow, options...)
+}
+
+// GetTrendingTVShows calls GetTrendingTVShowsFunc.
+func (f *TrendingService) GetTrendingTVShows(timeWindow tmdb.TimeWindow, options ...tmdb.RequestOptionFn) (r0 *tmdb.TrendingTVShows, r1 *http.Response, r2 error) {
+	f.record("GetTrendingTVShows", timeWindow, options)
+	if f.GetTrendingTVShowsFunc == nil {
+		r2 = notImplemented("TrendingService.GetTrendingTVShows")
+		return
+	}
+	return f.GetTrendingTVShowsFunc(timeWindow, options...)
+}
+
+// GetTrendingPeople calls GetTrendingPeopleFunc.
+func (f *TrendingService) GetTrendingPeople(timeWindow tmdb.TimeWindow, options ...tmdb.RequestOptionFn) (r0 *tmdb.TrendingPeople, r1 *http.Response, r2 error) {
+	f.record("GetTrendingPeople", timeWindow, options)
+	if f.GetTrendingPeopleFunc == nil {
+		r2 = notImplemented("TrendingService.GetTrendingPeople")
+		return
+	}
+	return f.GetTrendingPeopleFunc(timeWindow, options...)
+}
+
+// GetTrending calls GetTrendingFunc.
+func (f *TrendingService) GetTrending(timeWindow tmdb.TimeWindow, options ...tmdb.RequestOptionFn) (r0 *tmdb.Trending, r1 *http.Response, r2 error) {
+	f.record("GetTrending", timeWindow, options)
+	if f.GetTrendingFunc == nil {
+		r2 = notImplemented("TrendingService.GetTrending")
+		return
+	}
+	return f.GetTrendingFunc(timeWindow, options...)
+}
+
+// TVService is a fake tmdb.TVService.
+// Each method records its call and calls the function of the same name with the Func suffix,
+// or returns ErrNotImplemented if it is not set.
+type TVService struct {
+	calls
+
+	GetManyFunc                 func(ctx context.Context, tvIDs []int, opt *tmdb.TVShowDetailsOptions, batch tmdb.BatchOptions, options ...tmdb.RequestOptionFn) ([]tmdb.BatchResult[*tmdb.TVShowDetails], error)
+	StreamManyFunc              func(ctx context.Context, tvIDs []int, opt *tmdb.TVShowDetailsOptions, batch tmdb.BatchOptions, options ...tmdb.RequestOptionFn) (<-chan tmdb.BatchResult[*tmdb.TVShowDetails], error)
+	GetTVShowFunc               func(tvID int, opt *tmdb.TVShowDetailsOptions, options ...tmdb.RequestOptionFn) (*tmdb.TVShowDetails, *http.Response, error)
+	GetAccountStatesFunc        func(tvID int, sessionID string, options ...tmdb.RequestOptionFn) (*tmdb.AccountStates, *http.Response, error)
+	GetAggregateCreditsFunc     func(tvID int, opt *tmdb.AggregateCreditsOptions, options ...tmdb.RequestOptionFn) (*tmdb.AggregateCredits, *http.Response, error)
+	GetAlternativeTitlesFunc    func(tvID int, opt *tmdb.TVShowAlternativeTitlesOptions, options ...tmdb.RequestOptionFn) (*tmdb.TVShowAlternativeTitles, *http.Response, error)
+	GetChangesFunc              func(tvID int, opt *tmdb.ChangesOptions, options ...tmdb.RequestOptionFn) (*tmdb.Changes, *http.Response, error)
+	GetContentRatingsFunc       func(tvID int, opt *tmdb.ContentRatingsOptions, options ...tmdb.RequestOptionFn) (*tmdb.ContentRatings, *http.Response, error)
+	GetCreditsFunc              func(tvID int, opt *tmdb.CreditsOptions, options ...tmdb.RequestOptionFn) (*tmdb.TVShowCredits, *http.Response, error)
+	GetEpisodeGroupsFunc        func(tvID int, opt *tmdb.EpisodeGroupsOptions, options ...tmdb.RequestOptionFn) (*tmdb.EpisodeGroups, *http.Response, error)
+	GetExternalIDsFunc          func(tvID int, opt *tmdb.ExternalIDsOptions, options ...tmdb.RequestOptionFn) (*tmdb.TVShowExternalIDs, *http.Response, error)
+	GetImagesFunc               func(tvID int, opt *tmdb.ImagesOptions, options ...tmdb.RequestOptionFn) (*tmdb.Images, *http.Response, error)
+	GetKeywordsFunc             func(tvID int, options ...tmdb.RequestOptionFn) (*tmdb.TVShowKeywords, *http.Response, error)
+	GetRecommendationsFunc      func(tvID int, opt *tmdb.RecommendationsOptions, options ...tmdb.RequestOptionFn) (*tmdb.RecommendedTVShows, *http.Response, error)
+	GetReviewsFunc              func(tvID int, opt *tmdb.ReviewsOptions, options ...tmdb.RequestOptionFn) (*tmdb.TVShowReviews, *http.Response, error)
+	GetScreenedTheatricallyFunc func(tvID int, options ...tmdb.RequestOptionFn) (*tmdb.ScreenedTheatrically, *http.Response, error)
+	GetSimilarFunc              func(tvID int, opt *tmdb.SimilarTVShowsOptions, options ...tmdb.RequestOptionFn) (*tmdb.SimilarTVShows, *http.Response, error)
+	GetTranslationsFunc         func(tvID int, options ...tmdb.RequestOptionFn) (*tmdb.TVShowTranslations, *http.Response, error)
+	GetVideosFunc               func(tvID int, opt *tmdb.VideosOptions, options ...tmdb.RequestOptionFn) (*tmdb.Videos, *http.Response, error)
+	GetWatchProvidersFunc       func(tvID int, options ...tmdb.RequestOptionFn) (*tmdb.WatchProviders, *http.Response, error)
+	GetLatestFunc               func(opt *tmdb.LatestOptions, options ...tmdb.RequestOptionFn) (*tmdb.LatestTVShow, *http.Response, error)
+	GetAiringTodayFunc          func(opt *tmdb.TVShowsAiringOptions, options ...tmdb.RequestOptionFn) (*tmdb.TVShowsAiring, *http.Response, error)
+	GetTVShowsChangesFunc       func(opt *tmdb.ChangesOptions, options ...tmdb.RequestOptionFn) (*tmdb.MediaChanges, *http.Response, error)
+	GetOnTheAirFunc             func(opt *tmdb.TVShowsAiringOptions, options ...tmdb.RequestOptionFn) (*tmdb.TVShowsAiring, *http.Response, error)
+	GetPopularFunc              func(opt *tmdb.PopularTVShowsOptions, options ...tmdb.RequestOptionFn) (*tmdb.PopularTVShows, *http.Response, error)
+	GetTopRatedFunc             func(opt *tmdb.TopRatedTVShowOptions, options ...tmdb.RequestOptionFn) (*tmdb.TopRatedTVShows, *http.Response, error)
+	GetEpisodeGroupFunc         func(groupID string, opt *tmdb.EpisodeGroupOptions, options ...tmdb.RequestOptionFn) (*tmdb.EpisodeGroup, *http.Response, error)
+	RateFunc                    func(tvID int, rating float64, sessionID tmdb.Auth, options ...tmdb.RequestOptionFn) (*tmdb.RateResponse, *http.Response, error)
+	DeleteRatingFunc            func(movieID int, sessionID tmdb.Auth, options ...tmdb.RequestOptionFn) (*tmdb.DeleteRatingResponse, *http.Response, error)
+	GetFullShowFunc             func(tvID int, opt *tmdb.FullTVShowOptions, options ...tmdb.RequestOptionFn) (*tmdb.TVShowDetails, *http.Response, error)
+}
+
+var _ tmdb.TVService = (*TVService)(nil)
+
+// GetMany calls GetManyFunc.
+func (f *TVService) GetMany(ctx context.Context, tvIDs []int, opt *tmdb.TVShowDetailsOptions, batch tmdb.BatchOptions, options ...tmdb.RequestOptionFn) (r0 []tmdb.BatchResult[*tmdb.TVShowDetails], r1 error) {
+	f.record("GetMany", ctx, tvIDs, opt, batch, options)
+	if f.GetManyFunc == nil {
+		r1 = notImplemented("TVService.GetMany")
+		return
+	}
+	return f.GetManyFunc(ctx, tvIDs, opt, batch, options...)
+}
+
+// StreamMany calls StreamManyFunc.
+func (f *TVService) StreamMany(ctx context.Context, tvIDs []int, opt *tmdb.TVShowDetailsOptions, batch tmdb.BatchOptions, options ...tmdb.RequestOptionFn) (r0 <-chan tmdb.BatchResult[*tmdb.TVShowDetails], r1 error) {
+	f.record("StreamMany", ctx, tvIDs, opt, batch, options)
+	if f.StreamManyFunc == nil {
+		r1 = notImplemented("TVService.StreamMany")
+		return
+	}
+	return f.StreamManyFunc(ctx, tvIDs, opt, batch, options...)
+}
+
+// GetTVShow calls GetTVShowFunc.
+func (f *TVService) GetTVShow(tvID int, opt *tmdb.TVShowDetailsOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.TVShowDetails, r1 *http.Response, r2 error) {
+	f.record("GetTVShow", tvID, opt, options)
+	if f.GetTVShowFunc == nil {
+		r2 = notImplemented("TVService.GetTVShow")
+		return
+	}
+	return f.GetTVShowFunc(tvID, opt, options...)
+}
+
+// GetAccountStates calls GetAccountStatesFunc.
+func (f *TVService) GetAccountStates(tvID int, sessionID string, options ...tmdb.RequestOptionFn) (r0 *tmdb.AccountStates, r1 *http.Response, r2 error) {
+	f.record("GetAccountStates", tvID, sessionID, options)
+	if f.GetAccountStatesFunc == nil {
+		r2 = notImplemented("TVService.GetAccountStates")
+		return
+	}
+	return f.GetAccountStatesFunc(tvID, sessionID, options...)
+}
+
+// GetAggregateCredits calls GetAggregateCreditsFunc.
+func (f *TVService) GetAggregateCredits(tvID int, opt *tmdb.AggregateCreditsOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.AggregateCredits, r1 *http.Response, r2 error) {
+	f.record("GetAggregateCredits", tvID, opt, options)
+	if f.GetAggregateCreditsFunc == nil {
+		r2 = notImplemented("TVService.GetAggregateCredits")
+		return
+	}
+	return f.GetAggregateCreditsFunc(tvID, opt, options...)
+}
+
+// GetAlternativeTitles calls GetAlternativeTitlesFunc.
+func (f *TVService) GetAlternativeTitles(tvID int, opt *tmdb.TVShowAlternativeTitlesOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.TVShowAlternativeTitles, r1 *http.Response, r2 error) {
+	f.record("GetAlternativeTitles", tvID, opt, options)
+	if f.GetAlternativeTitlesFunc == nil {
+		r2 = notImplemented("TVService.GetAlternativeTitles")
+		return
+	}
+	return f.GetAlternativeTitlesFunc(tvID, opt, options...)
+}
+
+// GetChanges calls GetChangesFunc.
+func (f *TVService) GetChanges(tvID int, opt *tmdb.ChangesOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.Changes, r1 *http.Response, r2 error) {
+	f.record("GetChanges", tvID, opt, options)
+	if f.GetChangesFunc == nil {
+		r2 = notImplemented("TVService.GetChanges")
+		return
+	}
+	return f.GetChangesFunc(tvID, opt, options...)
+}
+
+// GetContentRatings calls GetContentRatingsFunc.
+func (f *TVService) GetContentRatings(tvID int, opt *tmdb.ContentRatingsOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.ContentRatings, r1 *http.Response, r2 error) {
+	f.record("GetContentRatings", tvID, opt, options)
+	if f.GetContentRatingsFunc == nil {
+		r2 = notImplemented("TVService.GetContentRatings")
+		return
+	}
+	return f.GetContentRatingsFunc(tvID, opt, options...)
+}
+
+// GetCredits calls GetCreditsFunc.
+func (f *TVService) GetCredits(tvID int, opt *tmdb.CreditsOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.TVShowCredits, r1 *http.Response, r2 error) {
+	f.record("GetCredits", tvID, opt, options)
+	if f.GetCreditsFunc == nil {
+		r2 = notImplemented("TVService.GetCredits")
+		return
+	}
+	return f.GetCreditsFunc(tvID, opt, options...)
+}
+
+// GetEpisodeGroups calls GetEpisodeGroupsFunc.
+func (f *TVService) GetEpisodeGroups(tvID int, opt *tmdb.EpisodeGroupsOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.EpisodeGroups, r1 *http.Response, r2 error) {
+	f.record("GetEpisodeGroups", tvID, opt, options)
+	if f.GetEpisodeGroupsFunc == nil {
+		r2 = notImplemented("TVService.GetEpisodeGroups")
+		return
+	}
+	return f.GetEpisodeGroupsFunc(tvID, opt, options...)
+}
+
+// GetExternalIDs calls GetExternalIDsFunc.
+func (f *TVService) GetExternalIDs(tvID int, opt *tmdb.ExternalIDsOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.TVShowExternalIDs, r1 *http.Response, r2 error) {
+	f.record("GetExternalIDs", tvID, opt, options)
+	if f.GetExternalIDsFunc == nil {
+		r2 = notImplemented("TVService.GetExternalIDs")
+		return
+	}
+	return f.GetExternalIDsFunc(tvID, opt, options...)
+}
+
+// GetImages calls GetImagesFunc.
+func (f *TVService) GetImages(tvID int, opt *tmdb.ImagesOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.Images, r1 *http.Response, r2 error) {
+	f.record("GetImages", tvID, opt, options)
+	if f.GetImagesFunc == nil {
+		r2 = notImplemented("TVService.GetImages")
+		return
+	}
+	return f.GetImagesFunc(tvID, opt, options...)
+}
+
+// GetKeywords calls GetKeywordsFunc.
+func (f *TVService) GetKeywords(tvID int, options ...tmdb.RequestOptionFn) (r0 *tmdb.TVShowKeywords, r1 *http.Response, r2 error) {
+	f.record("GetKeywords", tvID, options)
+	if f.GetKeywordsFunc == nil {
+		r2 = notImplemented("TVService.GetKeywords")
+		return
+	}
+	return f.GetKeywordsFunc(tvID, options...)
+}
+
+// GetRecommendations calls GetRecommendationsFunc.
+func (f *TVService) GetRecommendations(tvID int, opt *tmdb.RecommendationsOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.RecommendedTVShows, r1 *http.Response, r2 error) {
+	f.record("GetRecommendations", tvID, opt, options)
+	if f.GetRecommendationsFunc == nil {
+		r2 = notImplemented("TVService.GetRecommendations")
+		return
+	}
+	return f.GetRecommendationsFunc(tvID, opt, options...)
+}
+
+// GetReviews calls GetReviewsFunc.
+func (f *TVService) GetReviews(tvID int, opt *tmdb.ReviewsOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.TVShowReviews, r1 *http.Response, r2 error) {
+	f.record("GetReviews", tvID, opt, options)
+	if f.GetReviewsFunc == nil {
+		r2 = notImplemented("TVService.GetReviews")
+		return
+	}
+	return f.GetReviewsFunc(tvID, opt, options...)
+}
+
+// GetScreenedTheatrically calls GetScreenedTheatricallyFunc.
+func (f *TVService) GetScreenedTheatrically(tvID int, options ...tmdb.RequestOptionFn) (r0 *tmdb.ScreenedTheatrically, r1 *http.Response, r2 error) {
+	f.record("GetScreenedTheatrically", tvID, options)
+	if f.GetScreenedTheatricallyFunc == nil {
+		r2 = notImplemented("TVService.GetScreenedTheatrically")
+		return
+	}
+	return f.GetScreenedTheatricallyFunc(tvID, options...)
+}
+
+// GetSimilar calls GetSimilarFunc.
+func (f *TVService) GetSimilar(tvID int, opt *tmdb.SimilarTVShowsOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.SimilarTVShows, r1 *http.Response, r2 error) {
+	f.record("GetSimilar", tvID, opt, options)
+	if f.GetSimilarFunc == nil {
+		r2 = notImplemented("TVService.GetSimilar")
+		return
+	}
+	return f.GetSimilarFunc(tvID, opt, options...)
+}
+
+// GetTranslations calls GetTranslationsFunc.
+func (f *TVService) GetTranslations(tvID int, options ...tmdb.RequestOptionFn) (r0 *tmdb.TVShowTranslations, r1 *http.Response, r2 error) {
+	f.record("GetTranslations", tvID, options)
+	if f.GetTranslationsFunc == nil {
+		r2 = notImplemented("TVService.GetTranslations")
+		return
+	}
+	return f.GetTranslationsFunc(tvID, options...)
+}
+
+// GetVideos calls GetVideosFunc.
+func (f *TVService) GetVideos(tvID int, opt *tmdb.VideosOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.Videos, r1 *http.Response, r2 error) {
+	f.record("GetVideos", tvID, opt, options)
+	if f.GetVideosFunc == nil {
+		r2 = notImplemented("TVService.GetVideos")
+		return
+	}
+	return f.GetVideosFunc(tvID, opt, options...)
+}
+
+// GetWatchProviders calls GetWatchProvidersFunc.
+func (f *TVService) GetWatchProviders(tvID int, options ...tmdb.RequestOptionFn) (r0 *tmdb.WatchProviders, r1 *http.Response, r2 error) {
+	f.record("GetWatchProviders", tvID, options)
+	if f.GetWatchProvidersFunc == nil {
+		r2 = notImplemented("TVService.GetWatchProviders")
+		return
+	}
+	return f.GetWatchProvidersFunc(tvID, options...)
+}
+
+// GetLatest calls GetLatestFunc.
+func (f *TVService) GetLatest(opt *tmdb.LatestOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.LatestTVShow, r1 *http.Response, r2 error) {
+	f.record("GetLatest", opt, options)
+	if f.GetLatestFunc == nil {
+		r2 = notImplemented("TVService.GetLatest")
+		return
+	}
+	return f.GetLatestFunc(opt, options...)
+}
+
+// GetAiringToday calls GetAiringTodayFunc.
+func (f *TVService) GetAiringToday(opt *tmdb.TVShowsAiringOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.TVShowsAiring, r1 *http.Response, r2 error) {
+	f.record("GetAiringToday", opt, options)
+	if f.GetAiringTodayFunc == nil {
+		r2 = notImplemented("TVService.GetAiringToday")
+		return
+	}
+	return f.GetAiringTodayFunc(opt, options...)
+}
+
+// GetTVShowsChanges calls GetTVShowsChangesFunc.
+func (f *TVService) GetTVShowsChanges(opt *tmdb.ChangesOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.MediaChanges, r1 *http.Response, r2 error) {
+	f.record("GetTVShowsChanges", opt, options)
+	if f.GetTVShowsChangesFunc == nil {
+		r2 = notImplemented("TVService.GetTVShowsChanges")
+		return
+	}
+	return f.GetTVShowsChangesFunc(opt, options...)
+}
+
+// GetOnTheAir calls GetOnTheAirFunc.
+func (f *TVService) GetOnTheAir(opt *tmdb.TVShowsAiringOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.TVShowsAiring, r1 *http.Response, r2 error) {
+	f.record("GetOnTheAir", opt, options)
+	if f.GetOnTheAirFunc == nil {
+		r2 = notImplemented("TVService.GetOnTheAir")
+		return
+	}
+	return f.GetOnTheAirFunc(opt, options...)
+}
+
+// GetPopular calls GetPopularFunc.
+func (f *TVService) GetPopular(opt *tmdb.PopularTVShowsOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.PopularTVShows, r1 *http.Response, r2 error) {
+	f.record("GetPopular", opt, options)
+	if f.GetPopularFunc == nil {
+		r2 = notImplemented("TVService.GetPopular")
+		return
+	}
+	return f.GetPopularFunc(opt, options...)
+}
+
+// GetTopRated calls GetTopRatedFunc.
+func (f *TVService) GetTopRated(opt *tmdb.TopRatedTVShowOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.TopRatedTVShows, r1 *http.Response, r2 error) {
+	f.record("GetTopRated", opt, options)
+	if f.GetTopRatedFunc == nil {
+		r2 = notImplemented("TVService.GetTopRated")
+		return
+	}
+	return f.GetTopRatedFunc(opt, options...)
+}
+
+// GetEpisodeGroup calls GetEpisodeGroupFunc.
+func (f *TVService) GetEpisodeGroup(groupID string, opt *tmdb.EpisodeGroupOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.EpisodeGroup, r1 *http.Response, r2 error) {
+	f.record("GetEpisodeGroup", groupID, opt, options)
+	if f.GetEpisodeGroupFunc == nil {
+		r2 = notImplemented("TVService.GetEpisodeGroup")
+		return
+	}
+	return f.GetEpisodeGroupFunc(groupID, opt, options...)
+}
+
+// Rate calls RateFunc.
+func (f *TVService) Rate(tvID int, rating float64, sessionID tmdb.Auth, options ...tmdb.RequestOptionFn) (r0 *tmdb.RateResponse, r1 *http.Response, r2 error) {
+	f.record("Rate", tvID, rating, sessionID, options)
+	if f.RateFunc == nil {
+		r2 = notImplemented("TVService.Rate")
+		return
+	}
+	return f.RateFunc(tvID, rating, sessionID, options...)
+}
+
+// DeleteRating calls DeleteRatingFunc.
+func (f *TVService) DeleteRating(movieID int, sessionID tmdb.Auth, options ...tmdb.RequestOptionFn) (r0 *tmdb.DeleteRatingResponse, r1 *http.Response, r2 error) {
+	f.record("DeleteRating", movieID, sessionID, options)
+	if f.DeleteRatingFunc == nil {
+		r2 = notImplemented("TVService.DeleteRating")
+		return
+	}
+	return f.DeleteRatingFunc(movieID, sessionID, options...)
+}
+
+// GetFullShow calls GetFullShowFunc.
+func (f *TVService) GetFullShow(tvID int, opt *tmdb.FullTVShowOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.TVShowDetails, r1 *http.Response, r2 error) {
+	f.record("GetFullShow", tvID, opt, options)
+	if f.GetFullShowFunc == nil {
+		r2 = notImplemented("TVService.GetFullShow")
+		return
+	}
+	return f.GetFullShowFunc(tvID, opt, options...)
+}
+
+// TVEpisodesService is a fake tmdb.TVEpisodesService.
+// Each method records its call and calls the function of the same name with the Func suffix,
+// or returns ErrNotImplemented if it is not set.
+type TVEpisodesService struct {
+	calls
+
+	GetEpisodeFunc       func(tvID, seasonNumber, episodeNumber int, opt *tmdb.TVEpisodeDetailsOptions, options ...tmdb.RequestOptionFn) (*tmdb.TVEpisodeDetails, *http.Response, error)
+	GetAccountStatesFunc func(tvID, seasonNumber, episodeNumber int, sessionID string, options ...tmdb.RequestOptionFn) (*tmdb.AccountStatesEpisode, *http.Response, error)
+	GetChangesFunc       func(episodeID int, opt *tmdb.ChangesOptions, options ...tmdb.RequestOptionFn) (*tmdb.Changes, *http.Response, error)
+	GetCreditsFunc       func(tvID, seasonNumber, episodeNumber int, opt *tmdb.CreditsOptions, options ...tmdb.RequestOptionFn) (*tmdb.TVEpisodeCredits, *http.Response, error)
+	GetExternalIDsFunc   func(tvID, seasonNumber, episodeNumber int, opt *tmdb.ExternalIDsOptions, options ...tmdb.RequestOptionFn) (*tmdb.TVEpisodeExternalIDs, *http.Response, error)
+	GetImagesFunc        func(tvID, seasonNumber, episodeNumber int, opt *tmdb.ImagesOptions, options ...tmdb.RequestOptionFn) (*tmdb.TVEpisodeImages, *http.Response, error)
+	GetTranslationsFunc  func(tvID, seasonNumber, episodeNumber int, options ...tmdb.RequestOptionFn) (*tmdb.TVEpisodeTranslations, *http.Response, error)
+	GetVideosFunc        func(tvID, seasonNumber, episodeNumber int, opt *tmdb.VideosOptions, options ...tmdb.RequestOptionFn) (*tmdb.Videos, *http.Response, error)
+	RateFunc             func(tvID, seasonNumber, episodeNumber int, rating float64, sessionID tmdb.Auth, options ...tmdb.RequestOptionFn) (*tmdb.RateResponse, *http.Response, error)
+	DeleteRatingFunc     func(tvID, seasonNumber, episodeNumber int, sessionID tmdb.Auth, options ...tmdb.RequestOptionFn) (*tmdb.DeleteRatingResponse, *http.Response, error)
+}
+
+var _ tmdb.TVEpisodesService = (*TVEpisodesService)(nil)
+
+// GetEpisode calls GetEpisodeFunc.
+func (f *TVEpisodesService) GetEpisode(tvID int, seasonNumber int, episodeNumber int, opt *tmdb.TVEpisodeDetailsOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.TVEpisodeDetails, r1 *http.Response, r2 error) {
+	f.record("GetEpisode", tvID, seasonNumber, episodeNumber, opt, options)
+	if f.GetEpisodeFunc == nil {
+		r2 = notImplemented("TVEpisodesService.GetEpisode")
+		return
+	}
+	return f.GetEpisodeFunc(tvID, seasonNumber, episodeNumber, opt, options...)
+}
+
+// GetAccountStates calls GetAccountStatesFunc.
+func (f *TVEpisodesService) GetAccountStates(tvID int, seasonNumber int, episodeNumber int, sessionID string, options ...tmdb.RequestOptionFn) (r0 *tmdb.AccountStatesEpisode, r1 *http.Response, r2 error) {
+	f.record("GetAccountStates", tvID, seasonNumber, episodeNumber, sessionID, options)
+	if f.GetAccountStatesFunc == nil {
+		r2 = notImplemented("TVEpisodesService.GetAccountStates")
+		return
+	}
+	return f.GetAccountStatesFunc(tvID, seasonNumber, episodeNumber, sessionID, options...)
+}
+
+// GetChanges calls GetChangesFunc.
+func (f *TVEpisodesService) GetChanges(episodeID int, opt *tmdb.ChangesOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.Changes, r1 *http.Response, r2 error) {
+	f.record("GetChanges", episodeID, opt, options)
+	if f.GetChangesFunc == nil {
+		r2 = notImplemented("TVEpisodesService.GetChanges")
+		return
+	}
+	return f.GetChangesFunc(episodeID, opt, options...)
+}
+
+// GetCredits calls GetCreditsFunc.
+func (f *TVEpisodesService) GetCredits(tvID int, seasonNumber int, episodeNumber int, opt *tmdb.CreditsOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.TVEpisodeCredits, r1 *http.Response, r2 error) {
+	f.record("GetCredits", tvID, seasonNumber, episodeNumber, opt, options)
+	if f.GetCreditsFunc == nil {
+		r2 = notImplemented("TVEpisodesService.GetCredits")
+		return
+	}
+	return f.GetCreditsFunc(tvID, seasonNumber, episodeNumber, opt, options...)
+}
+
+// GetExternalIDs calls GetExternalIDsFunc.
+func (f *TVEpisodesService) GetExternalIDs(tvID int, seasonNumber int, episodeNumber int, opt *tmdb.ExternalIDsOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.TVEpisodeExternalIDs, r1 *http.Response, r2 error) {
+	f.record("GetExternalIDs", tvID, seasonNumber, episodeNumber, opt, options)
+	if f.GetExternalIDsFunc == nil {
+		r2 = notImplemented("TVEpisodesService.GetExternalIDs")
+		return
+	}
+	return f.GetExternalIDsFunc(tvID, seasonNumber, episodeNumber, opt, options...)
+}
+
+// GetImages calls GetImagesFunc.
+func (f *TVEpisodesService) GetImages(tvID int, seasonNumber int, episodeNumber int, opt *tmdb.ImagesOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.TVEpisodeImages, r1 *http.Response, r2 error) {
+	f.record("GetImages", tvID, seasonNumber, episodeNumber, opt, options)
+	if f.GetImagesFunc == nil {
+		r2 = notImplemented("TVEpisodesService.GetImages")
+		return
+	}
+	return f.GetImagesFunc(tvID, seasonNumber, episodeNumber, opt, options...)
+}
+
+// GetTranslations calls GetTranslationsFunc.
+func (f *TVEpisodesService) GetTranslations(tvID int, seasonNumber int, episodeNumber int, options ...tmdb.RequestOptionFn) (r0 *tmdb.TVEpisodeTranslations, r1 *http.Response, r2 error) {
+	f.record("GetTranslations", tvID, seasonNumber, episodeNumber, options)
+	if f.GetTranslationsFunc == nil {
+		r2 = notImplemented("TVEpisodesService.GetTranslations")
+		return
+	}
+	return f.GetTranslationsFunc(tvID, seasonNumber, episodeNumber, options...)
+}
+
+// GetVideos calls GetVideosFunc.
+func (f *TVEpisodesService) GetVideos(tvID int, seasonNumber int, episodeNumber int, opt *tmdb.VideosOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.Videos, r1 *http.Response, r2 error) {
+	f.record("GetVideos", tvID, seasonNumber, episodeNumber, opt, options)
+	if f.GetVideosFunc == nil {
+		r2 = notImplemented("TVEpisodesService.GetVideos")
+		return
+	}
+	return f.GetVideosFunc(tvID, seasonNumber, episodeNumber, opt, options...)
+}
+
+// Rate calls RateFunc.
+func (f *TVEpisodesService) Rate(tvID int, seasonNumber int, episodeNumber int, rating float64, sessionID tmdb.Auth, options ...tmdb.RequestOptionFn) (r0 *tmdb.RateResponse, r1 *http.Response, r2 error) {
+	f.record("Rate", tvID, seasonNumber, episodeNumber, rating, sessionID, options)
+	if f.RateFunc == nil {
+		r2 = notImplemented("TVEpisodesService.Rate")
+		return
+	}
+	return f.RateFunc(tvID, seasonNumber, episodeNumber, rating, sessionID, options...)
+}
+
+// DeleteRating calls DeleteRatingFunc.
+func (f *TVEpisodesService) DeleteRating(tvID int, seasonNumber int, episodeNumber int, sessionID tmdb.Auth, options ...tmdb.RequestOptionFn) (r0 *tmdb.DeleteRatingResponse, r1 *http.Response, r2 error) {
+	f.record("DeleteRating", tvID, seasonNumber, episodeNumber, sessionID, options)
+	if f.DeleteRatingFunc == nil {
+		r2 = notImplemented("TVEpisodesService.DeleteRating")
+		return
+	}
+	return f.DeleteRatingFunc(tvID, seasonNumber, episodeNumber, sessionID, options...)
+}
+
+// TVSeasonsService is a fake tmdb.TVSeasonsService.
+// Each method records its call and calls the function of the same name with the Func suffix,
+// or returns ErrNotImplemented if it is not set.
+type TVSeasonsService struct {
+	calls
+
+	GetSeasonFunc           func(tvID, seasonNumber int, opt *tmdb.TVSeasonDetailsOptions, options ...tmdb.RequestOptionFn) (*tmdb.TVSeasonDetails, *http.Response, error)
+	GetAccountStatesFunc    func(tvID, seasonNumber int, sessionID string, options ...tmdb.RequestOptionFn) (*tmdb.AccountStatesSeason, *http.Response, error)
+	GetAggregateCreditsFunc func(tvID, seasonNumber int, opt *tmdb.AggregateCreditsOptions, options ...tmdb.RequestOptionFn) (*tmdb.AggregateCredits, *http.Response, error)
+	GetChangesFunc          func(seasonID int, opt *tmdb.ChangesOptions, options ...tmdb.RequestOptionFn) (*tmdb.Changes, *http.Response, error)
+	GetCreditsFunc          func(tvID, seasonNumber int, opt *tmdb.CreditsOptions, options ...tmdb.RequestOptionFn) (*tmdb.TVShowCredits, *http.Response, error)
+	GetExternalIDsFunc      func(tvID, seasonNumber int, opt *tmdb.ExternalIDsOptions, options ...tmdb.RequestOptionFn) (*tmdb.TVSeasonExternalIDs, *http.Response, error)
+	GetImagesFunc           func(tvID, seasonNumber int, opt *tmdb.ImagesOptions, options ...tmdb.RequestOptionFn) (*tmdb.TVSeasonImages, *http.Response, error)
+	GetTranslationsFunc     func(tvID, seasonNumber int, options ...tmdb.RequestOptionFn) (*tmdb.TVSeasonTranslations, *http.Response, error)
+	GetVideosFunc           func(tvID, seasonNumber int, opt *tmdb.VideosOptions, options ...tmdb.RequestOptionFn) (*tmdb.Videos, *http.Response, error)
+}
+
+var _ tmdb.TVSeasonsService = (*TVSeasonsService)(nil)
+
+// GetSeason calls GetSeasonFunc.
+func (f *TVSeasonsService) GetSeason(tvID int, seasonNumber int, opt *tmdb.TVSeasonDetailsOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.TVSeasonDetails, r1 *http.Response, r2 error) {
+	f.record("GetSeason", tvID, seasonNumber, opt, options)
+	if f.GetSeasonFunc == nil {
+		r2 = notImplemented("TVSeasonsService.GetSeason")
+		return
+	}
+	return f.GetSeasonFunc(tvID, seasonNumber, opt, options...)
+}
+
+// GetAccountStates calls GetAccountStatesFunc.
+func (f *TVSeasonsService) GetAccountStates(tvID int, seasonNumber int, sessionID string, options ...tmdb.RequestOptionFn) (r0 *tmdb.AccountStatesSeason, r1 *http.Response, r2 error) {
+	f.record("GetAccountStates", tvID, seasonNumber, sessionID, options)
+	if f.GetAccountStatesFunc == nil {
+		r2 = notImplemented("TVSeasonsService.GetAccountStates")
+		return
+	}
+	return f.GetAccountStatesFunc(tvID, seasonNumber, sessionID, options...)
+}
+
+// GetAggregateCredits calls GetAggregateCreditsFunc.
+func (f *TVSeasonsService) GetAggregateCredits(tvID int, seasonNumber int, opt *tmdb.AggregateCreditsOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.AggregateCredits, r1 *http.Response, r2 error) {
+	f.record("GetAggregateCredits", tvID, seasonNumber, opt, options)
+	if f.GetAggregateCreditsFunc == nil {
+		r2 = notImplemented("TVSeasonsService.GetAggregateCredits")
+		return
+	}
+	return f.GetAggregateCreditsFunc(tvID, seasonNumber, opt, options...)
+}
+
+// GetChanges calls GetChangesFunc.
+func (f *TVSeasonsService) GetChanges(seasonID int, opt *tmdb.ChangesOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.Changes, r1 *http.Response, r2 error) {
+	f.record("GetChanges", seasonID, opt, options)
+	if f.GetChangesFunc == nil {
+		r2 = notImplemented("TVSeasonsService.GetChanges")
+		return
+	}
+	return f.GetChangesFunc(seasonID, opt, options...)
+}
+
+// GetCredits calls GetCreditsFunc.
+func (f *TVSeasonsService) GetCredits(tvID int, seasonNumber int, opt *tmdb.CreditsOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.TVShowCredits, r1 *http.Response, r2 error) {
+	f.record("GetCredits", tvID, seasonNumber, opt, options)
+	if f.GetCreditsFunc == nil {
+		r2 = notImplemented("TVSeasonsService.GetCredits")
+		return
+	}
+	return f.GetCreditsFunc(tvID, seasonNumber, opt, options...)
+}
+
+// GetExternalIDs calls GetExternalIDsFunc.
+func (f *TVSeasonsService) GetExternalIDs(tvID int, seasonNumber int, opt *tmdb.ExternalIDsOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.TVSeasonExternalIDs, r1 *http.Response, r2 error) {
+	f.record("GetExternalIDs", tvID, seasonNumber, opt, options)
+	if f.GetExternalIDsFunc == nil {
+		r2 = notImplemented("TVSeasonsService.GetExternalIDs")
+		return
+	}
+	return f.GetExternalIDsFunc(tvID, seasonNumber, opt, options...)
+}
+
+// GetImages calls GetImagesFunc.
+func (f *TVSeasonsService) GetImages(tvID int, seasonNumber int, opt *tmdb.ImagesOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.TVSeasonImages, r1 *http.Response, r2 error) {
+	f.record("GetImages", tvID, seasonNumber, opt, options)
+	if f.GetImagesFunc == nil {
+		r2 = notImplemented("TVSeasonsService.GetImages")
+		return
+	}
+	return f.GetImagesFunc(tvID, seasonNumber, opt, options...)
+}
+
+// GetTranslations calls GetTranslationsFunc.
+func (f *TVSeasonsService) GetTranslations(tvID int, seasonNumber int, options ...tmdb.RequestOptionFn) (r0 *tmdb.TVSeasonTranslations, r1 *http.Response, r2 error) {
+	f.record("GetTranslations", tvID, seasonNumber, options)
+	if f.GetTranslationsFunc == nil {
+		r2 = notImplemented("TVSeasonsService.GetTranslations")
+		return
+	}
+	return f.GetTranslationsFunc(tvID, seasonNumber, options...)
+}
+
+// GetVideos calls GetVideosFunc.
+func (f *TVSeasonsService) GetVideos(tvID int, seasonNumber int, opt *tmdb.VideosOptions, options ...tmdb.RequestOptionFn) (r0 *tmdb.Videos, r1 *http.Response, r2 error) {
+	f.record("GetVideos", tvID, seasonNumber, opt, options)
+	if f.GetVideosFunc == nil {
+		r2 = notImplemented("TVSeasonsService.GetVideos")
+		return
+	}
+	return f.GetVideosFunc(tvID, seasonNumber, opt, options...)
+}
+
+// WatchProvidersService is a fake tmdb.WatchProvidersService.
+// Each method records its call and calls the function of the same name with the Func suffix,
+// or returns ErrNotImplemented if it is not set.
+type WatchProvidersService struct {
+	calls
+
+	GetMovieProvidersFunc  func(opt *tmdb.ProvidersOptions, options ...tmdb.RequestOptionFn) ([]tmdb.Provider, *http.Response, error)
+	GetTVProvidersFunc     func(opt *tmdb.ProvidersOptions, options ...tmdb.RequestOptionFn) ([]tmdb.Provider, *http.Response, error)
+	GetProviderRegionsFunc func(opt *tmdb.ProviderRegionsOptions, options ...tmdb.RequestOptionFn) ([]tmdb.ProviderRegion, *http.Response, error)
+}
+
+var _ tmdb.WatchProvidersService = (*WatchProvidersService)(nil)
+
+// GetMovieProviders calls GetMovieProvidersFunc.
+func (f *WatchProvidersService) GetMovieProviders(opt *tmdb.ProvidersOptions, options ...tmdb.RequestOptionFn) (r0 []tmdb.Provider, r1 *http.Response, r2 error) {
+	f.record("GetMovieProviders", opt, options)
+	if f.GetMovieProvidersFunc == nil {
+		r2 = notImplemented("WatchProvidersService.GetMovieProviders")
+		return
+	}
+	return f.GetMovieProvidersFunc(opt, options...)
+}
+
+// GetTVProviders calls GetTVProvidersFunc.
+func (f *WatchProvidersService) GetTVProviders(opt *tmdb.ProvidersOptions, options ...tmdb.RequestOptionFn) (r0 []tmdb.Provider, r1 *http.Response, r2 error) {
+	f.record("GetTVProviders", opt, options)
+	if f.GetTVProvidersFunc == nil {
+		r2 = notImplemented("WatchProvidersService.GetTVProviders")
+		return
+	}
+	return f.GetTVProvidersFunc(opt, options...)
+}
+
+// GetProviderRegions calls GetProviderRegionsFunc.
+func (f *WatchProvidersService) GetProviderRegions(opt *tmdb.ProviderRegionsOptions, options ...tmdb.RequestOptionFn) (r0 []tmdb.ProviderRegion, r1 *http.Response, r2 error) {
+	f.record("GetProviderRegions", opt, options)
+	if f.GetProviderRegionsFunc == nil {
+		r2 = notImplemented("WatchProvidersService.GetProviderRegions")
+		return
+	}
+	return f.GetProviderRegionsFunc(opt, options...)
+}
+
+// AccountV4Service is a fake tmdb.AccountV4Service.
+// Each method records its call and calls the function of the same name with the Func suffix,
+// or returns ErrNotImplemented if it is not set.
+type AccountV4Service struct {
+	calls
+
+	GetListsFunc                 func(accountID, accessToken string, opt *tmdb.AccountListsV4Options, options ...tmdb.RequestOptionFn) (*tmdb.AccountListsV4, *http.Response, error)
+	GetFavoriteMoviesFunc        func(accountID, accessToken string, opt *tmdb.AccountV4Options, options ...tmdb.RequestOptionFn) (*tmdb.FavoriteMovies, *http.Response, error)
+	GetFavoriteTVShowsFunc       func(accountID, accessToken string, opt *tmdb.AccountV4Options, options ...tmdb.RequestOptionFn) (*tmdb.FavoriteTVShows, *http.Response, error)
+	GetMovieRecommendationsFunc  func(accountID, accessToken string, opt *tmdb.AccountV4Options, options ...tmdb.RequestOptionFn) (*tmdb.AccountRecommendedMovies, *http.Response, error)
+	GetTVShowRecommendationsFunc func(accountID, accessToken string, opt *tmdb.AccountV4Options, options ...tmdb.RequestOptionFn) (*tmdb.AccountRecommendedTVShows, *http.Response, error)
+	GetWatchlistMoviesFunc       func(accountID, accessToken string, opt *tmdb.AccountV4Options, options ...tmdb.RequestOptionFn) (*tmdb.WatchlistMovies, *http.Response, error)
+	GetWatchlistTVShowsFunc      func(accountID, accessToken string, opt *tmdb.AccountV4Options, options ...tmdb.RequestOptionFn) (*tmdb.WatchlistTVShows, *http.Response, error)
+	GetRatedMoviesFunc           func(accountID, accessToken string, opt *tmdb.AccountV4Options, options ...tmdb.RequestOptionFn) (*tmdb.RatedMoviesV4, *http.Response, error)
+	GetRatedTVShowsFunc          func(accountID, accessToken string, opt *tmdb.AccountV4Options, options ...tmdb.RequestOptionFn) (*tmdb.RatedTVShowsV4, *http.Response, error)
+}
+
+var _ tmdb.AccountV4Service = (*AccountV4Service)(nil)
+
+// GetLists calls GetListsFunc.
+func (f *AccountV4Service) GetLists(accountID string, accessToken string, opt *tmdb.AccountListsV4Options, options ...tmdb.RequestOptionFn) (r0 *tmdb.AccountListsV4, r1 *http.Response, r2 error) {
+	f.record("GetLists", accountID, accessToken, opt, options)
+	if f.GetListsFunc == nil {
+		r2 = notImplemented("AccountV4Service.GetLists")
+		return
+	}
+	return f.GetListsFunc(accountID, accessToken, opt, options...)
+}
+
+// GetFavoriteMovies calls GetFavoriteMoviesFunc.
+func (f *AccountV4Service) GetFavoriteMovies(accountID string, accessToken string, opt *tmdb.AccountV4Options, options ...tmdb.RequestOptionFn) (r0 *tmdb.FavoriteMovies, r1 *http.Response, r2 error) {
+	f.record("GetFavoriteMovies", accountID, accessToken, opt, options)
+	if f.GetFavoriteMoviesFunc == nil {
+		r2 = notImplemented("AccountV4Service.GetFavoriteMovies")
+		return
+	}
+	return f.GetFavoriteMoviesFunc(accountID, accessToken, opt, options...)
+}
+
+// GetFavoriteTVShows calls GetFavoriteTVShowsFunc.
+func (f *AccountV4Service) GetFavoriteTVShows(accountID string, accessToken string, opt *tmdb.AccountV4Options, options ...tmdb.RequestOptionFn) (r0 *tmdb.FavoriteTVShows, r1 *http.Response, r2 error) {
+	f.record("GetFavoriteTVShows", accountID, accessToken, opt, options)
+	if f.GetFavoriteTVShowsFunc == nil {
+		r2 = notImplemented("AccountV4Service.GetFavoriteTVShows")
+		return
+	}
+	return f.GetFavoriteTVShowsFunc(accountID, accessToken, opt, options...)
+}
+
+// GetMovieRecommendations calls GetMovieRecommendationsFunc.
+func (f *AccountV4Service) GetMovieRecommendations(accountID string, accessToken string, opt *tmdb.AccountV4Options, options ...tmdb.RequestOptionFn) (r0 *tmdb.AccountRecommendedMovies, r1 *http.Response, r2 error) {
+	f.record("GetMovieRecommendations", accountID, accessToken, opt, options)
+	if f.GetMovieRecommendationsFunc == nil {
+		r2 = notImplemented("AccountV4Service.GetMovieRecommendations")
+		return
+	}
+	return f.GetMovieRecommendationsFunc(accountID, accessToken, opt, options...)
+}
+
+// GetTVShowRecommendations calls GetTVShowRecommendationsFunc.
+func (f *AccountV4Service) GetTVShowRecommendations(accountID string, accessToken string, opt *tmdb.AccountV4Options, options ...tmdb.RequestOptionFn) (r0 *tmdb.AccountRecommendedTVShows, r1 *http.Response, r2 error) {
+	f.record("GetTVShowRecommendations", accountID, accessToken, opt, options)
+	if f.GetTVShowRecommendationsFunc == nil {
+		r2 = notImplemented("AccountV4Service.GetTVShowRecommendations")
+		return
+	}
+	return f.GetTVShowRecommendationsFunc(accountID, accessToken, opt, options...)
+}
+
+// GetWatchlistMovies calls GetWatchlistMoviesFunc.
+func (f *AccountV4Service) GetWatchlistMovies(accountID string, accessToken string, opt *tmdb.AccountV4Options, options ...tmdb.RequestOptionFn) (r0 *tmdb.WatchlistMovies, r1 *http.Response, r2 error) {
+	f.record("GetWatchlistMovies", accountID, accessToken, opt, options)
+	if f.GetWatchlistMoviesFunc == nil {
+		r2 = notImplemented("AccountV4Service.GetWatchlistMovies")
+		return
+	}
+	return f.GetWatchlistMoviesFunc(accountID, accessToken, opt, options...)
+}
+
+// GetWatchlistTVShows calls GetWatchlistTVShowsFunc.
+func (f *AccountV4Service) GetWatchlistTVShows(accountID string, accessToken string, opt *tmdb.AccountV4Options, options ...tmdb.RequestOptionFn) (r0 *tmdb.WatchlistTVShows, r1 *http.Response, r2 error) {
+	f.record("GetWatchlistTVShows", accountID, accessToken, opt, options)
+	if f.GetWatchlistTVShowsFunc == nil {
+		r2 = notImplemented("AccountV4Service.GetWatchlistTVShows")
+		return
+	}
+	return f.GetWatchlistTVShowsFunc(accountID, accessToken, opt, options...)
+}
+
+// GetRatedMovies calls GetRatedMoviesFunc.
+func (f *AccountV4Service) GetRatedMovies(accountID string, accessToken string, opt *tmdb.AccountV4Options, options ...tmdb.RequestOptionFn) (r0 *tmdb.RatedMoviesV4, r1 *http.Response, r2 error) {
+	f.record("GetRatedMovies", accountID, accessToken, opt, options)
+	if f.GetRatedMoviesFunc == nil {
+		r2 = notImplemented("AccountV4Service.GetRatedMovies")
+		return
+	}
+	return f.GetRatedMoviesFunc(accountID, accessToken, opt, options...)
+}
+
+// GetRatedTVShows calls GetRatedTVShowsFunc.
+func (f *AccountV4Service) GetRatedTVShows(accountID string, accessToken string, opt *tmdb.AccountV4Options, options ...tmdb.RequestOptionFn) (r0 *tmdb.RatedTVShowsV4, r1 *http.Response, r2 error) {
+	f.record("GetRatedTVShows", accountID, accessToken, opt, options)
+	if f.GetRatedTVShowsFunc == nil {
+		r2 = notImplemented("AccountV4Service.GetRatedTVShows")
+		return
+	}
+	return f.GetRatedTVShowsFunc(accountID, accessToken, opt, options...)
+}
+
+// AuthenticationV4Service is a fake tmdb.AuthenticationV4Service.
+// Each method records its call and calls the function of the same name with the Func suffix,
+// or returns ErrNotImplemented if it is not set.
+type AuthenticationV4Service struct {
+	calls
+
+	CreateRequestTokenFunc func(redirectTo string, options ...tmdb.RequestOptionFn) (*tmdb.RequestTokenV4, *http.Response, error)
+	CreateAccessTokenFunc  func(requestToken string, options ...tmdb.RequestOptionFn) (*tmdb.AccessToken, *http.Response, error)
+	DeleteAccessTokenFunc  func(accessToken string, options ...tmdb.RequestOptionFn) (*tmdb.DeleteAccessTokenResponse, *http.Response, error)
+}
+
+var _ tmdb.AuthenticationV4Service = (*AuthenticationV4Service)(nil)
+
+// CreateRequestToken calls CreateRequestTokenFunc.
+func (f *AuthenticationV4Service) CreateRequestToken(redirectTo string, options ...tmdb.RequestOptionFn) (r0 *tmdb.RequestTokenV4, r1 *http.Response, r2 error) {
+	f.record("CreateRequestToken", redirectTo, options)
+	if f.CreateRequestTokenFunc == nil {
+		r2 = notImplemented("AuthenticationV4Service.CreateRequestToken")
+		return
+	}
+	return f.CreateRequestTokenFunc(redirectTo, options...)
+}
+
+// CreateAccessToken calls CreateAccessTokenFunc.
+func (f *AuthenticationV4Service) CreateAccessToken(requestToken string, options ...tmdb.RequestOptionFn) (r0 *tmdb.AccessToken, r1 *http.Response, r2 error) {
+	f.record("CreateAccessToken", requestToken, options)
+	if f.CreateAccessTokenFunc == nil {
+		r2 = notImplemented("AuthenticationV4Service.CreateAccessToken")
+		return
+	}
+	return f.CreateAccessTokenFunc(requestToken, options...)
+}
+
+// DeleteAccessToken calls DeleteAccessTokenFunc.
+func (f *AuthenticationV4Service) DeleteAccessToken(accessToken string, options ...tmdb.RequestOptionFn) (r0 *tmdb.DeleteAccessTokenResponse, r1 *http.Response, r2 error) {
+	f.record("DeleteAccessToken", accessToken, options)
+	if f.DeleteAccessTokenFunc == nil {
+		r2 = notImplemented("AuthenticationV4Service.DeleteAccessToken")
+		return
+	}
+	return f.DeleteAccessTokenFunc(accessToken, options...)
+}
+
+// ListsV4Service is a fake tmdb.ListsV4Service.
+// Each method records its call and calls the function of the same name with the Func suffix,
+// or returns ErrNotImplemented if it is not set.
+type ListsV4Service struct {
+	calls
+
+	GetListFunc       func(listID int, opt *tmdb.ListV4Options, options ...tmdb.RequestOptionFn) (*tmdb.ListV4, *http.Response, error)
+	CreateListFunc    func(accessToken string, list tmdb.CreateListV4, options ...tmdb.RequestOptionFn) (*tmdb.CreateListV4Response, *http.Response, error)
+	UpdateListFunc    func(accessToken string, listID int, list tmdb.UpdateListV4, options ...tmdb.RequestOptionFn) (*tmdb.UpdateListV4Response, *http.Response, error)
+	ClearFunc         func(accessToken string, listID int, options ...tmdb.RequestOptionFn) (*tmdb.ClearListV4Response, *http.Response, error)
+	DeleteFunc        func(accessToken string, listID int, options ...tmdb.RequestOptionFn) (*tmdb.DeleteListV4Response, *http.Response, error)
+	AddItemsFunc      func(accessToken string, listID int, items []tmdb.ListItemV4, options ...tmdb.RequestOptionFn) (*tmdb.ListItemsV4Response, *http.Response, error)
+	UpdateItemsFunc   func(accessToken string, listID int, items []tmdb.ListItemV4, options ...tmdb.RequestOptionFn) (*tmdb.ListItemsV4Response, *http.Response, error)
+	RemoveItemsFunc   func(accessToken string, listID int, items []tmdb.ListItemV4, options ...tmdb.RequestOptionFn) (*tmdb.ListItemsV4Response, *http.Response, error)
+	GetItemStatusFunc func(accessToken string, listID int, mediaType string, mediaID int, options ...tmdb.RequestOptionFn) (*tmdb.ItemStatusV4, *http.Response, error)
+}
+
+var _ tmdb.ListsV4Service = (*ListsV4Service)(nil)
+
+// GetList calls GetListFunc.
+func (f *ListsV4Service) GetList(listID int, opt *tmdb.ListV4Options, options ...tmdb.RequestOptionFn) (r0 *tmdb.ListV4, r1 *http.Response, r2 error) {
+	f.record("GetList", listID, opt, options)
+	if f.GetListFunc == nil {
+		r2 = notImplemented("ListsV4Service.GetList")
+		return
+	}
+	return f.GetListFunc(listID, opt, options...)
+}
+
+// CreateList calls CreateListFunc.
+func (f *ListsV4Service) CreateList(accessToken string, list tmdb.CreateListV4, options ...tmdb.RequestOptionFn) (r0 *tmdb.CreateListV4Response, r1 *http.Response, r2 error) {
+	f.record("CreateList", accessToken, list, options)
+	if f.CreateListFunc == nil {
+		r2 = notImplemented("ListsV4Service.CreateList")
+		return
+	}
+	return f.CreateListFunc(accessToken, list, options...)
+}
+
+// UpdateList calls UpdateListFunc.
+func (f *ListsV4Service) UpdateList(accessToken string, listID int, list tmdb.UpdateListV4, options ...tmdb.RequestOptionFn) (r0 *tmdb.UpdateListV4Response, r1 *http.Response, r2 error) {
+	f.record("UpdateList", accessToken, listID, list, options)
+	if f.UpdateListFunc == nil {
+		r2 = notImplemented("ListsV4Service.UpdateList")
+		return
+	}
+	return f.UpdateListFunc(accessToken, listID, list, options...)
+}
+
+// Clear calls ClearFunc.
+func (f *ListsV4Service) Clear(accessToken string, listID int, options ...tmdb.RequestOptionFn) (r0 *tmdb.ClearListV4Response, r1 *http.Response, r2 error) {
+	f.record("Clear", accessToken, listID, options)
+	if f.ClearFunc == nil {
+		r2 = notImplemented("ListsV4Service.Clear")
+		return
+	}
+	return f.ClearFunc(accessToken, listID, options...)
+}
+
+// Delete calls DeleteFunc.
+func (f *ListsV4Service) Delete(accessToken string, listID int, options ...tmdb.RequestOptionFn) (r0 *tmdb.DeleteListV4Response, r1 *http.Response, r2 error) {
+	f.record("Delete", accessToken, listID, options)
+	if f.DeleteFunc == nil {
+		r2 = notImplemented("ListsV4Service.Delete")
+		return
+	}
+	return f.DeleteFunc(accessToken, listID, options...)
+}
+
+// AddItems calls AddItemsFunc.
+func (f *ListsV4Service) AddItems(accessToken string, listID int, items []tmdb.ListItemV4, options ...tmdb.RequestOptionFn) (r0 *tmdb.ListItemsV4Response, r1 *http.Response, r2 error) {
+	f.record("AddItems", accessToken, listID, items, options)
+	if f.AddItemsFunc == nil {
+		r2 = notImplemented("ListsV4Service.AddItems")
+		return
+	}
+	return f.AddItemsFunc(accessToken, listID, items, options...)
+}
+
+// UpdateItems calls UpdateItemsFunc.
+func (f *ListsV4Service) UpdateItems(accessToken string, listID int, items []tmdb.ListItemV4, options ...tmdb.RequestOptionFn) (r0 *tmdb.ListItemsV4Response, r1 *http.Response, r2 error) {
+	f.record("UpdateItems", accessToken, listID, items, options)
+	if f.UpdateItemsFunc == nil {
+		r2 = notImplemented("ListsV4Service.UpdateItems")
+		return
+	}
+	return f.UpdateItemsFunc(accessToken, listID, items, options...)
+}
+
+// RemoveItems calls RemoveItemsFunc.
+func (f *ListsV4Service) RemoveItems(accessToken string, listID int, items []tmdb.ListItemV4, options ...tmdb.RequestOptionFn) (r0 *tmdb.ListItemsV4Response, r1 *http.Response, r2 error) {
+	f.record("RemoveItems", accessToken, listID, items, options)
+	if f.RemoveItemsFunc == nil {
+		r2 = notImplemented("ListsV4Service.RemoveItems")
+		return
+	}
+	return f.RemoveItemsFunc(accessToken, listID, items, options...)
+}
+
+// GetItemStatus calls GetItemStatusFunc.
+func (f *ListsV4Service) GetItemStatus(accessToken string, listID int, mediaType string, mediaID int, options ...tmdb.RequestOptionFn) (r0 *tmdb.ItemStatusV4, r1 *http.Response, r2 error) {
+	f.record("GetItemStatus", accessToken, listID, mediaType, mediaID, options)
+	if f.GetItemStatusFunc == nil {
+		r2 = notImplemented("ListsV4Service.GetItemStatus")
+		return
+	}
+	return f.GetItemStatusFunc(accessToken, listID, mediaType, mediaID, options...)
+}
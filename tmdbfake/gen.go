@@ -0,0 +1,199 @@
+//go:build ignore
+
+// gen generates the fakes of the services of the tmdb client, from the interfaces in services.go.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"strings"
+)
+
+const header = `// Code generated by gen.go; DO NOT EDIT.
+
+package tmdbfake
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/mdvalv/go-tmdb"
+)
+`
+
+// service represents a service interface and the client field holding it.
+type service struct {
+	name    string
+	field   string
+	methods []*ast.Field
+}
+
+func main() {
+	fset := token.NewFileSet()
+	servicesFile, err := parser.ParseFile(fset, "../services.go", nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	clientFile, err := parser.ParseFile(fset, "../tmdb.go", nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	interfaces := make(map[string]*ast.InterfaceType)
+	ast.Inspect(servicesFile, func(n ast.Node) bool {
+		if spec, ok := n.(*ast.TypeSpec); ok {
+			if iface, ok := spec.Type.(*ast.InterfaceType); ok {
+				interfaces[spec.Name.Name] = iface
+			}
+		}
+		return true
+	})
+
+	// Services are generated in the order of the client fields.
+	var services []service
+	ast.Inspect(clientFile, func(n ast.Node) bool {
+		spec, ok := n.(*ast.TypeSpec)
+		if !ok || spec.Name.Name != "Client" {
+			return true
+		}
+		for _, field := range spec.Type.(*ast.StructType).Fields.List {
+			ident, ok := field.Type.(*ast.Ident)
+			if !ok || interfaces[ident.Name] == nil {
+				continue
+			}
+			services = append(services, service{
+				name:    ident.Name,
+				field:   field.Names[0].Name,
+				methods: interfaces[ident.Name].Methods.List,
+			})
+		}
+		return false
+	})
+
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	writeClient(&buf, services)
+	for _, s := range services {
+		writeService(&buf, s)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("failed to format fakes: %v\n%s", err, buf.Bytes())
+	}
+	if err := os.WriteFile("fakes.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func writeClient(buf *bytes.Buffer, services []service) {
+	buf.WriteString("\n// Client holds the fakes of a client, see NewClient.\ntype Client struct {\n")
+	for _, s := range services {
+		fmt.Fprintf(buf, "%s *%s\n", s.field, s.name)
+	}
+	buf.WriteString("}\n\n// NewClient returns a client whose services are all fakes, along with the fakes.\n")
+	buf.WriteString("func NewClient() (*tmdb.Client, *Client) {\nfakes := &Client{\n")
+	for _, s := range services {
+		fmt.Fprintf(buf, "%s: &%s{},\n", s.field, s.name)
+	}
+	buf.WriteString("}\nclient := &tmdb.Client{\n")
+	for _, s := range services {
+		fmt.Fprintf(buf, "%s: fakes.%s,\n", s.field, s.field)
+	}
+	buf.WriteString("}\nreturn client, fakes\n}\n")
+}
+
+func writeService(buf *bytes.Buffer, s service) {
+	fmt.Fprintf(buf, "\n// %s is a fake tmdb.%s.\n", s.name, s.name)
+	buf.WriteString("// Each method records its call and calls the function of the same name with the Func suffix,\n")
+	buf.WriteString("// or returns ErrNotImplemented if it is not set.\n")
+	fmt.Fprintf(buf, "type %s struct {\ncalls\n\n", s.name)
+	for _, m := range s.methods {
+		fmt.Fprintf(buf, "%sFunc %s\n", m.Names[0].Name, expr(m.Type))
+	}
+	fmt.Fprintf(buf, "}\n\nvar _ tmdb.%s = (*%s)(nil)\n", s.name, s.name)
+
+	for _, m := range s.methods {
+		name := m.Names[0].Name
+		fn := m.Type.(*ast.FuncType)
+
+		var params, args, recorded []string
+		for _, p := range fn.Params.List {
+			for _, n := range p.Names {
+				params = append(params, n.Name+" "+expr(p.Type))
+				recorded = append(recorded, n.Name)
+				if _, ok := p.Type.(*ast.Ellipsis); ok {
+					args = append(args, n.Name+"...")
+				} else {
+					args = append(args, n.Name)
+				}
+			}
+		}
+		var results []string
+		returnsError := false
+		for i, r := range fn.Results.List {
+			results = append(results, fmt.Sprintf("r%d %s", i, expr(r.Type)))
+			returnsError = expr(r.Type) == "error"
+		}
+
+		fmt.Fprintf(buf, "\n// %s calls %sFunc.\n", name, name)
+		fmt.Fprintf(buf, "func (f *%s) %s(%s) (%s) {\n", s.name, name, strings.Join(params, ", "), strings.Join(results, ", "))
+		fmt.Fprintf(buf, "f.record(%q, %s)\n", name, strings.Join(recorded, ", "))
+		fmt.Fprintf(buf, "if f.%sFunc == nil {\n", name)
+		if returnsError {
+			fmt.Fprintf(buf, "r%d = notImplemented(%q)\n", len(results)-1, s.name+"."+name)
+		}
+		fmt.Fprintf(buf, "return\n}\nreturn f.%sFunc(%s)\n}\n", name, strings.Join(args, ", "))
+	}
+}
+
+// expr formats a type of package tmdb as seen from package tmdbfake.
+func expr(e ast.Expr) string {
+	var buf bytes.Buffer
+	if err := format.Node(&buf, token.NewFileSet(), qualify(e)); err != nil {
+		log.Fatal(err)
+	}
+	return buf.String()
+}
+
+// qualify prefixes the exported identifiers of package tmdb with the package name.
+func qualify(e ast.Expr) ast.Expr {
+	switch e := e.(type) {
+	case *ast.Ident:
+		if ast.IsExported(e.Name) {
+			return &ast.SelectorExpr{X: ast.NewIdent("tmdb"), Sel: e}
+		}
+		return e
+	case *ast.StarExpr:
+		return &ast.StarExpr{X: qualify(e.X)}
+	case *ast.ArrayType:
+		return &ast.ArrayType{Len: e.Len, Elt: qualify(e.Elt)}
+	case *ast.MapType:
+		return &ast.MapType{Key: qualify(e.Key), Value: qualify(e.Value)}
+	case *ast.ChanType:
+		return &ast.ChanType{Dir: e.Dir, Value: qualify(e.Value)}
+	case *ast.Ellipsis:
+		return &ast.Ellipsis{Elt: qualify(e.Elt)}
+	case *ast.IndexExpr:
+		return &ast.IndexExpr{X: qualify(e.X), Index: qualify(e.Index)}
+	case *ast.FuncType:
+		return &ast.FuncType{Params: qualifyFields(e.Params), Results: qualifyFields(e.Results)}
+	}
+	return e
+}
+
+func qualifyFields(fields *ast.FieldList) *ast.FieldList {
+	if fields == nil {
+		return nil
+	}
+	qualified := &ast.FieldList{}
+	for _, f := range fields.List {
+		qualified.List = append(qualified.List, &ast.Field{Names: f.Names, Type: qualify(f.Type)})
+	}
+	return qualified
+}
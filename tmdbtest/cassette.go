@@ -0,0 +1,298 @@
+package tmdbtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/mdvalv/go-tmdb"
+	"github.com/pkg/errors"
+)
+
+// Mode represents how a cassette handles requests.
+type Mode int
+
+const (
+	// ModeReplay answers requests with the recorded responses, failing the test on unmatched requests.
+	ModeReplay Mode = iota
+
+	// ModeRecord sends requests to TMDb API and records the responses, replacing the cassette.
+	ModeRecord
+
+	// ModeReplayOrRecord replays the cassette if it exists, or records it otherwise.
+	ModeReplayOrRecord
+)
+
+// Redacted replaces the values of RedactedFields in cassettes.
+const Redacted = "REDACTED"
+
+// RedactedFields are the query params and JSON body fields redacted in cassettes,
+// in requests and responses alike, so that cassettes can be committed without leaking credentials.
+var RedactedFields = []string{
+	"api_key", "session_id", "guest_session_id", "password", "request_token", "access_token",
+}
+
+// Interaction represents a recorded request and its response.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest represents a recorded request.
+type RecordedRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+// RecordedResponse represents a recorded response.
+type RecordedResponse struct {
+	Status int         `json:"status"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body"`
+}
+
+// Cassette is a transport recording the interactions with TMDb API in a JSON file and replaying them,
+// so that tests can capture real responses once and then run offline.
+//
+// Example:
+//
+//	cassette := tmdbtest.NewCassette(t, "testdata/movie.json", tmdbtest.ModeReplayOrRecord)
+//	client, err := tmdb.NewClient(os.Getenv("TMDB_API_KEY"), cassette.Option())
+type Cassette struct {
+	// Transport used to send requests when recording, http.DefaultTransport if not set.
+	Transport http.RoundTripper
+
+	t            testing.TB
+	path         string
+	recording    bool
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+// NewCassette returns a cassette stored at the given path.
+// Recorded cassettes are saved when the test and its subtests complete.
+func NewCassette(t testing.TB, path string, mode Mode) *Cassette {
+	t.Helper()
+	c := &Cassette{t: t, path: path}
+	switch mode {
+	case ModeRecord:
+		c.recording = true
+	case ModeReplayOrRecord:
+		_, err := os.Stat(path)
+		c.recording = os.IsNotExist(err)
+	}
+	if c.recording {
+		t.Cleanup(func() {
+			if err := c.Save(); err != nil {
+				t.Errorf("tmdbtest: %v", err)
+			}
+		})
+		return c
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("tmdbtest: failed to read cassette: %v", err)
+	}
+	if err := json.Unmarshal(data, &c.interactions); err != nil {
+		t.Fatalf("tmdbtest: failed to decode cassette %s: %v", path, err)
+	}
+	c.used = make([]bool, len(c.interactions))
+	return c
+}
+
+// Option returns the client option sending requests through the cassette.
+func (c *Cassette) Option() tmdb.ClientOption {
+	return tmdb.WithTransport(c)
+}
+
+// Interactions returns the interactions of the cassette.
+func (c *Cassette) Interactions() []Interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]Interaction(nil), c.interactions...)
+}
+
+// Save writes the recorded interactions to the cassette file.
+func (c *Cassette) Save() error {
+	c.mu.Lock()
+	data, err := json.MarshalIndent(c.interactions, "", "  ")
+	c.mu.Unlock()
+	if err != nil {
+		return errors.Wrapf(err, "failed to encode cassette %s", c.path)
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return errors.Wrapf(err, "failed to save cassette %s", c.path)
+	}
+	return errors.Wrapf(os.WriteFile(c.path, append(data, '\n'), 0o644), "failed to save cassette %s", c.path)
+}
+
+// RoundTrip records or replays a request.
+func (c *Cassette) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	recorded := RecordedRequest{
+		Method: req.Method,
+		URL:    redactURL(req.URL),
+		Body:   string(redactBody(body)),
+	}
+	if c.recording {
+		return c.record(req, recorded)
+	}
+	return c.replay(req, recorded)
+}
+
+func (c *Cassette) record(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	transport := c.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.interactions = append(c.interactions, Interaction{
+		Request: recorded,
+		Response: RecordedResponse{
+			Status: resp.StatusCode,
+			Header: recordedHeader(resp.Header),
+			Body:   string(redactBody(body)),
+		},
+	})
+	return resp, nil
+}
+
+func (c *Cassette) replay(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// Matching interactions are replayed in the order they were recorded,
+	// the last one being replayed again for further identical requests.
+	match := -1
+	for i, interaction := range c.interactions {
+		if interaction.Request == recorded {
+			match = i
+			if !c.used[i] {
+				break
+			}
+		}
+	}
+	if match < 0 {
+		c.t.Errorf("tmdbtest: cassette %s has no interaction for %s %s", c.path, recorded.Method, recorded.URL)
+		return nil, errors.Errorf("tmdbtest: unmatched request %s %s", recorded.Method, recorded.URL)
+	}
+	c.used[match] = true
+
+	response := c.interactions[match].Response
+	header := response.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", response.Status, http.StatusText(response.Status)),
+		StatusCode:    response.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader([]byte(response.Body))),
+		ContentLength: int64(len(response.Body)),
+		Request:       req,
+	}, nil
+}
+
+// recordedHeader keeps the response headers the client relies on.
+func recordedHeader(header http.Header) http.Header {
+	recorded := make(http.Header)
+	for _, key := range []string{"Content-Type", "ETag", "Retry-After"} {
+		if values := header.Values(key); len(values) > 0 {
+			recorded[key] = values
+		}
+	}
+	return recorded
+}
+
+// redactURL returns the URL with the values of RedactedFields redacted and the query params sorted.
+func redactURL(u *url.URL) string {
+	redacted := *u
+	query := u.Query()
+	for _, field := range RedactedFields {
+		if query.Has(field) {
+			query.Set(field, Redacted)
+		}
+	}
+	redacted.RawQuery = query.Encode()
+	return redacted.String()
+}
+
+// redactBody returns the JSON body with the values of RedactedFields redacted, at any depth.
+// Bodies that are not JSON objects are returned as is.
+func redactBody(body []byte) []byte {
+	var object map[string]interface{}
+	if len(body) == 0 || json.Unmarshal(body, &object) != nil {
+		return body
+	}
+	if !redactObject(object) {
+		return body
+	}
+	redacted, err := json.Marshal(object)
+	if err != nil {
+		return body
+	}
+	return redacted
+}
+
+// redactObject redacts the values of RedactedFields in a JSON value, reporting whether any was found.
+func redactObject(value interface{}) bool {
+	found := false
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, v := range value {
+			if isRedacted(key) {
+				value[key] = Redacted
+				found = true
+			} else if redactObject(v) {
+				found = true
+			}
+		}
+	case []interface{}:
+		for _, v := range value {
+			if redactObject(v) {
+				found = true
+			}
+		}
+	}
+	return found
+}
+
+func isRedacted(field string) bool {
+	for _, redacted := range RedactedFields {
+		if field == redacted {
+			return true
+		}
+	}
+	return false
+}
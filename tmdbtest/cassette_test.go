@@ -0,0 +1,211 @@
+package tmdbtest_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mdvalv/go-tmdb"
+	"github.com/mdvalv/go-tmdb/tmdbtest"
+)
+
+func TestCassette(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	server := tmdbtest.NewServer()
+
+	requests := []struct {
+		name string
+		do   func(client *tmdb.Client) (interface{}, error)
+		want func(value interface{}) bool
+	}{
+		{
+			name: "movie",
+			do: func(client *tmdb.Client) (interface{}, error) {
+				movie, _, err := client.Movies.GetMovie(tmdbtest.MovieID, nil)
+				return movie, err
+			},
+			want: func(v interface{}) bool { return v.(*tmdb.MovieDetails).Title == "Fight Club" },
+		},
+		{
+			name: "search",
+			do: func(client *tmdb.Client) (interface{}, error) {
+				movies, _, err := client.Search.Movies("fight club", nil)
+				return movies, err
+			},
+			want: func(v interface{}) bool { return len(v.(*tmdb.SearchMovies).Movies) > 0 },
+		},
+		{
+			name: "delete session",
+			do: func(client *tmdb.Client) (interface{}, error) {
+				deleted, _, err := client.Authentication.DeleteSession(tmdbtest.SessionID)
+				return deleted, err
+			},
+			want: func(v interface{}) bool { return v != nil },
+		},
+	}
+
+	t.Run("record", func(t *testing.T) {
+		cassette := tmdbtest.NewCassette(t, path, tmdbtest.ModeReplayOrRecord)
+		client, err := server.Client(cassette.Option())
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, r := range requests {
+			value, err := r.do(client)
+			if err != nil {
+				t.Fatalf("%s: %v", r.name, err)
+			}
+			if !r.want(value) {
+				t.Errorf("%s: unexpected response %+v", r.name, value)
+			}
+		}
+		if got := len(cassette.Interactions()); got != len(requests) {
+			t.Errorf("got %d interactions, want %d", got, len(requests))
+		}
+	})
+	server.Close()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{tmdbtest.APIKey, tmdbtest.SessionID} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("got %s in the cassette", secret)
+		}
+	}
+
+	t.Run("replay", func(t *testing.T) {
+		cassette := tmdbtest.NewCassette(t, path, tmdbtest.ModeReplayOrRecord)
+		// The server is closed, so responses can only come from the cassette.
+		client, err := tmdb.NewClient(tmdbtest.APIKey, tmdb.WithBaseURL(server.BaseURL()), cassette.Option())
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, r := range requests {
+			value, err := r.do(client)
+			if err != nil {
+				t.Fatalf("%s: %v", r.name, err)
+			}
+			if !r.want(value) {
+				t.Errorf("%s: unexpected response %+v", r.name, value)
+			}
+		}
+	})
+}
+
+func TestCassetteRedaction(t *testing.T) {
+	tests := []struct {
+		name       string
+		do         func(client *tmdb.Client) error
+		wantURL    string
+		wantInBody string
+	}{
+		{
+			name: "api key in query",
+			do: func(client *tmdb.Client) error {
+				_, _, err := client.Movies.GetMovie(tmdbtest.MovieID, nil)
+				return err
+			},
+			wantURL: "api_key=" + tmdbtest.Redacted,
+		},
+		{
+			name: "session id in body",
+			do: func(client *tmdb.Client) error {
+				_, _, err := client.Authentication.DeleteSession(tmdbtest.SessionID)
+				return err
+			},
+			wantInBody: `"session_id":"` + tmdbtest.Redacted + `"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := tmdbtest.NewServer()
+			defer server.Close()
+			cassette := tmdbtest.NewCassette(t, filepath.Join(t.TempDir(), "cassette.json"), tmdbtest.ModeRecord)
+			client, err := server.Client(cassette.Option())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tt.do(client); err != nil {
+				t.Fatal(err)
+			}
+			interactions := cassette.Interactions()
+			if len(interactions) != 1 {
+				t.Fatalf("got %d interactions, want 1", len(interactions))
+			}
+			request := interactions[0].Request
+			if !strings.Contains(request.URL, tt.wantURL) {
+				t.Errorf("got url %s, want %s in it", request.URL, tt.wantURL)
+			}
+			if !strings.Contains(request.Body, tt.wantInBody) {
+				t.Errorf("got body %s, want %s in it", request.Body, tt.wantInBody)
+			}
+		})
+	}
+}
+
+func TestCassetteRedactsLogins(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+		login func(client *tmdb.Client) error
+	}{
+		{
+			name:  "v3 login",
+			token: tmdbtest.APIKey,
+			login: func(client *tmdb.Client) error {
+				_, err := tmdb.NewSessionManager(client, nil).Login(tmdbtest.Username, tmdbtest.Password)
+				return err
+			},
+		},
+		{
+			name:  "v4 access token",
+			token: tmdbtest.ReadAccessToken,
+			login: func(client *tmdb.Client) error {
+				token, _, err := client.AuthenticationV4.CreateRequestToken("")
+				if err != nil {
+					return err
+				}
+				_, _, err = client.AuthenticationV4.CreateAccessToken(token.RequestToken)
+				return err
+			},
+		},
+	}
+	secrets := []string{
+		tmdbtest.APIKey, tmdbtest.ReadAccessToken, tmdbtest.AccessToken,
+		tmdbtest.Password, tmdbtest.RequestToken, tmdbtest.SessionID,
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := tmdbtest.NewServer()
+			defer server.Close()
+			path := filepath.Join(t.TempDir(), "login.json")
+			cassette := tmdbtest.NewCassette(t, path, tmdbtest.ModeRecord)
+			client, err := server.ClientWithToken(tt.token, cassette.Option())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tt.login(client); err != nil {
+				t.Fatal(err)
+			}
+			if err := cassette.Save(); err != nil {
+				t.Fatal(err)
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(string(data), tmdbtest.Redacted) {
+				t.Error("got nothing redacted in the cassette")
+			}
+			for _, secret := range secrets {
+				if strings.Contains(string(data), secret) {
+					t.Errorf("got %s in the cassette", secret)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package tmdbtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/mdvalv/go-tmdb"
+)
+
+// RetryAfter is the number of seconds the canned 429 error asks to wait before retrying.
+const RetryAfter = 1
+
+// cannedError represents a TMDb error answered by the server.
+type cannedError struct {
+	statusCode    int
+	statusMessage string
+}
+
+// cannedErrors are the TMDb errors answered by the server, by HTTP status.
+var cannedErrors = map[int]cannedError{
+	http.StatusUnauthorized:    {tmdb.StatusInvalidAPIKey, "Invalid API key: You must be granted a valid key."},
+	http.StatusNotFound:        {tmdb.StatusResourceNotFound, "The resource you requested could not be found."},
+	http.StatusTooManyRequests: {tmdb.StatusRequestLimitExceeded, "Your request count (41) is over the allowed limit of (40)."},
+}
+
+// writeError writes the canned TMDb error of the HTTP status.
+// Statuses without a canned error are answered with the HTTP status text.
+func writeError(w http.ResponseWriter, status int) {
+	if status == http.StatusTooManyRequests {
+		w.Header().Set("Retry-After", strconv.Itoa(RetryAfter))
+	}
+	canned, ok := cannedErrors[status]
+	if !ok {
+		canned = cannedError{statusMessage: http.StatusText(status)}
+	}
+	writeAPIError(w, status, canned.statusCode, canned.statusMessage)
+}
+
+// writeAPIError writes a TMDb error.
+func writeAPIError(w http.ResponseWriter, status, statusCode int, statusMessage string) {
+	data, _ := json.Marshal(map[string]interface{}{
+		"success":        false,
+		"status_code":    statusCode,
+		"status_message": statusMessage,
+	})
+	writeJSON(w, status, data)
+}
+
+func writeJSON(w http.ResponseWriter, status int, data []byte) {
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	w.WriteHeader(status)
+	_, _ = w.Write(data)
+}
@@ -0,0 +1,189 @@
+package tmdbtest_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/mdvalv/go-tmdb"
+	"github.com/mdvalv/go-tmdb/tmdbtest"
+)
+
+func TestServerFixtures(t *testing.T) {
+	server := tmdbtest.NewServer()
+	defer server.Close()
+	client, err := server.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		get  func() (interface{}, error)
+		want func(value interface{}) bool
+	}{
+		{
+			name: "movie",
+			get: func() (interface{}, error) {
+				v, _, err := client.Movies.GetMovie(tmdbtest.MovieID, nil)
+				return v, err
+			},
+			want: func(v interface{}) bool { return v.(*tmdb.MovieDetails).ID == tmdbtest.MovieID },
+		},
+		{
+			name: "tv show",
+			get: func() (interface{}, error) {
+				v, _, err := client.TV.GetTVShow(tmdbtest.TVShowID, nil)
+				return v, err
+			},
+			want: func(v interface{}) bool { return v.(*tmdb.TVShowDetails).ID == tmdbtest.TVShowID },
+		},
+		{
+			name: "person",
+			get: func() (interface{}, error) {
+				v, _, err := client.People.GetPerson(tmdbtest.PersonID, nil)
+				return v, err
+			},
+			want: func(v interface{}) bool { return v.(*tmdb.PersonDetails).ID == tmdbtest.PersonID },
+		},
+		{
+			name: "search",
+			get: func() (interface{}, error) {
+				v, _, err := client.Search.Movies("fight club", nil)
+				return v, err
+			},
+			want: func(v interface{}) bool { return len(v.(*tmdb.SearchMovies).Movies) > 0 },
+		},
+		{
+			name: "appended sub-resource",
+			get: func() (interface{}, error) {
+				v, _, err := client.Movies.GetMovie(tmdbtest.MovieID, &tmdb.MovieDetailsOptions{AppendToResponse: tmdb.MovieAppendCredits})
+				return v, err
+			},
+			want: func(v interface{}) bool { return v.(*tmdb.MovieDetails).Credits != nil },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := tt.get()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !tt.want(value) {
+				t.Errorf("unexpected fixture %+v", value)
+			}
+		})
+	}
+}
+
+func TestServerErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(server *tmdbtest.Server)
+		token   string
+		movieID int
+		check   func(err error) bool
+	}{
+		{name: "unknown route", token: tmdbtest.APIKey, movieID: 1, check: tmdb.IsNotFound},
+		{name: "invalid api key", token: "invalid", movieID: tmdbtest.MovieID, check: tmdb.IsUnauthorized},
+		{
+			name:    "canned not found",
+			setup:   func(s *tmdbtest.Server) { s.Fail(http.MethodGet, "/3/movie/550", http.StatusNotFound) },
+			token:   tmdbtest.APIKey,
+			movieID: tmdbtest.MovieID,
+			check:   tmdb.IsNotFound,
+		},
+		{
+			name:    "canned unauthorized",
+			setup:   func(s *tmdbtest.Server) { s.Fail("", "/3/movie/550", http.StatusUnauthorized) },
+			token:   tmdbtest.APIKey,
+			movieID: tmdbtest.MovieID,
+			check:   tmdb.IsUnauthorized,
+		},
+		{
+			name:    "canned rate limit on every route",
+			setup:   func(s *tmdbtest.Server) { s.Fail("", "", http.StatusTooManyRequests) },
+			token:   tmdbtest.APIKey,
+			movieID: tmdbtest.MovieID,
+			check:   tmdb.IsRateLimited,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := tmdbtest.NewServer()
+			defer server.Close()
+			if tt.setup != nil {
+				tt.setup(server)
+			}
+			client, err := server.ClientWithToken(tt.token)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, _, err = client.Movies.GetMovie(tt.movieID, nil)
+			if !tt.check(err) {
+				t.Errorf("unexpected error %v", err)
+			}
+		})
+	}
+}
+
+func TestServerRetryAfter(t *testing.T) {
+	server := tmdbtest.NewServer()
+	defer server.Close()
+	server.Fail(http.MethodGet, "/3/movie/550", http.StatusTooManyRequests)
+	client, err := server.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, resp, err := client.Movies.GetMovie(tmdbtest.MovieID, nil)
+	if !tmdb.IsRateLimited(err) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if got := resp.Header.Get("Retry-After"); got != "1" {
+		t.Errorf("got Retry-After %q, want 1", got)
+	}
+}
+
+func TestServerRecoverAndReset(t *testing.T) {
+	server := tmdbtest.NewServer()
+	defer server.Close()
+	client, err := server.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server.Fail(http.MethodGet, "/3/movie/550", http.StatusNotFound)
+	if _, _, err := client.Movies.GetMovie(tmdbtest.MovieID, nil); !tmdb.IsNotFound(err) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	server.Recover(http.MethodGet, "/3/movie/550")
+	if _, _, err := client.Movies.GetMovie(tmdbtest.MovieID, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := server.Handle(http.MethodGet, "/3/movie/603", http.StatusOK, tmdb.MovieDetails{ID: 603, Title: "The Matrix"}); err != nil {
+		t.Fatal(err)
+	}
+	movie, _, err := client.Movies.GetMovie(603, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if movie.Title != "The Matrix" {
+		t.Errorf("got title %q, want The Matrix", movie.Title)
+	}
+
+	requests := server.Requests()
+	if len(requests) != 3 {
+		t.Fatalf("got %d requests, want 3", len(requests))
+	}
+	if requests[2].Path != "/3/movie/603" || requests[2].Query.Get("api_key") != tmdbtest.APIKey {
+		t.Errorf("unexpected request %+v", requests[2])
+	}
+
+	server.Reset()
+	if len(server.Requests()) != 0 {
+		t.Error("got requests after reset")
+	}
+	if _, _, err := client.Movies.GetMovie(603, nil); !tmdb.IsNotFound(err) {
+		t.Errorf("got %v after reset, want the handled route removed", err)
+	}
+}
@@ -1,8 +1,12 @@
 package tmdb
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
 )
@@ -18,13 +22,13 @@ type TVShowDetails struct {
 	BackdropPath        *string             `json:"backdrop_path"`
 	CreatedBy           []TVCreatedBy       `json:"created_by"`
 	EpisodeRunTime      []int               `json:"episode_run_time"`
-	FirstAirDate        string              `json:"first_air_date"`
+	FirstAirDate        Date                `json:"first_air_date"`
 	Genres              []Genre             `json:"genres"`
 	Homepage            string              `json:"homepage"`
 	ID                  int                 `json:"id"`
 	InProduction        bool                `json:"in_production"`
 	Languages           []string            `json:"languages"`
-	LastAirDate         string              `json:"last_air_date"`
+	LastAirDate         Date                `json:"last_air_date"`
 	LastEpisodeToAir    *TVEpisode          `json:"last_episode_to_air"`
 	Name                string              `json:"name"`
 	Networks            []TVShowNetwork     `json:"networks"`
@@ -63,6 +67,71 @@ type TVShowDetails struct {
 	Similar              *SimilarTVShows          `json:"similar"`
 	Translations         *TVShowTranslations      `json:"translations"`
 	Videos               *Videos                  `json:"videos"`
+
+	// Seasons appended with TVShowDetailsOptions.AppendSeasons, by season number.
+	// They are not in Seasons, which already holds the summaries of all the seasons.
+	SeasonDetails map[int]*TVSeasonDetails `json:"-"`
+}
+
+// UnmarshalJSON decodes the tv show details and the appended seasons, keyed as season/1, season/2 and so on.
+// Each appended season is decoded once, and responses without appended seasons are decoded as usual.
+func (tv *TVShowDetails) UnmarshalJSON(data []byte) error {
+	type tvShowDetails TVShowDetails
+	var seasons map[int]*TVSeasonDetails
+	if bytes.Contains(data, []byte(`"`+seasonAppendPrefix)) {
+		var raw map[string]json.RawMessage
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return err
+		}
+		for key, value := range raw {
+			if !strings.HasPrefix(key, seasonAppendPrefix) {
+				continue
+			}
+			seasonNumber, err := strconv.Atoi(strings.TrimPrefix(key, seasonAppendPrefix))
+			if err != nil {
+				continue
+			}
+			var season TVSeasonDetails
+			if err := json.Unmarshal(value, &season); err != nil {
+				return errors.Wrapf(err, "failed to decode %s", key)
+			}
+			if seasons == nil {
+				seasons = make(map[int]*TVSeasonDetails)
+			}
+			seasons[seasonNumber] = &season
+			delete(raw, key)
+		}
+		if seasons != nil {
+			var err error
+			if data, err = json.Marshal(raw); err != nil {
+				return err
+			}
+		}
+	}
+	if err := json.Unmarshal(data, (*tvShowDetails)(tv)); err != nil {
+		return err
+	}
+	tv.SeasonDetails = seasons
+	return nil
+}
+
+// MarshalJSON encodes the tv show details and the appended seasons as they are in TMDb responses.
+func (tv TVShowDetails) MarshalJSON() ([]byte, error) {
+	type tvShowDetails TVShowDetails
+	data, err := json.Marshal(tvShowDetails(tv))
+	if err != nil || len(tv.SeasonDetails) == 0 {
+		return data, err
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	for seasonNumber, season := range tv.SeasonDetails {
+		if raw[fmt.Sprintf("%s%d", seasonAppendPrefix, seasonNumber)], err = json.Marshal(season); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(raw)
 }
 
 // TVShowDetailsOptions represents the available options for the request.
@@ -74,37 +143,25 @@ type TVShowDetailsOptions struct {
 	// If the provided language is wrong, it is ignored.
 	Language string `url:"language,omitempty" json:"language,omitempty"`
 
-	// supported values:
-	// - aggregate_credits
-	// - alternative_titles
-	// - changes
-	// - content_ratings
-	// - credits
-	// - episode_groups
-	// - external_ids
-	// - images
-	// - keywords
-	// - recommendations
-	// - reviews
-	// - screened_theatrically
-	// - similar
-	// - translations
-	// - videos
-	// provide them separated by commas, example: images,videos
-	AppendToResponse string `url:"append_to_response,omitempty" json:"append_to_response,omitempty"`
+	// Sub-resources to append to the response, example: TVAppendImages | TVAppendVideos
+	AppendToResponse TVAppend `url:"append_to_response,omitempty" json:"append_to_response,omitempty"`
+
+	// Seasons to append to the response by season number, requested as season/1,season/2.
+	// They count towards the limit of 20 appended items and are decoded in TVShowDetails.SeasonDetails.
+	AppendSeasons []int `url:"-" json:"-"`
 }
 
 // GetTVShow retrieves the primary TV show details by id.
-func (tr *TVResource) GetTVShow(tvID int, opt *TVShowDetailsOptions) (*TVShowDetails, *http.Response, error) {
+func (tr *TVResource) GetTVShow(tvID int, opt *TVShowDetailsOptions, options ...RequestOptionFn) (*TVShowDetails, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d", tvID)
 	var tvShow TVShowDetails
-	resp, err := tr.client.get(path, &tvShow, WithQueryParams(opt))
+	resp, err := tr.client.get(path, &tvShow, WithQueryParams(opt), withAppendedSeasons(opt), withOptions(options...))
 	return &tvShow, resp, errors.Wrap(err, "failed to get tv show")
 }
 
 // Season represents a tv season in TMDb.
 type Season struct {
-	AirDate      string  `json:"air_date"`
+	AirDate      Date    `json:"air_date"`
 	EpisodeCount int     `json:"episode_count"`
 	ID           int     `json:"id"`
 	Name         string  `json:"name"`
@@ -117,7 +174,7 @@ type Season struct {
 type TVShow struct {
 	Adult            bool     `json:"adult"`
 	BackdropPath     *string  `json:"backdrop_path"`
-	FirstAirDate     string   `json:"first_air_date"`
+	FirstAirDate     Date     `json:"first_air_date"`
 	GenreIDs         []int    `json:"genre_ids"`
 	ID               int      `json:"id"`
 	MediaType        string   `json:"media_type"`
@@ -134,7 +191,7 @@ type TVShow struct {
 
 // TVEpisode represents a tv episode in TMDb.
 type TVEpisode struct {
-	AirDate        string  `json:"air_date"`
+	AirDate        Date    `json:"air_date"`
 	EpisodeNumber  int     `json:"episode_number"`
 	ID             int     `json:"id"`
 	Name           string  `json:"name"`
@@ -152,7 +209,7 @@ type TVEpisode struct {
 type TVShowResult struct {
 	Adult            bool     `json:"adult"`
 	BackdropPath     *string  `json:"backdrop_path"`
-	FirstAirDate     string   `json:"first_air_date"`
+	FirstAirDate     Date     `json:"first_air_date"`
 	GenreIDs         []int    `json:"genre_ids"`
 	ID               int      `json:"id"`
 	Name             string   `json:"name"`
@@ -167,7 +224,7 @@ type TVShowResult struct {
 }
 
 type paginatedTVShows struct {
-	pagination
+	Pagination
 	TVShows []TVShowResult `json:"results"`
 }
 
@@ -175,10 +232,10 @@ type paginatedTVShows struct {
 // - Movie rating
 // - If it belongs to the watchlist
 // - If it belongs to the favorite list
-func (tr *TVResource) GetAccountStates(tvID int, sessionID string) (*AccountStates, *http.Response, error) {
+func (tr *TVResource) GetAccountStates(tvID int, sessionID string, options ...RequestOptionFn) (*AccountStates, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/account_states", tvID)
 	var states AccountStates
-	resp, err := tr.client.get(path, &states, WithSessionID(sessionID))
+	resp, err := tr.client.get(path, &states, WithSessionID(sessionID), withOptions(options...))
 	return &states, resp, errors.Wrap(err, "failed to get account states")
 }
 
@@ -239,10 +296,10 @@ type AggregateCredits struct {
 // GetAggregateCredits retrieves the aggregate credits (cast and crew) that have been added to a TV show.
 // This call differs from the main `credits` call in that it does not return the newest season but rather,
 // is a view of all the entire cast & crew for all episodes belonging to a TV show.
-func (tr *TVResource) GetAggregateCredits(tvID int, opt *AggregateCreditsOptions) (*AggregateCredits, *http.Response, error) {
+func (tr *TVResource) GetAggregateCredits(tvID int, opt *AggregateCreditsOptions, options ...RequestOptionFn) (*AggregateCredits, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/aggregate_credits", tvID)
 	var credits AggregateCredits
-	resp, err := tr.client.get(path, &credits, WithQueryParams(opt))
+	resp, err := tr.client.get(path, &credits, WithQueryParams(opt), withOptions(options...))
 	return &credits, resp, errors.Wrap(err, "failed to get aggregate credits")
 }
 
@@ -256,10 +313,10 @@ type TVShowAlternativeTitles struct {
 }
 
 // GetAlternativeTitles retrieves all of the alternative titles for a tv show.
-func (tr *TVResource) GetAlternativeTitles(tvID int, opt *TVShowAlternativeTitlesOptions) (*TVShowAlternativeTitles, *http.Response, error) {
+func (tr *TVResource) GetAlternativeTitles(tvID int, opt *TVShowAlternativeTitlesOptions, options ...RequestOptionFn) (*TVShowAlternativeTitles, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/alternative_titles", tvID)
 	var titles TVShowAlternativeTitles
-	resp, err := tr.client.get(path, &titles, WithQueryParams(opt))
+	resp, err := tr.client.get(path, &titles, WithQueryParams(opt), withOptions(options...))
 	return &titles, resp, errors.Wrap(err, "failed to get alternative titles")
 }
 
@@ -269,10 +326,10 @@ func (tr *TVResource) GetAlternativeTitles(tvID int, opt *TVShowAlternativeTitle
 // that will create a change entry at the show level.
 // These can be found under the season and episode keys.
 // These keys will contain a series_id and episode_id.
-func (tr *TVResource) GetChanges(tvID int, opt *ChangesOptions) (*Changes, *http.Response, error) {
+func (tr *TVResource) GetChanges(tvID int, opt *ChangesOptions, options ...RequestOptionFn) (*Changes, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/changes", tvID)
 	var changes Changes
-	resp, err := tr.client.get(path, &changes, WithQueryParams(opt))
+	resp, err := tr.client.get(path, &changes, WithQueryParams(opt), withOptions(options...))
 	return &changes, resp, errors.Wrap(err, "failed to get changes")
 }
 
@@ -292,10 +349,10 @@ type ContentRatings struct {
 }
 
 // GetContentRatings retrieves the list of content ratings (certifications) that have been added to a TV show.
-func (tr *TVResource) GetContentRatings(tvID int, opt *ContentRatingsOptions) (*ContentRatings, *http.Response, error) {
+func (tr *TVResource) GetContentRatings(tvID int, opt *ContentRatingsOptions, options ...RequestOptionFn) (*ContentRatings, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/content_ratings", tvID)
 	var ratings ContentRatings
-	resp, err := tr.client.get(path, &ratings, WithQueryParams(opt))
+	resp, err := tr.client.get(path, &ratings, WithQueryParams(opt), withOptions(options...))
 	return &ratings, resp, errors.Wrap(err, "failed to get content ratings")
 }
 
@@ -337,10 +394,10 @@ type TVShowCredits struct {
 }
 
 // GetCredits retrieves the credits (cast and crew) that have been added to a TV show.
-func (tr *TVResource) GetCredits(tvID int, opt *CreditsOptions) (*TVShowCredits, *http.Response, error) {
+func (tr *TVResource) GetCredits(tvID int, opt *CreditsOptions, options ...RequestOptionFn) (*TVShowCredits, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/credits", tvID)
 	var credits TVShowCredits
-	resp, err := tr.client.get(path, &credits, WithQueryParams(opt))
+	resp, err := tr.client.get(path, &credits, WithQueryParams(opt), withOptions(options...))
 	return &credits, resp, errors.Wrap(err, "failed to get credits")
 }
 
@@ -354,13 +411,13 @@ type TVShowNetwork struct {
 
 // TVShowEpisodeGroup represents tv show episode group in TMDb.
 type TVShowEpisodeGroup struct {
-	Description  string        `json:"description"`
-	EpisodeCount int           `json:"episode_count"`
-	GroupCount   int           `json:"group_count"`
-	ID           string        `json:"id"`
-	Name         string        `json:"name"`
-	Network      TVShowNetwork `json:"network"`
-	Type         int           `json:"type"`
+	Description  string           `json:"description"`
+	EpisodeCount int              `json:"episode_count"`
+	GroupCount   int              `json:"group_count"`
+	ID           string           `json:"id"`
+	Name         string           `json:"name"`
+	Network      TVShowNetwork    `json:"network"`
+	Type         EpisodeGroupType `json:"type"`
 }
 
 // EpisodeGroups represents episode groups in TMDb.
@@ -373,10 +430,10 @@ type EpisodeGroups struct {
 type EpisodeGroupsOptions languageOptions
 
 // GetEpisodeGroups retrieves all of the episode groups that have been created for a TV show.
-func (tr *TVResource) GetEpisodeGroups(tvID int, opt *EpisodeGroupsOptions) (*EpisodeGroups, *http.Response, error) {
+func (tr *TVResource) GetEpisodeGroups(tvID int, opt *EpisodeGroupsOptions, options ...RequestOptionFn) (*EpisodeGroups, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/episode_groups", tvID)
 	var credits EpisodeGroups
-	resp, err := tr.client.get(path, &credits, WithQueryParams(opt))
+	resp, err := tr.client.get(path, &credits, WithQueryParams(opt), withOptions(options...))
 	return &credits, resp, errors.Wrap(err, "failed to get episode groups")
 }
 
@@ -397,10 +454,10 @@ type TVShowExternalIDs struct {
 type ExternalIDsOptions languageOptions
 
 // GetExternalIDs retrieves the external ids for a TV show.
-func (tr *TVResource) GetExternalIDs(tvID int, opt *ExternalIDsOptions) (*TVShowExternalIDs, *http.Response, error) {
+func (tr *TVResource) GetExternalIDs(tvID int, opt *ExternalIDsOptions, options ...RequestOptionFn) (*TVShowExternalIDs, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/external_ids", tvID)
 	var ids TVShowExternalIDs
-	resp, err := tr.client.get(path, &ids, WithQueryParams(opt))
+	resp, err := tr.client.get(path, &ids, WithQueryParams(opt), withOptions(options...))
 	return &ids, resp, errors.Wrap(err, "failed to get external ids")
 }
 
@@ -408,10 +465,10 @@ func (tr *TVResource) GetExternalIDs(tvID int, opt *ExternalIDsOptions) (*TVShow
 // Querying images with a language parameter will filter the results.
 // To include a fallback language (especially useful for backdrops), use the include_image_language parameter.
 // This should be a comma separated value like so: include_image_language=en,null.
-func (tr *TVResource) GetImages(tvID int, opt *ImagesOptions) (*Images, *http.Response, error) {
+func (tr *TVResource) GetImages(tvID int, opt *ImagesOptions, options ...RequestOptionFn) (*Images, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/images", tvID)
 	var images Images
-	resp, err := tr.client.get(path, &images, WithQueryParams(opt))
+	resp, err := tr.client.get(path, &images, WithQueryParams(opt), withOptions(options...))
 	return &images, resp, errors.Wrap(err, "failed to get images")
 }
 
@@ -422,10 +479,10 @@ type TVShowKeywords struct {
 }
 
 // GetKeywords retrieves the keywords that have been added to a TV show.
-func (tr *TVResource) GetKeywords(tvID int) (*TVShowKeywords, *http.Response, error) {
+func (tr *TVResource) GetKeywords(tvID int, options ...RequestOptionFn) (*TVShowKeywords, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/keywords", tvID)
 	var keywords TVShowKeywords
-	resp, err := tr.client.get(path, &keywords)
+	resp, err := tr.client.get(path, &keywords, options...)
 	return &keywords, resp, errors.Wrap(err, "failed to get keywords")
 }
 
@@ -434,15 +491,15 @@ type RecommendationsOptions languagePageOptions
 
 // RecommendedTVShows represents recommended tv shows in TMDb.
 type RecommendedTVShows struct {
-	pagination
+	Pagination
 	TVShows []TVShow `json:"results"`
 }
 
 // GetRecommendations retrieves the list of TV show recommendations for this item.
-func (tr *TVResource) GetRecommendations(tvID int, opt *RecommendationsOptions) (*RecommendedTVShows, *http.Response, error) {
+func (tr *TVResource) GetRecommendations(tvID int, opt *RecommendationsOptions, options ...RequestOptionFn) (*RecommendedTVShows, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/recommendations", tvID)
 	var tvShows RecommendedTVShows
-	resp, err := tr.client.get(path, &tvShows, WithQueryParams(opt))
+	resp, err := tr.client.get(path, &tvShows, WithQueryParams(opt), withOptions(options...))
 	return &tvShows, resp, errors.Wrap(err, "failed to get tv shows recommendations")
 }
 
@@ -451,16 +508,16 @@ type ReviewsOptions languagePageOptions
 
 // TVShowReviews represents tv show reviews in TMDb.
 type TVShowReviews struct {
-	pagination
+	Pagination
 	ID      *int     `json:"id"`
 	Reviews []Review `json:"results"`
 }
 
 // GetReviews retrieves the reviews for a TV show.
-func (tr *TVResource) GetReviews(tvID int, opt *ReviewsOptions) (*TVShowReviews, *http.Response, error) {
+func (tr *TVResource) GetReviews(tvID int, opt *ReviewsOptions, options ...RequestOptionFn) (*TVShowReviews, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/reviews", tvID)
 	var reviews TVShowReviews
-	resp, err := tr.client.get(path, &reviews, WithQueryParams(opt))
+	resp, err := tr.client.get(path, &reviews, WithQueryParams(opt), withOptions(options...))
 	return &reviews, resp, errors.Wrap(err, "failed to get reviews")
 }
 
@@ -478,10 +535,10 @@ type ScreenedTheatrically struct {
 }
 
 // GetScreenedTheatrically retrieves a list of seasons or episodes that have been screened in a film festival or theatre.
-func (tr *TVResource) GetScreenedTheatrically(tvID int) (*ScreenedTheatrically, *http.Response, error) {
+func (tr *TVResource) GetScreenedTheatrically(tvID int, options ...RequestOptionFn) (*ScreenedTheatrically, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/screened_theatrically", tvID)
 	var screenedTheatrically ScreenedTheatrically
-	resp, err := tr.client.get(path, &screenedTheatrically)
+	resp, err := tr.client.get(path, &screenedTheatrically, options...)
 	return &screenedTheatrically, resp, errors.Wrap(err, "failed to get screened theatrically info")
 }
 
@@ -492,10 +549,10 @@ type SimilarTVShows paginatedTVShows
 type SimilarTVShowsOptions languagePageOptions
 
 // GetSimilar retrieves a list of similar TV shows. These items are assembled by looking at keywords and genres.
-func (tr *TVResource) GetSimilar(tvID int, opt *SimilarTVShowsOptions) (*SimilarTVShows, *http.Response, error) {
+func (tr *TVResource) GetSimilar(tvID int, opt *SimilarTVShowsOptions, options ...RequestOptionFn) (*SimilarTVShows, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/similar", tvID)
 	var similar SimilarTVShows
-	resp, err := tr.client.get(path, &similar, WithQueryParams(opt))
+	resp, err := tr.client.get(path, &similar, WithQueryParams(opt), withOptions(options...))
 	return &similar, resp, errors.Wrap(err, "failed to get similar tv shows")
 }
 
@@ -523,18 +580,18 @@ type TVShowTranslations struct {
 }
 
 // GetTranslations retrieves a list of the translations that exist for a TV show.
-func (tr *TVResource) GetTranslations(tvID int) (*TVShowTranslations, *http.Response, error) {
+func (tr *TVResource) GetTranslations(tvID int, options ...RequestOptionFn) (*TVShowTranslations, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/translations", tvID)
 	var translations TVShowTranslations
-	resp, err := tr.client.get(path, &translations)
+	resp, err := tr.client.get(path, &translations, options...)
 	return &translations, resp, errors.Wrap(err, "failed to get translations")
 }
 
 // GetVideos retrieves the videos that have been added to a TV show.
-func (tr *TVResource) GetVideos(tvID int, opt *VideosOptions) (*Videos, *http.Response, error) {
+func (tr *TVResource) GetVideos(tvID int, opt *VideosOptions, options ...RequestOptionFn) (*Videos, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/videos", tvID)
 	var videos Videos
-	resp, err := tr.client.get(path, &videos, WithQueryParams(opt))
+	resp, err := tr.client.get(path, &videos, WithQueryParams(opt), withOptions(options...))
 	return &videos, resp, errors.Wrap(err, "failed to get tv show videos")
 }
 
@@ -544,10 +601,10 @@ func (tr *TVResource) GetVideos(tvID int, opt *VideosOptions) (*Videos, *http.Re
 // Link to the provided TMDB URL to help support TMDB and provide the actual deep links to the content.
 // Please note: In order to use this data it's REQUIRED to attribute the source of the data as JustWatch.
 // If any usage is found not complying with these terms the access to the API will be revoked.
-func (tr *TVResource) GetWatchProviders(tvID int) (*WatchProviders, *http.Response, error) {
+func (tr *TVResource) GetWatchProviders(tvID int, options ...RequestOptionFn) (*WatchProviders, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/watch/providers", tvID)
 	var providers WatchProviders
-	resp, err := tr.client.get(path, &providers)
+	resp, err := tr.client.get(path, &providers, options...)
 	return &providers, resp, errors.Wrap(err, "failed to get tv show watch providers")
 }
 
@@ -566,13 +623,13 @@ type LatestTVShow struct {
 	BackdropPath        *string             `json:"backdrop_path"`
 	CreatedBy           []TVCreatedBy       `json:"created_by"`
 	EpisodeRunTime      []int               `json:"episode_run_time"`
-	FirstAirDate        string              `json:"first_air_date"`
+	FirstAirDate        Date                `json:"first_air_date"`
 	Genres              []Genre             `json:"genres"`
 	Homepage            string              `json:"homepage"`
 	ID                  int                 `json:"id"`
 	InProduction        bool                `json:"in_production"`
 	Languages           []string            `json:"languages"`
-	LastAirDate         string              `json:"last_air_date"`
+	LastAirDate         Date                `json:"last_air_date"`
 	LastEpisodeToAir    *TVEpisode          `json:"last_episode_to_air"`
 	Name                string              `json:"name"`
 	Networks            []TVShowNetwork     `json:"networks"`
@@ -597,17 +654,17 @@ type LatestTVShow struct {
 }
 
 // GetLatest retrieves the most newly created TV show. This is a live response and will continuously change.
-func (tr *TVResource) GetLatest(opt *LatestOptions) (*LatestTVShow, *http.Response, error) {
+func (tr *TVResource) GetLatest(opt *LatestOptions, options ...RequestOptionFn) (*LatestTVShow, *http.Response, error) {
 	path := "/tv/latest"
 	var latest LatestTVShow
-	resp, err := tr.client.get(path, &latest, WithQueryParams(opt))
+	resp, err := tr.client.get(path, &latest, WithQueryParams(opt), withOptions(options...))
 	return &latest, resp, errors.Wrap(err, "failed to get latest tv show")
 }
 
 // TVShowAiring represents a airing tv show in TMDb.
 type TVShowAiring struct {
 	BackdropPath     *string  `json:"backdrop_path"`
-	FirstAirDate     string   `json:"first_air_date"`
+	FirstAirDate     Date     `json:"first_air_date"`
 	GenreIDs         []int    `json:"genre_ids"`
 	ID               int      `json:"id"`
 	Name             string   `json:"name"`
@@ -623,7 +680,7 @@ type TVShowAiring struct {
 
 // TVShowsAiring represents airing tv shows in TMDb.
 type TVShowsAiring struct {
-	pagination
+	Pagination
 	TVShows []TVShowAiring `json:"results"`
 }
 
@@ -632,36 +689,36 @@ type TVShowsAiringOptions languagePageOptions
 
 // GetAiringToday retrieves a list of TV shows that are airing today.
 // This query is purely day based as TMDb currently doesn't support airing times.
-func (tr *TVResource) GetAiringToday(opt *TVShowsAiringOptions) (*TVShowsAiring, *http.Response, error) {
+func (tr *TVResource) GetAiringToday(opt *TVShowsAiringOptions, options ...RequestOptionFn) (*TVShowsAiring, *http.Response, error) {
 	path := "/tv/airing_today"
 	var tvShows TVShowsAiring
-	resp, err := tr.client.get(path, &tvShows, WithQueryParams(opt))
+	resp, err := tr.client.get(path, &tvShows, WithQueryParams(opt), withOptions(options...))
 	return &tvShows, resp, errors.Wrap(err, "failed to get airing today")
 }
 
 // GetTVShowsChanges retrieves a list of all of the movie ids that have been changed in the past 24 hours.
 // Query it for up to 14 days worth of changed IDs at a time with the start_date and end_date query parameters.
 // 100 items are returned per page.
-func (tr *TVResource) GetTVShowsChanges(opt *ChangesOptions) (*MediaChanges, *http.Response, error) {
+func (tr *TVResource) GetTVShowsChanges(opt *ChangesOptions, options ...RequestOptionFn) (*MediaChanges, *http.Response, error) {
 	path := "/tv/changes"
 	var changes MediaChanges
-	resp, err := tr.client.get(path, &changes, WithQueryParams(opt))
+	resp, err := tr.client.get(path, &changes, WithQueryParams(opt), withOptions(options...))
 	return &changes, resp, errors.Wrap(err, "failed to get tv shows changes")
 }
 
 // GetOnTheAir retrieves a list of shows that are currently on the air.
 // This query looks for any TV show that has an episode with an air date in the next 7 days.
-func (tr *TVResource) GetOnTheAir(opt *TVShowsAiringOptions) (*TVShowsAiring, *http.Response, error) {
+func (tr *TVResource) GetOnTheAir(opt *TVShowsAiringOptions, options ...RequestOptionFn) (*TVShowsAiring, *http.Response, error) {
 	path := "/tv/on_the_air"
 	var tvShows TVShowsAiring
-	resp, err := tr.client.get(path, &tvShows, WithQueryParams(opt))
+	resp, err := tr.client.get(path, &tvShows, WithQueryParams(opt), withOptions(options...))
 	return &tvShows, resp, errors.Wrap(err, "failed to get on the air")
 }
 
 // PopularTVShow represents a popular tv show in TMDb.
 type PopularTVShow struct {
 	BackdropPath     *string  `json:"backdrop_path"`
-	FirstAirDate     string   `json:"first_air_date"`
+	FirstAirDate     Date     `json:"first_air_date"`
 	GenreIDs         []int    `json:"genre_ids"`
 	ID               int      `json:"id"`
 	Name             string   `json:"name"`
@@ -677,7 +734,7 @@ type PopularTVShow struct {
 
 // PopularTVShows represents popular tv shows in TMDb.
 type PopularTVShows struct {
-	pagination
+	Pagination
 	TVShows []PopularTVShow `json:"results"`
 }
 
@@ -685,17 +742,17 @@ type PopularTVShows struct {
 type PopularTVShowsOptions languagePageOptions
 
 // GetPopular retrieves a list of the current popular TV shows on TMDB. This list updates daily.
-func (tr *TVResource) GetPopular(opt *PopularTVShowsOptions) (*PopularTVShows, *http.Response, error) {
+func (tr *TVResource) GetPopular(opt *PopularTVShowsOptions, options ...RequestOptionFn) (*PopularTVShows, *http.Response, error) {
 	path := "/tv/popular"
 	var popular PopularTVShows
-	resp, err := tr.client.get(path, &popular, WithQueryParams(opt))
+	resp, err := tr.client.get(path, &popular, WithQueryParams(opt), withOptions(options...))
 	return &popular, resp, errors.Wrap(err, "failed to get popular tv shows")
 }
 
 // TopRatedTVShow represents a top rated tv show in TMDb.
 type TopRatedTVShow struct {
 	BackdropPath     *string  `json:"backdrop_path"`
-	FirstAirDate     string   `json:"first_air_date"`
+	FirstAirDate     Date     `json:"first_air_date"`
 	GenreIDs         []int    `json:"genre_ids"`
 	ID               int      `json:"id"`
 	Name             string   `json:"name"`
@@ -711,7 +768,7 @@ type TopRatedTVShow struct {
 
 // TopRatedTVShows represents top rated tv shows in TMDb.
 type TopRatedTVShows struct {
-	pagination
+	Pagination
 	TVShows []TopRatedTVShow `json:"results"`
 }
 
@@ -719,16 +776,16 @@ type TopRatedTVShows struct {
 type TopRatedTVShowOptions languagePageOptions
 
 // GetTopRated retrieves a list of the top rated TV shows on TMDB.
-func (tr *TVResource) GetTopRated(opt *TopRatedTVShowOptions) (*TopRatedTVShows, *http.Response, error) {
+func (tr *TVResource) GetTopRated(opt *TopRatedTVShowOptions, options ...RequestOptionFn) (*TopRatedTVShows, *http.Response, error) {
 	path := "/tv/popular"
 	var topRated TopRatedTVShows
-	resp, err := tr.client.get(path, &topRated, WithQueryParams(opt))
+	resp, err := tr.client.get(path, &topRated, WithQueryParams(opt), withOptions(options...))
 	return &topRated, resp, errors.Wrap(err, "failed to get top rated tv shows")
 }
 
 // GroupEpisode represents a group episode in TMDb.
 type GroupEpisode struct {
-	AirDate        string  `json:"air_date"`
+	AirDate        Date    `json:"air_date"`
 	EpisodeNumber  int     `json:"episode_number"`
 	ID             int     `json:"id"`
 	Name           string  `json:"name"`
@@ -754,14 +811,14 @@ type Group struct {
 
 // EpisodeGroup represents an episode group in TMDb.
 type EpisodeGroup struct {
-	Description  string        `json:"description"`
-	EpisodeCount int           `json:"episode_count"`
-	GroupCount   int           `json:"group_count"`
-	Groups       []Group       `json:"groups"`
-	ID           string        `json:"id"`
-	Name         string        `json:"name"`
-	Network      TVShowNetwork `json:"network"`
-	Type         int           `json:"type"`
+	Description  string           `json:"description"`
+	EpisodeCount int              `json:"episode_count"`
+	GroupCount   int              `json:"group_count"`
+	Groups       []Group          `json:"groups"`
+	ID           string           `json:"id"`
+	Name         string           `json:"name"`
+	Network      TVShowNetwork    `json:"network"`
+	Type         EpisodeGroupType `json:"type"`
 }
 
 // EpisodeGroupOptions represents the available options for the request.
@@ -776,27 +833,27 @@ type EpisodeGroupOptions languageOptions
 // 5. Story arc
 // 6. Production
 // 7. TV
-func (tr *TVResource) GetEpisodeGroup(groupID string, opt *EpisodeGroupOptions) (*EpisodeGroup, *http.Response, error) {
+func (tr *TVResource) GetEpisodeGroup(groupID string, opt *EpisodeGroupOptions, options ...RequestOptionFn) (*EpisodeGroup, *http.Response, error) {
 	path := fmt.Sprintf("/tv/episode_group/%s", groupID)
 	var groups EpisodeGroup
-	resp, err := tr.client.get(path, &groups, WithQueryParams(opt))
+	resp, err := tr.client.get(path, &groups, WithQueryParams(opt), withOptions(options...))
 	return &groups, resp, errors.Wrap(err, "failed to get episode groups")
 }
 
 // Rate rates a TV show.
 // A valid session or guest session ID is required.
-func (tr *TVResource) Rate(tvID int, rating float64, sessionID Auth) (*RateResponse, *http.Response, error) {
+func (tr *TVResource) Rate(tvID int, rating float64, sessionID Auth, options ...RequestOptionFn) (*RateResponse, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/rating", tvID)
 	var response RateResponse
-	resp, err := tr.client.post(path, &response, WithBody(map[string]float64{"value": rating}), WithQueryParams(sessionID))
+	resp, err := tr.client.post(path, &response, WithBody(ratingValue{rating}), WithQueryParams(sessionID), withOptions(options...))
 	return &response, resp, errors.Wrap(err, "failed to rate tv show")
 }
 
 // DeleteRating removes a rating for a TV show.
 // A valid session or guest session ID is required.
-func (tr *TVResource) DeleteRating(movieID int, sessionID Auth) (*DeleteRatingResponse, *http.Response, error) {
+func (tr *TVResource) DeleteRating(movieID int, sessionID Auth, options ...RequestOptionFn) (*DeleteRatingResponse, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/rating", movieID)
 	var response DeleteRatingResponse
-	resp, err := tr.client.delete(path, &response, WithQueryParams(sessionID))
+	resp, err := tr.client.delete(path, &response, WithQueryParams(sessionID), withOptions(options...))
 	return &response, resp, errors.Wrap(err, "failed to delete tv show rating")
 }
@@ -14,7 +14,7 @@ type TVEpisodesResource struct {
 
 // TVEpisodeDetails represents tv episode details in TMDb.
 type TVEpisodeDetails struct {
-	AirDate        string       `json:"air_date"`
+	AirDate        Date         `json:"air_date"`
 	Crew           []TVShowCrew `json:"crew"`
 	EpisodeNumber  int          `json:"episode_number"`
 	GuestStars     []TVShowCast `json:"guest_stars"`
@@ -56,33 +56,33 @@ type TVEpisodeDetailsOptions struct {
 }
 
 // GetEpisode retrieves the TV episode details by id.
-func (tr *TVEpisodesResource) GetEpisode(tvID, seasonNumber, episodeNumber int, opt *TVEpisodeDetailsOptions) (*TVEpisodeDetails, *http.Response, error) {
+func (tr *TVEpisodesResource) GetEpisode(tvID, seasonNumber, episodeNumber int, opt *TVEpisodeDetailsOptions, options ...RequestOptionFn) (*TVEpisodeDetails, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/season/%d/episode/%d", tvID, seasonNumber, episodeNumber)
 	var episode TVEpisodeDetails
-	resp, err := tr.client.get(path, &episode, WithQueryParams(opt))
+	resp, err := tr.client.get(path, &episode, WithQueryParams(opt), withOptions(options...))
 	return &episode, resp, errors.Wrap(err, "failed to get episode")
 }
 
 // AccountStatesEpisode represents account states for a episode in TMDb.
 type AccountStatesEpisode struct {
-	ID    int         `json:"id"`
-	Rated interface{} `json:"rated"`
+	ID    int        `json:"id"`
+	Rated UserRating `json:"rated"`
 }
 
 // GetAccountStates returns all of the user ratings for the season's episodes.
-func (tr *TVEpisodesResource) GetAccountStates(tvID, seasonNumber, episodeNumber int, sessionID string) (*AccountStatesEpisode, *http.Response, error) {
+func (tr *TVEpisodesResource) GetAccountStates(tvID, seasonNumber, episodeNumber int, sessionID string, options ...RequestOptionFn) (*AccountStatesEpisode, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/season/%d/episode/%d/account_states", tvID, seasonNumber, episodeNumber)
 	var states AccountStatesEpisode
-	resp, err := tr.client.get(path, &states, WithSessionID(sessionID))
+	resp, err := tr.client.get(path, &states, WithSessionID(sessionID), withOptions(options...))
 	return &states, resp, errors.Wrap(err, "failed to get account states")
 }
 
 // GetChanges retrieves the changes for a TV episode. By default only the last 24 hours are returned.
 // Query up to 14 days in a single query by using the start_date and end_date query parameters.
-func (tr *TVEpisodesResource) GetChanges(episodeID int, opt *ChangesOptions) (*Changes, *http.Response, error) {
+func (tr *TVEpisodesResource) GetChanges(episodeID int, opt *ChangesOptions, options ...RequestOptionFn) (*Changes, *http.Response, error) {
 	path := fmt.Sprintf("/tv/episode/%d/changes", episodeID)
 	var changes Changes
-	resp, err := tr.client.get(path, &changes, WithQueryParams(opt))
+	resp, err := tr.client.get(path, &changes, WithQueryParams(opt), withOptions(options...))
 	return &changes, resp, errors.Wrap(err, "failed to get episode changes")
 }
 
@@ -95,10 +95,10 @@ type TVEpisodeCredits struct {
 }
 
 // GetCredits retrieves the credits (cast, crew and guest stars) for a TV episode.
-func (tr *TVEpisodesResource) GetCredits(tvID, seasonNumber, episodeNumber int, opt *CreditsOptions) (*TVEpisodeCredits, *http.Response, error) {
+func (tr *TVEpisodesResource) GetCredits(tvID, seasonNumber, episodeNumber int, opt *CreditsOptions, options ...RequestOptionFn) (*TVEpisodeCredits, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/season/%d/episode/%d/credits", tvID, seasonNumber, episodeNumber)
 	var credits TVEpisodeCredits
-	resp, err := tr.client.get(path, &credits, WithQueryParams(opt))
+	resp, err := tr.client.get(path, &credits, WithQueryParams(opt), withOptions(options...))
 	return &credits, resp, errors.Wrap(err, "failed to get credits")
 }
 
@@ -113,10 +113,10 @@ type TVEpisodeExternalIDs struct {
 }
 
 // GetExternalIDs retrieves the external ids for a TV season.
-func (tr *TVEpisodesResource) GetExternalIDs(tvID, seasonNumber, episodeNumber int, opt *ExternalIDsOptions) (*TVEpisodeExternalIDs, *http.Response, error) {
+func (tr *TVEpisodesResource) GetExternalIDs(tvID, seasonNumber, episodeNumber int, opt *ExternalIDsOptions, options ...RequestOptionFn) (*TVEpisodeExternalIDs, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/season/%d/episode/%d/external_ids", tvID, seasonNumber, episodeNumber)
 	var ids TVEpisodeExternalIDs
-	resp, err := tr.client.get(path, &ids, WithQueryParams(opt))
+	resp, err := tr.client.get(path, &ids, WithQueryParams(opt), withOptions(options...))
 	return &ids, resp, errors.Wrap(err, "failed to get external ids")
 }
 
@@ -133,10 +133,10 @@ type TVEpisodeImages struct {
 // Querying images with a language parameter will filter the results.
 // To include a fallback language (especially useful for backdrops), use the include_image_language parameter.
 // This should be a comma separated value like so: include_image_language=en,null.
-func (tr *TVEpisodesResource) GetImages(tvID, seasonNumber, episodeNumber int, opt *ImagesOptions) (*TVEpisodeImages, *http.Response, error) {
+func (tr *TVEpisodesResource) GetImages(tvID, seasonNumber, episodeNumber int, opt *ImagesOptions, options ...RequestOptionFn) (*TVEpisodeImages, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/season/%d/episode/%d/images", tvID, seasonNumber, episodeNumber)
 	var images TVEpisodeImages
-	resp, err := tr.client.get(path, &images, WithQueryParams(opt))
+	resp, err := tr.client.get(path, &images, WithQueryParams(opt), withOptions(options...))
 	return &images, resp, errors.Wrap(err, "failed to get images")
 }
 
@@ -162,35 +162,35 @@ type TVEpisodeTranslations struct {
 }
 
 // GetTranslations retrieves a list of the translations that exist for a TV show.
-func (tr *TVEpisodesResource) GetTranslations(tvID, seasonNumber, episodeNumber int) (*TVEpisodeTranslations, *http.Response, error) {
+func (tr *TVEpisodesResource) GetTranslations(tvID, seasonNumber, episodeNumber int, options ...RequestOptionFn) (*TVEpisodeTranslations, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/season/%d/episode/%d/translations", tvID, seasonNumber, episodeNumber)
 	var translations TVEpisodeTranslations
-	resp, err := tr.client.get(path, &translations)
+	resp, err := tr.client.get(path, &translations, options...)
 	return &translations, resp, errors.Wrap(err, "failed to get translations")
 }
 
 // GetVideos retrieves the videos that have been added to a TV season.
-func (tr *TVEpisodesResource) GetVideos(tvID, seasonNumber, episodeNumber int, opt *VideosOptions) (*Videos, *http.Response, error) {
+func (tr *TVEpisodesResource) GetVideos(tvID, seasonNumber, episodeNumber int, opt *VideosOptions, options ...RequestOptionFn) (*Videos, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/season/%d/episode/%d/videos", tvID, seasonNumber, episodeNumber)
 	var videos Videos
-	resp, err := tr.client.get(path, &videos, WithQueryParams(opt))
+	resp, err := tr.client.get(path, &videos, WithQueryParams(opt), withOptions(options...))
 	return &videos, resp, errors.Wrap(err, "failed to get tv show videos")
 }
 
 // Rate rates a TV episode.
 // A valid session or guest session ID is required.
-func (tr *TVEpisodesResource) Rate(tvID, seasonNumber, episodeNumber int, rating float64, sessionID Auth) (*RateResponse, *http.Response, error) {
+func (tr *TVEpisodesResource) Rate(tvID, seasonNumber, episodeNumber int, rating float64, sessionID Auth, options ...RequestOptionFn) (*RateResponse, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/season/%d/episode/%d/rating", tvID, seasonNumber, episodeNumber)
 	var response RateResponse
-	resp, err := tr.client.post(path, &response, WithBody(map[string]float64{"value": rating}), WithQueryParams(sessionID))
+	resp, err := tr.client.post(path, &response, WithBody(ratingValue{rating}), WithQueryParams(sessionID), withOptions(options...))
 	return &response, resp, errors.Wrap(err, "failed to rate tv show episode")
 }
 
 // DeleteRating removes a rating for a TV episode.
 // A valid session or guest session ID is required.
-func (tr *TVEpisodesResource) DeleteRating(tvID, seasonNumber, episodeNumber int, sessionID Auth) (*DeleteRatingResponse, *http.Response, error) {
+func (tr *TVEpisodesResource) DeleteRating(tvID, seasonNumber, episodeNumber int, sessionID Auth, options ...RequestOptionFn) (*DeleteRatingResponse, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/season/%d/episode/%d/rating", tvID, seasonNumber, episodeNumber)
 	var response DeleteRatingResponse
-	resp, err := tr.client.delete(path, &response, WithQueryParams(sessionID))
+	resp, err := tr.client.delete(path, &response, WithQueryParams(sessionID), withOptions(options...))
 	return &response, resp, errors.Wrap(err, "failed to delete tv show episode rating")
 }
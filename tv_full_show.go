@@ -0,0 +1,190 @@
+package tmdb
+
+import (
+	"math/bits"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultFullShowConcurrency is the default maximum number of concurrent requests of GetFullShow.
+const DefaultFullShowConcurrency = 4
+
+// FullTVShowOptions represents the available options for GetFullShow.
+type FullTVShowOptions struct {
+	// Pass a ISO 639-1 value to display translated data for the fields that support it.
+	// minLength: 2
+	// pattern: ([a-z]{2})-([A-Z]{2})
+	// default: en-US
+	// If the provided language is wrong, it is ignored.
+	Language string `url:"language,omitempty" json:"language,omitempty"`
+
+	// Sub-resources to append to the tv show details, example: TVAppendCredits | TVAppendExternalIDs
+	AppendToResponse TVAppend `url:"append_to_response,omitempty" json:"append_to_response,omitempty"`
+
+	// Enrich each episode with its external ids, which takes one more request per episode.
+	EpisodeExternalIDs bool `url:"-" json:"episode_external_ids,omitempty"`
+
+	// Enrich each episode with its credits, which takes one more request per episode.
+	EpisodeCredits bool `url:"-" json:"episode_credits,omitempty"`
+
+	// Maximum number of concurrent requests, DefaultFullShowConcurrency if not set.
+	Concurrency int `url:"-" json:"concurrency,omitempty"`
+}
+
+// Validate checks the options.
+func (opt *FullTVShowOptions) Validate() error {
+	errs := checkFields(opt)
+	if opt != nil && opt.Concurrency < 0 {
+		errs.add("concurrency", opt.Concurrency, "must not be negative")
+	}
+	return errs.err()
+}
+
+// concurrency retrieves the maximum number of concurrent requests.
+func (opt *FullTVShowOptions) concurrency() int {
+	if opt.Concurrency == 0 {
+		return DefaultFullShowConcurrency
+	}
+	return opt.Concurrency
+}
+
+// episodeAppendToResponse retrieves the sub-resources to append to each episode, if any.
+func (opt *FullTVShowOptions) episodeAppendToResponse() string {
+	var names []string
+	if opt.EpisodeCredits {
+		names = append(names, "credits")
+	}
+	if opt.EpisodeExternalIDs {
+		names = append(names, "external_ids")
+	}
+	return strings.Join(names, ",")
+}
+
+// GetFullShow retrieves the TV show details along with the details of all its seasons and their episodes,
+// in TVShowDetails.SeasonDetails.
+// Seasons are appended to tv show details requests, 20 at a time, starting with the first request.
+// When requested, each episode is then enriched with its credits and external ids, one request per episode.
+// Requests after the first one run concurrently, up to the concurrency of the options.
+// The response is the one of the first tv show details request.
+func (tr *TVResource) GetFullShow(tvID int, opt *FullTVShowOptions, options ...RequestOptionFn) (*TVShowDetails, *http.Response, error) {
+	if opt == nil {
+		opt = &FullTVShowOptions{}
+	}
+	if err := opt.Validate(); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to get full tv show: invalid options")
+	}
+
+	// The season numbers are not known before the first request, which appends the first ones,
+	// as they usually start at 0 for specials or 1 and follow each other.
+	showOpt := TVShowDetailsOptions{Language: opt.Language, AppendToResponse: opt.AppendToResponse}
+	for i := bits.OnesCount32(uint32(opt.AppendToResponse)); i < MaxAppendToResponse; i++ {
+		showOpt.AppendSeasons = append(showOpt.AppendSeasons, len(showOpt.AppendSeasons))
+	}
+	tvShow, resp, err := tr.GetTVShow(tvID, &showOpt, options...)
+	if err != nil {
+		return tvShow, resp, errors.Wrap(err, "failed to get full tv show")
+	}
+
+	var seasonNumbers []int
+	for _, season := range tvShow.Seasons {
+		if tvShow.SeasonDetails[season.SeasonNumber] == nil {
+			seasonNumbers = append(seasonNumbers, season.SeasonNumber)
+		}
+	}
+	var chunks [][]int
+	for len(seasonNumbers) > 0 {
+		n := MaxAppendToResponse
+		if len(seasonNumbers) < n {
+			n = len(seasonNumbers)
+		}
+		chunks, seasonNumbers = append(chunks, seasonNumbers[:n]), seasonNumbers[n:]
+	}
+
+	if tvShow.SeasonDetails == nil {
+		tvShow.SeasonDetails = make(map[int]*TVSeasonDetails, len(tvShow.Seasons))
+	}
+	var mu sync.Mutex
+	err = forEachConcurrently(len(chunks), opt.concurrency(), func(i int) error {
+		seasonsOpt := TVShowDetailsOptions{Language: opt.Language, AppendSeasons: chunks[i]}
+		seasons, _, err := tr.GetTVShow(tvID, &seasonsOpt, options...)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get seasons %v", chunks[i])
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		for seasonNumber, season := range seasons.SeasonDetails {
+			tvShow.SeasonDetails[seasonNumber] = season
+		}
+		return nil
+	})
+	if err != nil {
+		return tvShow, resp, errors.Wrap(err, "failed to get full tv show")
+	}
+
+	appendToResponse := opt.episodeAppendToResponse()
+	if appendToResponse == "" {
+		return tvShow, resp, nil
+	}
+	var episodes []*SeasonEpisode
+	for _, season := range tvShow.Seasons {
+		if details := tvShow.SeasonDetails[season.SeasonNumber]; details != nil {
+			for i := range details.Episodes {
+				episodes = append(episodes, &details.Episodes[i])
+			}
+		}
+	}
+	err = forEachConcurrently(len(episodes), opt.concurrency(), func(i int) error {
+		episode := episodes[i]
+		episodeOpt := TVEpisodeDetailsOptions{Language: opt.Language, AppendToResponse: appendToResponse}
+		details, _, err := tr.client.TVEpisodes.GetEpisode(tvID, episode.SeasonNumber, episode.EpisodeNumber, &episodeOpt, options...)
+		if err != nil {
+			return errors.Wrapf(err, "failed to enrich episode %d of season %d", episode.EpisodeNumber, episode.SeasonNumber)
+		}
+		episode.Credits = details.Credits
+		episode.ExternalIDs = details.ExternalIDs
+		return nil
+	})
+	return tvShow, resp, errors.Wrap(err, "failed to get full tv show")
+}
+
+// forEachConcurrently calls fn for each index from 0 to n-1, running up to concurrency calls at a time.
+// No more calls are started after a call fails, and the first error is returned.
+func forEachConcurrently(n, concurrency int, fn func(i int) error) error {
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	failed := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return firstErr != nil
+	}
+	sem := make(chan struct{}, concurrency)
+	for i := 0; i < n; i++ {
+		sem <- struct{}{}
+		if failed() {
+			<-sem
+			break
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := fn(i); err != nil {
+				mu.Lock()
+				defer mu.Unlock()
+				if firstErr == nil {
+					firstErr = err
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	return firstErr
+}
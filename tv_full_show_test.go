@@ -0,0 +1,72 @@
+package tmdb_test
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/mdvalv/go-tmdb"
+	"github.com/mdvalv/go-tmdb/tmdbtest"
+)
+
+func TestGetFullShow(t *testing.T) {
+	tests := []struct {
+		name             string
+		seasons          int
+		appendToResponse tmdb.TVAppend
+		wantRequests     int
+	}{
+		{name: "seasons in first request", seasons: 5, wantRequests: 1},
+		{name: "specials and 19 seasons in first request", seasons: 20, wantRequests: 1},
+		{name: "remaining seasons in second request", seasons: 25, wantRequests: 2},
+		{name: "appended sub-resources count towards the limit", seasons: 19, appendToResponse: tmdb.TVAppendCredits | tmdb.TVAppendImages, wantRequests: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := tmdbtest.NewServer()
+			defer server.Close()
+			client, err := server.Client()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			show := tmdb.TVShowDetails{ID: tmdbtest.TVShowID, Name: "Game of Thrones"}
+			for i := 0; i < tt.seasons; i++ {
+				show.Seasons = append(show.Seasons, tmdb.Season{SeasonNumber: i})
+				path := fmt.Sprintf("/3/tv/%d/season/%d", tmdbtest.TVShowID, i)
+				if err := server.Handle(http.MethodGet, path, http.StatusOK, tmdb.TVSeasonDetails{SeasonNumber: i}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := server.Handle(http.MethodGet, fmt.Sprintf("/3/tv/%d", tmdbtest.TVShowID), http.StatusOK, show); err != nil {
+				t.Fatal(err)
+			}
+
+			opt := tmdb.FullTVShowOptions{AppendToResponse: tt.appendToResponse}
+			tvShow, _, err := client.TV.GetFullShow(tmdbtest.TVShowID, &opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(tvShow.SeasonDetails) != tt.seasons {
+				t.Errorf("got %d seasons, want %d", len(tvShow.SeasonDetails), tt.seasons)
+			}
+			for i := 0; i < tt.seasons; i++ {
+				if season := tvShow.SeasonDetails[i]; season == nil || season.SeasonNumber != i {
+					t.Errorf("got season %d %+v", i, season)
+				}
+			}
+			requests := server.Requests()
+			if len(requests) != tt.wantRequests {
+				t.Fatalf("got %d requests, want %d", len(requests), tt.wantRequests)
+			}
+			first := requests[0].Query.Get("append_to_response")
+			if !strings.Contains(first, "season/0") {
+				t.Errorf("got first append_to_response %q, want the first seasons", first)
+			}
+			if count := len(strings.Split(first, ",")); count > tmdb.MaxAppendToResponse {
+				t.Errorf("got %d appended items in first request, want at most %d", count, tmdb.MaxAppendToResponse)
+			}
+		})
+	}
+}
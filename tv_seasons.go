@@ -14,7 +14,7 @@ type TVSeasonsResource struct {
 
 // SeasonEpisode represents a season episode in TMDb.
 type SeasonEpisode struct {
-	AirDate        string       `json:"air_date"`
+	AirDate        Date         `json:"air_date"`
 	Crew           []TVShowCrew `json:"crew"`
 	EpisodeNumber  int          `json:"episode_number"`
 	GuestStars     []TVShowCast `json:"guest_stars"`
@@ -27,12 +27,16 @@ type SeasonEpisode struct {
 	StillPath      *string      `json:"still_path"`
 	VoteAverage    float64      `json:"vote_average"`
 	VoteCount      int          `json:"vote_count"`
+
+	// filled by TVResource.GetFullShow when requested
+	Credits     *TVEpisodeCredits     `json:"credits,omitempty"`
+	ExternalIDs *TVEpisodeExternalIDs `json:"external_ids,omitempty"`
 }
 
 // TVSeasonDetails represents season details in TMDb.
 type TVSeasonDetails struct {
 	ID           int             `json:"id"`
-	AirDate      string          `json:"air_date"`
+	AirDate      Date            `json:"air_date"`
 	Episodes     []SeasonEpisode `json:"episodes"`
 	Name         string          `json:"name"`
 	Overview     string          `json:"overview"`
@@ -69,18 +73,18 @@ type TVSeasonDetailsOptions struct {
 }
 
 // GetSeason retrieves the TV season details by id.
-func (tr *TVSeasonsResource) GetSeason(tvID, seasonNumber int, opt *TVSeasonDetailsOptions) (*TVSeasonDetails, *http.Response, error) {
+func (tr *TVSeasonsResource) GetSeason(tvID, seasonNumber int, opt *TVSeasonDetailsOptions, options ...RequestOptionFn) (*TVSeasonDetails, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/season/%d", tvID, seasonNumber)
 	var season TVSeasonDetails
-	resp, err := tr.client.get(path, &season, WithQueryParams(opt))
+	resp, err := tr.client.get(path, &season, WithQueryParams(opt), withOptions(options...))
 	return &season, resp, errors.Wrap(err, "failed to get season")
 }
 
 // AccountStateSeason represents account state for a season in TMDb.
 type AccountStateSeason struct {
-	ID            int         `json:"id"`
-	EpisodeNumber int         `json:"episode_number"`
-	Rated         interface{} `json:"rated"`
+	ID            int        `json:"id"`
+	EpisodeNumber int        `json:"episode_number"`
+	Rated         UserRating `json:"rated"`
 }
 
 // AccountStatesSeason represents account states for a season in TMDb.
@@ -90,37 +94,37 @@ type AccountStatesSeason struct {
 }
 
 // GetAccountStates returns all of the user ratings for the season's episodes.
-func (tr *TVSeasonsResource) GetAccountStates(tvID, seasonNumber int, sessionID string) (*AccountStatesSeason, *http.Response, error) {
+func (tr *TVSeasonsResource) GetAccountStates(tvID, seasonNumber int, sessionID string, options ...RequestOptionFn) (*AccountStatesSeason, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/season/%d/account_states", tvID, seasonNumber)
 	var states AccountStatesSeason
-	resp, err := tr.client.get(path, &states, WithSessionID(sessionID))
+	resp, err := tr.client.get(path, &states, WithSessionID(sessionID), withOptions(options...))
 	return &states, resp, errors.Wrap(err, "failed to get account states")
 }
 
 // GetAggregateCredits retrieves the aggregate credits for TV season.
 // This call differs from the main credits call in that it does not only return the season credits,
 // but rather is a view of all the cast & crew for all of the episodes belonging to a season.
-func (tr *TVSeasonsResource) GetAggregateCredits(tvID, seasonNumber int, opt *AggregateCreditsOptions) (*AggregateCredits, *http.Response, error) {
+func (tr *TVSeasonsResource) GetAggregateCredits(tvID, seasonNumber int, opt *AggregateCreditsOptions, options ...RequestOptionFn) (*AggregateCredits, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/season/%d/aggregate_credits", tvID, seasonNumber)
 	var credits AggregateCredits
-	resp, err := tr.client.get(path, &credits, WithQueryParams(opt))
+	resp, err := tr.client.get(path, &credits, WithQueryParams(opt), withOptions(options...))
 	return &credits, resp, errors.Wrap(err, "failed to get aggregate credits")
 }
 
 // GetChanges retrieves the changes for a TV season. By default only the last 24 hours are returned.
 // Query up to 14 days in a single query by using the start_date and end_date query parameters.
-func (tr *TVSeasonsResource) GetChanges(seasonID int, opt *ChangesOptions) (*Changes, *http.Response, error) {
+func (tr *TVSeasonsResource) GetChanges(seasonID int, opt *ChangesOptions, options ...RequestOptionFn) (*Changes, *http.Response, error) {
 	path := fmt.Sprintf("/tv/season/%d/changes", seasonID)
 	var changes Changes
-	resp, err := tr.client.get(path, &changes, WithQueryParams(opt))
+	resp, err := tr.client.get(path, &changes, WithQueryParams(opt), withOptions(options...))
 	return &changes, resp, errors.Wrap(err, "failed to get season changes")
 }
 
 // GetCredits retrieves the credits for TV season.
-func (tr *TVSeasonsResource) GetCredits(tvID, seasonNumber int, opt *CreditsOptions) (*TVShowCredits, *http.Response, error) {
+func (tr *TVSeasonsResource) GetCredits(tvID, seasonNumber int, opt *CreditsOptions, options ...RequestOptionFn) (*TVShowCredits, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/season/%d/credits", tvID, seasonNumber)
 	var credits TVShowCredits
-	resp, err := tr.client.get(path, &credits, WithQueryParams(opt))
+	resp, err := tr.client.get(path, &credits, WithQueryParams(opt), withOptions(options...))
 	return &credits, resp, errors.Wrap(err, "failed to get credits")
 }
 
@@ -134,10 +138,10 @@ type TVSeasonExternalIDs struct {
 }
 
 // GetExternalIDs retrieves the external ids for a TV season.
-func (tr *TVSeasonsResource) GetExternalIDs(tvID, seasonNumber int, opt *ExternalIDsOptions) (*TVSeasonExternalIDs, *http.Response, error) {
+func (tr *TVSeasonsResource) GetExternalIDs(tvID, seasonNumber int, opt *ExternalIDsOptions, options ...RequestOptionFn) (*TVSeasonExternalIDs, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/season/%d/external_ids", tvID, seasonNumber)
 	var ids TVSeasonExternalIDs
-	resp, err := tr.client.get(path, &ids, WithQueryParams(opt))
+	resp, err := tr.client.get(path, &ids, WithQueryParams(opt), withOptions(options...))
 	return &ids, resp, errors.Wrap(err, "failed to get external ids")
 }
 
@@ -151,10 +155,10 @@ type TVSeasonImages struct {
 // Querying images with a language parameter will filter the results.
 // To include a fallback language (especially useful for backdrops), use the include_image_language parameter.
 // This should be a comma separated value like so: include_image_language=en,null.
-func (tr *TVSeasonsResource) GetImages(tvID, seasonNumber int, opt *ImagesOptions) (*TVSeasonImages, *http.Response, error) {
+func (tr *TVSeasonsResource) GetImages(tvID, seasonNumber int, opt *ImagesOptions, options ...RequestOptionFn) (*TVSeasonImages, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/season/%d/images", tvID, seasonNumber)
 	var images TVSeasonImages
-	resp, err := tr.client.get(path, &images, WithQueryParams(opt))
+	resp, err := tr.client.get(path, &images, WithQueryParams(opt), withOptions(options...))
 	return &images, resp, errors.Wrap(err, "failed to get images")
 }
 
@@ -180,17 +184,17 @@ type TVSeasonTranslations struct {
 }
 
 // GetTranslations retrieves a list of the translations that exist for a TV show.
-func (tr *TVSeasonsResource) GetTranslations(tvID, seasonNumber int) (*TVSeasonTranslations, *http.Response, error) {
+func (tr *TVSeasonsResource) GetTranslations(tvID, seasonNumber int, options ...RequestOptionFn) (*TVSeasonTranslations, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/season/%d/translations", tvID, seasonNumber)
 	var translations TVSeasonTranslations
-	resp, err := tr.client.get(path, &translations)
+	resp, err := tr.client.get(path, &translations, options...)
 	return &translations, resp, errors.Wrap(err, "failed to get translations")
 }
 
 // GetVideos retrieves the videos that have been added to a TV season.
-func (tr *TVSeasonsResource) GetVideos(tvID, seasonNumber int, opt *VideosOptions) (*Videos, *http.Response, error) {
+func (tr *TVSeasonsResource) GetVideos(tvID, seasonNumber int, opt *VideosOptions, options ...RequestOptionFn) (*Videos, *http.Response, error) {
 	path := fmt.Sprintf("/tv/%d/season/%d/videos", tvID, seasonNumber)
 	var videos Videos
-	resp, err := tr.client.get(path, &videos, WithQueryParams(opt))
+	resp, err := tr.client.get(path, &videos, WithQueryParams(opt), withOptions(options...))
 	return &videos, resp, errors.Wrap(err, "failed to get tv show videos")
 }
@@ -0,0 +1,364 @@
+package tmdb
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var iso6391Pattern = regexp.MustCompile(`^[a-z]{2}$`)
+
+// FieldError represents an invalid field of a request.
+type FieldError struct {
+	// Name of the field in TMDb API, e.g. language.
+	Field  string
+	Value  interface{}
+	Reason string
+}
+
+// Error returns the field error as a string.
+func (fe FieldError) Error() string {
+	if reflect.ValueOf(fe.Value).Kind() == reflect.String {
+		return fmt.Sprintf("%s %q: %s", fe.Field, fe.Value, fe.Reason)
+	}
+	return fmt.Sprintf("%s %v: %s", fe.Field, fe.Value, fe.Reason)
+}
+
+// ValidationError represents a request that failed client-side validation, listing each invalid field.
+// It is returned before any HTTP request is sent.
+type ValidationError struct {
+	Errors []FieldError
+}
+
+// Error returns the validation error as a string.
+func (ve *ValidationError) Error() string {
+	messages := make([]string, len(ve.Errors))
+	for i, fe := range ve.Errors {
+		messages[i] = fe.Error()
+	}
+	return "invalid fields: " + strings.Join(messages, "; ")
+}
+
+// fieldErrors collects the invalid fields of a request.
+type fieldErrors []FieldError
+
+// add adds an invalid field.
+func (fe *fieldErrors) add(field string, value interface{}, format string, args ...interface{}) {
+	*fe = append(*fe, FieldError{Field: field, Value: value, Reason: fmt.Sprintf(format, args...)})
+}
+
+// err returns a validation error listing the invalid fields, if any.
+func (fe fieldErrors) err() error {
+	if len(fe) == 0 {
+		return nil
+	}
+	return &ValidationError{Errors: fe}
+}
+
+// validateOptions checks the options, if they can be checked.
+func validateOptions(options interface{}) error {
+	if v, ok := options.(interface{ Validate() error }); ok {
+		return v.Validate()
+	}
+	return nil
+}
+
+// validateFields checks the fields of options, see checkFields.
+func validateFields(opt interface{}) error {
+	return checkFields(opt).err()
+}
+
+// fieldRules checks the fields by their name in TMDb API, returning the reason when invalid.
+var fieldRules = map[string]func(value interface{}) string{
+	"language":               matchRule(languagePattern, "must be an ISO 639-1 code, optionally followed by an ISO 3166-1 code, e.g. en or en-US"),
+	"iso_639_1":              matchRule(iso6391Pattern, "must be a lowercase ISO 639-1 code, e.g. en"),
+	"with_original_language": matchRule(iso6391Pattern, "must be a lowercase ISO 639-1 code, e.g. en"),
+	"region":                 matchRule(regionPattern, "must be an uppercase ISO 3166-1 code, e.g. US"),
+	"watch_region":           matchRule(regionPattern, "must be an uppercase ISO 3166-1 code, e.g. US"),
+	"certification_country":  matchRule(regionPattern, "must be an uppercase ISO 3166-1 code, e.g. US"),
+	"country":                matchRule(regionPattern, "must be an uppercase ISO 3166-1 code, e.g. US"),
+	"iso_3166_1":             matchRule(regionPattern, "must be an uppercase ISO 3166-1 code, e.g. US"),
+	"page":                   intRangeRule(1, MaxPages),
+	"year":                   intRangeRule(1000, 9999),
+	"primary_release_year":   intRangeRule(1000, 9999),
+	"first_air_date_year":    intRangeRule(1000, 9999),
+	"start_date":             dateRule,
+	"end_date":               dateRule,
+}
+
+func matchRule(pattern *regexp.Regexp, reason string) func(value interface{}) string {
+	return func(value interface{}) string {
+		if s, ok := value.(string); ok && !pattern.MatchString(s) {
+			return reason
+		}
+		return ""
+	}
+}
+
+func intRangeRule(min, max int) func(value interface{}) string {
+	return func(value interface{}) string {
+		if n, ok := value.(int); ok && (n < min || n > max) {
+			return fmt.Sprintf("must be between %d and %d", min, max)
+		}
+		return ""
+	}
+}
+
+func dateRule(value interface{}) string {
+	if s, ok := value.(string); ok {
+		if _, err := time.Parse(DateLayout, s); err != nil {
+			return "must be formatted as YYYY-MM-DD"
+		}
+	}
+	return ""
+}
+
+// checkFields checks the fields of options that are set, using their name in TMDb API.
+// Fields with a Validate method, such as typed enumerations, are checked with it.
+func checkFields(opt interface{}) fieldErrors {
+	var errs fieldErrors
+	v := reflect.ValueOf(opt)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name := fieldName(t.Field(i))
+		field := v.Field(i)
+		if name == "" || !t.Field(i).IsExported() || field.IsZero() {
+			continue
+		}
+		if field.Kind() == reflect.Pointer {
+			field = field.Elem()
+		}
+		value := field.Interface()
+		if validator, ok := value.(interface{ Validate() error }); ok {
+			if err := validator.Validate(); err != nil {
+				errs.add(name, value, "%s", err)
+			}
+			continue
+		}
+		if rule, ok := fieldRules[name]; ok {
+			if reason := rule(value); reason != "" {
+				errs.add(name, value, "%s", reason)
+			}
+		}
+	}
+	return errs
+}
+
+// fieldName retrieves the name of a field in TMDb API from its url or json tag.
+func fieldName(field reflect.StructField) string {
+	for _, key := range []string{"url", "json"} {
+		if name := strings.Split(field.Tag.Get(key), ",")[0]; name != "" && name != "-" {
+			return name
+		}
+	}
+	return ""
+}
+
+// checkRequired checks that a string field is set.
+func (fe *fieldErrors) checkRequired(field, value string) {
+	if strings.TrimSpace(value) == "" {
+		fe.add(field, value, "is required")
+	}
+}
+
+// checkMediaType checks that a media type is either movie or tv.
+func (fe *fieldErrors) checkMediaType(field, value string) {
+	if value != MediaTypeMovie && value != MediaTypeTV {
+		fe.add(field, value, "must be either movie or tv")
+	}
+}
+
+// checkRatingValue checks that a rating is between 0.5 and 10, in 0.5 steps.
+func (fe *fieldErrors) checkRatingValue(field string, value float64) {
+	if value < 0.5 || value > 10 || math.Mod(value*2, 1) != 0 {
+		fe.add(field, value, "must be between 0.5 and 10, in 0.5 steps")
+	}
+}
+
+// Validate checks the options.
+func (opt *AccountListsOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *AccountOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *AccountListsV4Options) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *AccountV4Options) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *AggregateCreditsOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *ChangesOptions) Validate() error {
+	errs := checkFields(opt)
+	if opt == nil {
+		return errs.err()
+	}
+	start, startErr := time.Parse(DateLayout, opt.StartDate)
+	end, endErr := time.Parse(DateLayout, opt.EndDate)
+	if startErr == nil && endErr == nil && start.After(end) {
+		errs.add("end_date", opt.EndDate, "must not be before start_date %s", opt.StartDate)
+	}
+	return errs.err()
+}
+
+// Validate checks the options.
+func (opt *CollectionsOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *ContentRatingsOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *CreditsOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *EpisodeGroupOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *EpisodeGroupsOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *ExternalIDOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *ExternalIDsOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *FindOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *GenresOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *GuestSessionOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *ImagesOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *KeywordMoviesOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *LatestOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *LatestPersonOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *ListOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *ListV4Options) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *MovieAlternativeTitlesOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *MovieDetailsOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *MoviesOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *NowPlayingMoviesOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *PersonDetailsOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *PersonTranslationsOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *PopularMoviesOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *PopularPeopleOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *PopularTVShowsOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *ProviderRegionsOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *ProvidersOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *RecommendationsOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *ReviewsOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *SearchCollectionsOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *SearchCompaniesOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *SearchKeywordsOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *SearchMoviesOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *SearchMultiOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *SearchPeopleOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *SearchTVShowsOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *SimilarTVShowsOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *TaggedImagesOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *TopRatedMoviesOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *TopRatedTVShowOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *TVEpisodeDetailsOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *TVSeasonDetailsOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *TVShowAlternativeTitlesOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *TVShowDetailsOptions) Validate() error {
+	errs := checkFields(opt)
+	if opt != nil {
+		errs.checkAppendSeasons(opt.AppendToResponse, opt.AppendSeasons)
+	}
+	return errs.err()
+}
+
+// Validate checks the options.
+func (opt *TVShowsAiringOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *UpcomingMoviesOptions) Validate() error { return validateFields(opt) }
+
+// Validate checks the options.
+func (opt *VideosOptions) Validate() error { return validateFields(opt) }
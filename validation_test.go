@@ -0,0 +1,165 @@
+package tmdb_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/mdvalv/go-tmdb"
+	"github.com/mdvalv/go-tmdb/tmdbtest"
+)
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func TestCheckFields(t *testing.T) {
+	tests := []struct {
+		name       string
+		opt        *tmdb.SearchMoviesOptions
+		wantFields []string
+	}{
+		{name: "nil options"},
+		{name: "empty options", opt: &tmdb.SearchMoviesOptions{}},
+		{name: "valid options", opt: &tmdb.SearchMoviesOptions{Language: "en-US", Page: intPtr(2), Year: intPtr(1999), Region: "US"}},
+		{name: "language", opt: &tmdb.SearchMoviesOptions{Language: "english"}, wantFields: []string{"language"}},
+		{name: "page out of range", opt: &tmdb.SearchMoviesOptions{Page: intPtr(tmdb.MaxPages + 1)}, wantFields: []string{"page"}},
+		{name: "zero page", opt: &tmdb.SearchMoviesOptions{Page: intPtr(0)}, wantFields: []string{"page"}},
+		{name: "lowercase region", opt: &tmdb.SearchMoviesOptions{Region: "us"}, wantFields: []string{"region"}},
+		{
+			name:       "every invalid field",
+			opt:        &tmdb.SearchMoviesOptions{Language: "EN", Year: intPtr(99), PrimaryReleaseYear: intPtr(10000), Region: "USA"},
+			wantFields: []string{"language", "year", "primary_release_year", "region"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := tmdbtest.NewServer()
+			defer server.Close()
+			client, err := server.Client()
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, _, err = client.Search.Movies("alien", tt.opt)
+			if got := fmt.Sprint(invalidFields(err)); got != fmt.Sprint(tt.wantFields) {
+				t.Errorf("got invalid fields %s, want %v (error %v)", got, tt.wantFields, err)
+			}
+			wantRequests := 1
+			if len(tt.wantFields) > 0 {
+				wantRequests = 0
+			}
+			if got := len(server.Requests()); got != wantRequests {
+				t.Errorf("got %d requests, want %d", got, wantRequests)
+			}
+		})
+	}
+}
+
+func TestChangesOptionsValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		opt        *tmdb.ChangesOptions
+		wantFields []string
+	}{
+		{name: "nil options"},
+		{name: "dates in order", opt: &tmdb.ChangesOptions{StartDate: "2023-01-01", EndDate: "2023-01-10"}},
+		{name: "same dates", opt: &tmdb.ChangesOptions{StartDate: "2023-01-01", EndDate: "2023-01-01"}},
+		{name: "start after end", opt: &tmdb.ChangesOptions{StartDate: "2023-01-10", EndDate: "2023-01-01"}, wantFields: []string{"end_date"}},
+		{name: "invalid start date only", opt: &tmdb.ChangesOptions{StartDate: "2023-13-01", EndDate: "2023-01-01"}, wantFields: []string{"start_date"}},
+		{name: "start date only", opt: &tmdb.ChangesOptions{StartDate: "2023-01-10"}},
+		{name: "page", opt: &tmdb.ChangesOptions{Page: intPtr(-1)}, wantFields: []string{"page"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.opt.Validate()
+			if got := fmt.Sprint(invalidFields(err)); got != fmt.Sprint(tt.wantFields) {
+				t.Errorf("got invalid fields %s, want %v (error %v)", got, tt.wantFields, err)
+			}
+		})
+	}
+}
+
+func TestRequestBodiesValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		do         func(auth tmdb.AuthenticationService) error
+		wantFields []string
+	}{
+		{
+			name: "create session without request token",
+			do: func(auth tmdb.AuthenticationService) error {
+				_, _, err := auth.CreateSession("")
+				return err
+			},
+			wantFields: []string{"request_token"},
+		},
+		{
+			name: "create session with v4 token without access token",
+			do: func(auth tmdb.AuthenticationService) error {
+				_, _, err := auth.CreateSessionWithV4Token(" ")
+				return err
+			},
+			wantFields: []string{"access_token"},
+		},
+		{
+			name: "delete session without session id",
+			do: func(auth tmdb.AuthenticationService) error {
+				_, _, err := auth.DeleteSession("")
+				return err
+			},
+			wantFields: []string{"session_id"},
+		},
+		{
+			name: "login without credentials",
+			do: func(auth tmdb.AuthenticationService) error {
+				_, _, err := auth.ValidateRequestToken("", "", "token")
+				return err
+			},
+			wantFields: []string{"username", "password"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := tmdbtest.NewServer()
+			defer server.Close()
+			client, err := server.Client()
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = tt.do(client.Authentication)
+			if got := fmt.Sprint(invalidFields(err)); got != fmt.Sprint(tt.wantFields) {
+				t.Errorf("got invalid fields %s, want %v (error %v)", got, tt.wantFields, err)
+			}
+			if got := len(server.Requests()); got != 0 {
+				t.Errorf("got %d requests sent", got)
+			}
+		})
+	}
+}
+
+func TestOptionsValidateNil(t *testing.T) {
+	options := []interface{ Validate() error }{
+		(*tmdb.AccountOptions)(nil),
+		(*tmdb.CreditsOptions)(nil),
+		(*tmdb.DiscoverMoviesOptions)(nil),
+		(*tmdb.ListV4Options)(nil),
+		(*tmdb.MovieDetailsOptions)(nil),
+		(*tmdb.SearchMultiOptions)(nil),
+		(*tmdb.TVEpisodeDetailsOptions)(nil),
+	}
+	for _, opt := range options {
+		if err := opt.Validate(); err != nil {
+			t.Errorf("got error %v for nil %T", err, opt)
+		}
+	}
+}
+
+func TestValidationErrorMessage(t *testing.T) {
+	err := &tmdb.ValidationError{Errors: []tmdb.FieldError{
+		{Field: "language", Value: "english", Reason: "must be an ISO 639-1 code"},
+		{Field: "page", Value: 0, Reason: "must be between 1 and 500"},
+	}}
+	want := `invalid fields: language "english": must be an ISO 639-1 code; page 0: must be between 1 and 500`
+	if got := err.Error(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -3,6 +3,7 @@ package tmdb
 import (
 	"fmt"
 	"net/http"
+	"sort"
 
 	"github.com/pkg/errors"
 )
@@ -20,6 +21,90 @@ type Provider struct {
 	ProviderID      int     `json:"provider_id"`
 }
 
+// Monetization represents how a provider makes a movie or tv show available.
+type Monetization string
+
+// Available monetization types.
+const (
+	MonetizationFlatrate Monetization = "flatrate"
+	MonetizationRent     Monetization = "rent"
+	MonetizationBuy      Monetization = "buy"
+	MonetizationAds      Monetization = "ads"
+	MonetizationFree     Monetization = "free"
+)
+
+// RegionProviders represents the providers of a movie or tv show in a region in TMDb.
+type RegionProviders struct {
+	// Link to the TMDb watch page, which holds the actual deep links to the content.
+	Link     string     `json:"link"`
+	Flatrate []Provider `json:"flatrate"`
+	Rent     []Provider `json:"rent"`
+	Buy      []Provider `json:"buy"`
+	Ads      []Provider `json:"ads"`
+	Free     []Provider `json:"free"`
+}
+
+// ByMonetization retrieves the providers with the given monetization type.
+func (rp RegionProviders) ByMonetization(kind Monetization) []Provider {
+	switch kind {
+	case MonetizationFlatrate:
+		return rp.Flatrate
+	case MonetizationRent:
+		return rp.Rent
+	case MonetizationBuy:
+		return rp.Buy
+	case MonetizationAds:
+		return rp.Ads
+	case MonetizationFree:
+		return rp.Free
+	}
+	return nil
+}
+
+// HasProvider checks whether the provider makes the movie or tv show available in the region,
+// whatever the monetization type.
+func (rp RegionProviders) HasProvider(providerID int) bool {
+	for _, providers := range [][]Provider{rp.Flatrate, rp.Rent, rp.Buy, rp.Ads, rp.Free} {
+		for _, provider := range providers {
+			if provider.ProviderID == providerID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// AvailableIn retrieves the providers in a region.
+// Use the ISO-3166-1 code of the region, e.g. BR, FR, US.
+func (p Providers) AvailableIn(region string) (RegionProviders, bool) {
+	providers, ok := p[region]
+	return providers, ok
+}
+
+// ByMonetization retrieves the providers with the given monetization type, indexed by region.
+// Regions without such providers are left out.
+func (p Providers) ByMonetization(kind Monetization) map[string][]Provider {
+	byRegion := make(map[string][]Provider)
+	for region, providers := range p {
+		if monetized := providers.ByMonetization(kind); len(monetized) > 0 {
+			byRegion[region] = monetized
+		}
+	}
+	return byRegion
+}
+
+// RegionsWithProvider retrieves the regions where the provider makes the movie or tv show available, sorted.
+func (p Providers) RegionsWithProvider(providerID int) []string {
+	var regions []string
+	for region, providers := range p {
+		if providers.HasProvider(providerID) {
+			regions = append(regions, region)
+		}
+	}
+	sort.Strings(regions)
+	return regions
+}
+
 // providers represents providers in TMDb.
 type providers struct {
 	Providers []Provider `json:"results"`
@@ -40,19 +125,19 @@ type ProvidersOptions struct {
 }
 
 // GetMovieProviders returns a list of the watch provider (OTT/streaming) data TMDb has available for movies.
-func (pr *WatchProvidersResource) GetMovieProviders(opt *ProvidersOptions) ([]Provider, *http.Response, error) {
-	return pr.getProviders("movie", opt)
+func (pr *WatchProvidersResource) GetMovieProviders(opt *ProvidersOptions, options ...RequestOptionFn) ([]Provider, *http.Response, error) {
+	return pr.getProviders("movie", opt, options...)
 }
 
 // GetTVProviders returns a list of the watch provider (OTT/streaming) data TMDb has available for TV series.
-func (pr *WatchProvidersResource) GetTVProviders(opt *ProvidersOptions) ([]Provider, *http.Response, error) {
-	return pr.getProviders("tv", opt)
+func (pr *WatchProvidersResource) GetTVProviders(opt *ProvidersOptions, options ...RequestOptionFn) ([]Provider, *http.Response, error) {
+	return pr.getProviders("tv", opt, options...)
 }
 
-func (pr *WatchProvidersResource) getProviders(providerType string, opt *ProvidersOptions) ([]Provider, *http.Response, error) {
+func (pr *WatchProvidersResource) getProviders(providerType string, opt *ProvidersOptions, options ...RequestOptionFn) ([]Provider, *http.Response, error) {
 	path := fmt.Sprintf("/watch/providers/%s", providerType)
 	var providers providers
-	resp, err := pr.client.get(path, &providers, WithQueryParams(opt))
+	resp, err := pr.client.get(path, &providers, WithQueryParams(opt), withOptions(options...))
 	return providers.Providers, resp, errors.Wrap(err, fmt.Sprintf("failed to get %s providers", providerType))
 }
 
@@ -72,9 +157,9 @@ type providerRegions struct {
 type ProviderRegionsOptions languageOptions
 
 // GetProviderRegions returns a list of all of the countries TMDb has watch provider (OTT/streaming) data for.
-func (pr *WatchProvidersResource) GetProviderRegions(opt *ProviderRegionsOptions) ([]ProviderRegion, *http.Response, error) {
+func (pr *WatchProvidersResource) GetProviderRegions(opt *ProviderRegionsOptions, options ...RequestOptionFn) ([]ProviderRegion, *http.Response, error) {
 	path := "/watch/providers/regions"
 	var providerRegions providerRegions
-	resp, err := pr.client.get(path, &providerRegions, WithQueryParams(opt))
+	resp, err := pr.client.get(path, &providerRegions, WithQueryParams(opt), withOptions(options...))
 	return providerRegions.ProviderRegions, resp, errors.Wrap(err, "failed to get provider regions")
 }
@@ -0,0 +1,111 @@
+package tmdb_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/mdvalv/go-tmdb"
+)
+
+const watchProvidersJSON = `{
+	"id": 550,
+	"results": {
+		"BR": {
+			"link": "https://www.themoviedb.org/movie/550-fight-club/watch?locale=BR",
+			"flatrate": [{"provider_id": 337, "provider_name": "Disney Plus", "display_priority": 1}],
+			"rent": [{"provider_id": 2, "provider_name": "Apple TV", "display_priority": 4}]
+		},
+		"FR": {
+			"link": "https://www.themoviedb.org/movie/550-fight-club/watch?locale=FR",
+			"buy": [{"provider_id": 2, "provider_name": "Apple TV", "display_priority": 4}]
+		},
+		"US": {
+			"link": "https://www.themoviedb.org/movie/550-fight-club/watch?locale=US",
+			"flatrate": [{"provider_id": 9, "provider_name": "Amazon Prime Video", "display_priority": 2}],
+			"ads": [{"provider_id": 73, "provider_name": "Tubi TV", "display_priority": 18}],
+			"free": [{"provider_id": 538, "provider_name": "Plex", "display_priority": 40}]
+		}
+	}
+}`
+
+// providerIDs returns the IDs of the providers.
+func providerIDs(providers []tmdb.Provider) []int {
+	var ids []int
+	for _, provider := range providers {
+		ids = append(ids, provider.ProviderID)
+	}
+	return ids
+}
+
+func TestWatchProvidersByRegion(t *testing.T) {
+	var watchProviders tmdb.WatchProviders
+	if err := json.Unmarshal([]byte(watchProvidersJSON), &watchProviders); err != nil {
+		t.Fatal(err)
+	}
+	providers := watchProviders.Providers
+
+	tests := []struct {
+		name          string
+		region        string
+		monetization  tmdb.Monetization
+		wantAvailable bool
+		wantIDs       []int
+	}{
+		{name: "flatrate in BR", region: "BR", monetization: tmdb.MonetizationFlatrate, wantAvailable: true, wantIDs: []int{337}},
+		{name: "rent in BR", region: "BR", monetization: tmdb.MonetizationRent, wantAvailable: true, wantIDs: []int{2}},
+		{name: "buy in FR", region: "FR", monetization: tmdb.MonetizationBuy, wantAvailable: true, wantIDs: []int{2}},
+		{name: "ads in US", region: "US", monetization: tmdb.MonetizationAds, wantAvailable: true, wantIDs: []int{73}},
+		{name: "free in US", region: "US", monetization: tmdb.MonetizationFree, wantAvailable: true, wantIDs: []int{538}},
+		{name: "missing monetization", region: "FR", monetization: tmdb.MonetizationFlatrate, wantAvailable: true},
+		{name: "unknown monetization", region: "US", monetization: "subscription", wantAvailable: true},
+		{name: "missing region", region: "DE", monetization: tmdb.MonetizationFlatrate},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			regionProviders, ok := providers.AvailableIn(tt.region)
+			if ok != tt.wantAvailable {
+				t.Fatalf("got available %t, want %t", ok, tt.wantAvailable)
+			}
+			if got := providerIDs(regionProviders.ByMonetization(tt.monetization)); !reflect.DeepEqual(got, tt.wantIDs) {
+				t.Errorf("got providers %v, want %v", got, tt.wantIDs)
+			}
+		})
+	}
+}
+
+func TestWatchProvidersAcrossRegions(t *testing.T) {
+	var watchProviders tmdb.WatchProviders
+	if err := json.Unmarshal([]byte(watchProvidersJSON), &watchProviders); err != nil {
+		t.Fatal(err)
+	}
+	providers := watchProviders.Providers
+
+	tests := []struct {
+		name        string
+		providerID  int
+		wantRegions []string
+	}{
+		{name: "several regions and monetizations", providerID: 2, wantRegions: []string{"BR", "FR"}},
+		{name: "single region", providerID: 538, wantRegions: []string{"US"}},
+		{name: "unknown provider", providerID: 8},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := providers.RegionsWithProvider(tt.providerID); !reflect.DeepEqual(got, tt.wantRegions) {
+				t.Errorf("got regions %v, want %v", got, tt.wantRegions)
+			}
+		})
+	}
+
+	flatrate := providers.ByMonetization(tmdb.MonetizationFlatrate)
+	want := map[string][]int{"BR": {337}, "US": {9}}
+	if len(flatrate) != len(want) {
+		t.Fatalf("got flatrate regions %v, want %v", flatrate, want)
+	}
+	for region, ids := range want {
+		if got := providerIDs(flatrate[region]); !reflect.DeepEqual(got, ids) {
+			t.Errorf("got flatrate providers %v in %s, want %v", got, region, ids)
+		}
+	}
+}